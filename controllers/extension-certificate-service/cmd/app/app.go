@@ -63,6 +63,7 @@ func (o *Options) run(ctx context.Context) {
 	ctrlConfig.Apply(&lifecycle.ServiceConfig)
 	ctrlConfig.Apply(&certservice.ServiceConfig)
 	o.controllerOptions.Completed().Apply(&certservice.ControllerOptions)
+	o.reconcileOptions.Completed().Apply(&certservice.IgnoreOperationAnnotation)
 
 	if err := o.controllerSwitches.Completed().AddToManager(mgr); err != nil {
 		controllercmd.LogErrAndExit(err, "Could not add controllers to manager")
@@ -30,6 +30,7 @@ type Options struct {
 	restOptions        *controllercmd.RESTOptions
 	managerOptions     *controllercmd.ManagerOptions
 	controllerOptions  *controllercmd.ControllerOptions
+	reconcileOptions   *controllercmd.ReconcilerOptions
 	controllerSwitches *controllercmd.SwitchOptions
 	optionAggregator   controllercmd.OptionAggregator
 }
@@ -49,6 +50,10 @@ func NewOptions() *Options {
 			// This is a default value.
 			MaxConcurrentReconciles: 5,
 		},
+		reconcileOptions: &controllercmd.ReconcilerOptions{
+			// This is a default value.
+			IgnoreOperationAnnotation: true,
+		},
 		controllerSwitches: certificateservicecmd.ControllerSwitches(),
 	}
 
@@ -56,6 +61,7 @@ func NewOptions() *Options {
 		options.restOptions,
 		options.managerOptions,
 		options.controllerOptions,
+		options.reconcileOptions,
 		options.certOptions,
 		options.controllerSwitches,
 	)
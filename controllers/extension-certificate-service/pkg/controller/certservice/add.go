@@ -38,18 +38,20 @@ const (
 var (
 	// ControllerOptions contains options for the controller.
 	ControllerOptions controller.Options
+	// IgnoreOperationAnnotation specifies whether to ignore the operation annotation or not.
+	IgnoreOperationAnnotation bool
 	// ServiceConfig contains configuration for the certificate service.
 	ServiceConfig controllerconfig.Config
 )
 
 // AddToManager adds a controller with the default Options to the given Controller Manager.
 func AddToManager(mgr manager.Manager) error {
-	return AddToManagerWithOptions(mgr, ControllerOptions, ServiceConfig)
+	return AddToManagerWithOptions(mgr, ControllerOptions, IgnoreOperationAnnotation, ServiceConfig)
 }
 
 // AddToManagerWithOptions adds a controller with the given Options to the given manager.
 // The opts.Reconciler is being set with a newly instantiated actuator.
-func AddToManagerWithOptions(mgr manager.Manager, opts controller.Options, config controllerconfig.Config) error {
+func AddToManagerWithOptions(mgr manager.Manager, opts controller.Options, ignoreOperationAnnotation bool, config controllerconfig.Config) error {
 	var (
 		cl = mgr.GetClient()
 
@@ -74,10 +76,11 @@ func AddToManagerWithOptions(mgr manager.Manager, opts controller.Options, confi
 	)
 
 	return extension.Add(mgr, extension.AddArgs{
-		Actuator:          NewActuator(config.Configuration),
+		Actuator:          extension.OperationAnnotationWrapper(NewActuator(config.Configuration)),
 		Name:              ControllerName,
 		Type:              Type,
 		ControllerOptions: opts,
+		Predicates:        extension.DefaultPredicates(mgr.GetClient(), ignoreOperationAnnotation),
 		WatchBuilder:      watchBuilder,
 		Resync:            config.Spec.ServiceSync.Duration,
 	})
@@ -39,6 +39,9 @@ func NewControllerCommand(ctx context.Context) *cobra.Command {
 		ctrlOpts = &controllercmd.ControllerOptions{
 			MaxConcurrentReconciles: 5,
 		}
+		reconcileOpts = &controllercmd.ReconcilerOptions{
+			IgnoreOperationAnnotation: true,
+		}
 
 		controllerSwitches = coreos.ControllerSwitchOptions()
 
@@ -46,6 +49,7 @@ func NewControllerCommand(ctx context.Context) *cobra.Command {
 			restOpts,
 			mgrOpts,
 			ctrlOpts,
+			reconcileOpts,
 			controllerSwitches,
 		)
 	)
@@ -68,6 +72,7 @@ func NewControllerCommand(ctx context.Context) *cobra.Command {
 			}
 
 			ctrlOpts.Completed().Apply(&coreos.DefaultAddOptions.Controller)
+			reconcileOpts.Completed().Apply(&coreos.DefaultAddOptions.IgnoreOperationAnnotation)
 
 			if err := controllerSwitches.Completed().AddToManager(mgr); err != nil {
 				controllercmd.LogErrAndExit(err, "Could not add controller to manager")
@@ -33,15 +33,17 @@ var (
 type AddOptions struct {
 	// Controller are the controller related options.
 	Controller controller.Options
+	// IgnoreOperationAnnotation specifies whether to ignore the operation annotation or not.
+	IgnoreOperationAnnotation bool
 }
 
 // AddToManagerWithOptions adds a controller with the given Options to the given manager.
 // The opts.Reconciler is being set with a newly instantiated actuator.
 func AddToManagerWithOptions(mgr manager.Manager, opts AddOptions) error {
 	return operatingsystemconfig.Add(mgr, operatingsystemconfig.AddArgs{
-		Actuator:          NewActuator(),
+		Actuator:          operatingsystemconfig.OperationAnnotationWrapper(NewActuator()),
 		ControllerOptions: opts.Controller,
-		Predicates:        operatingsystemconfig.DefaultPredicates(Type),
+		Predicates:        operatingsystemconfig.DefaultPredicates(mgr.GetClient(), Type, opts.IgnoreOperationAnnotation),
 	})
 }
 
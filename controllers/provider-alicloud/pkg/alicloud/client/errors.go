@@ -0,0 +1,54 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"strings"
+
+	controllererror "github.com/gardener/gardener-extensions/pkg/controller/error"
+
+	alicloudsdkerrors "github.com/aliyun/alibaba-cloud-sdk-go/sdk/errors"
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+)
+
+// ErrorPatterns map the error codes of the Alicloud API to Gardener error codes.
+var ErrorPatterns = []controllererror.Pattern{
+	controllererror.NewPattern(`\b(InvalidAccessKeyId(\.NotFound|\.Inactive)?|SignatureDoesNotMatch|IncompleteSignature|InvalidSecurityToken(\.\w+)?)\b`, gardencorev1alpha1.ErrorInfraUnauthorized),
+	controllererror.NewPattern(`\b(Forbidden\.RAM|Forbidden\.SubUser|Forbidden\.NotAuthorized|NoPermission)\b`, gardencorev1alpha1.ErrorInfraInsufficientPrivileges),
+	controllererror.NewPattern(`\b(QuotaExceed(ed)?(\.\w+)?|\w+\.QuotaExceed(ed)?|ResourceNotEnough|OperationDenied\.NoStock)\b`, gardencorev1alpha1.ErrorInfraQuotaExceeded),
+	controllererror.NewPattern(`\b(DependencyViolation(\.\w+)?|\w+\.InUse|IncorrectVpcStatus|IncorrectStatus\.\w+)\b`, gardencorev1alpha1.ErrorInfraDependencies),
+}
+
+// ErrorClassifier determines the Gardener error codes of errors returned by the Alicloud API. Errors that lost
+// their type, e.g. because they have been formatted into another error, are classified by their message.
+var ErrorClassifier controllererror.Classifier = controllererror.ClassifierFunc(classifyError)
+
+func classifyError(err error) []gardencorev1alpha1.ErrorCode {
+	if err == nil {
+		return nil
+	}
+
+	var alicloudCodes []string
+	for _, err := range controllererror.Errors(err) {
+		if alicloudErr, ok := err.(alicloudsdkerrors.Error); ok {
+			alicloudCodes = append(alicloudCodes, alicloudErr.ErrorCode())
+		}
+	}
+	if len(alicloudCodes) > 0 {
+		return controllererror.MatchPatterns(strings.Join(alicloudCodes, " "), ErrorPatterns...)
+	}
+
+	return controllererror.MatchPatterns(err.Error(), ErrorPatterns...)
+}
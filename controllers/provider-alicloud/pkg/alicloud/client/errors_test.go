@@ -0,0 +1,51 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client_test
+
+import (
+	"errors"
+
+	. "github.com/gardener/gardener-extensions/controllers/provider-alicloud/pkg/alicloud/client"
+
+	alicloudsdkerrors "github.com/aliyun/alibaba-cloud-sdk-go/sdk/errors"
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	pkgerrors "github.com/pkg/errors"
+)
+
+var _ = Describe("Errors", func() {
+	Describe("#ErrorClassifier", func() {
+		It("should return nil for nil errors", func() {
+			Expect(ErrorClassifier.Classify(nil)).To(BeNil())
+		})
+
+		It("should classify the codes of wrapped Alicloud errors", func() {
+			err := pkgerrors.Wrap(alicloudsdkerrors.NewClientError("InvalidAccessKeyId.NotFound", "Specified access key is not found. QuotaExceeded", nil), "could not describe VPCs")
+
+			Expect(ErrorClassifier.Classify(err)).To(Equal([]gardencorev1alpha1.ErrorCode{gardencorev1alpha1.ErrorInfraUnauthorized}))
+		})
+
+		It("should fall back to the error message", func() {
+			err := errors.New("Error creating VSwitch: Forbidden.RAM: User not authorized to operate on the specified resource.")
+
+			Expect(ErrorClassifier.Classify(err)).To(Equal([]gardencorev1alpha1.ErrorCode{gardencorev1alpha1.ErrorInfraInsufficientPrivileges}))
+		})
+
+		It("should return nil if no code can be determined", func() {
+			Expect(ErrorClassifier.Classify(errors.New("foo"))).To(BeNil())
+		})
+	})
+})
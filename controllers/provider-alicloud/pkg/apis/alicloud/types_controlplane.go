@@ -16,6 +16,7 @@ package alicloud
 
 import (
 	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -27,9 +28,33 @@ type ControlPlaneConfig struct {
 
 	// CloudControllerManager contains configuration settings for the cloud-controller-manager.
 	CloudControllerManager *CloudControllerManagerConfig
+
+	// CloudProviderConfig contains settings for the cloud provider configuration used by the Kubernetes components.
+	CloudProviderConfig *CloudProviderConfig
 }
 
 // CloudControllerManagerConfig contains configuration settings for the cloud-controller-manager.
 type CloudControllerManagerConfig struct {
 	gardenv1beta1.KubernetesConfig
+
+	// RouteReconciliationPeriod is the period for reconciling the routes created for nodes by the cloud provider.
+	RouteReconciliationPeriod *metav1.Duration
+	// NodeMonitorPeriod is the period for syncing the status of the nodes.
+	NodeMonitorPeriod *metav1.Duration
+	// NodeStatusUpdateFrequency is the frequency in which the node status is updated from the cloud provider.
+	NodeStatusUpdateFrequency *metav1.Duration
+	// ConcurrentServiceSyncs is the number of services that are allowed to sync concurrently.
+	ConcurrentServiceSyncs *int32
+	// Resources are the compute resource requirements of the cloud-controller-manager. Requests and limits that are
+	// not given keep their default values.
+	Resources *corev1.ResourceRequirements
+	// Verbosity is the log level verbosity of the cloud-controller-manager.
+	Verbosity *int32
+}
+
+// CloudProviderConfig contains settings for the cloud provider configuration used by the Kubernetes components.
+type CloudProviderConfig struct {
+	// DisablePublicSLB specifies whether the creation of internet-facing server load balancers is disabled, i.e.
+	// whether all services of type LoadBalancer get intranet server load balancers.
+	DisablePublicSLB *bool
 }
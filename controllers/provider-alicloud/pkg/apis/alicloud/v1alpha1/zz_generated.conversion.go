@@ -25,6 +25,8 @@ import (
 
 	alicloud "github.com/gardener/gardener-extensions/controllers/provider-alicloud/pkg/apis/alicloud"
 	corev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	conversion "k8s.io/apimachinery/pkg/conversion"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
@@ -46,6 +48,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*CloudProviderConfig)(nil), (*alicloud.CloudProviderConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_CloudProviderConfig_To_alicloud_CloudProviderConfig(a.(*CloudProviderConfig), b.(*alicloud.CloudProviderConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*alicloud.CloudProviderConfig)(nil), (*CloudProviderConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_alicloud_CloudProviderConfig_To_v1alpha1_CloudProviderConfig(a.(*alicloud.CloudProviderConfig), b.(*CloudProviderConfig), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ControlPlaneConfig)(nil), (*alicloud.ControlPlaneConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_ControlPlaneConfig_To_alicloud_ControlPlaneConfig(a.(*ControlPlaneConfig), b.(*alicloud.ControlPlaneConfig), scope)
 	}); err != nil {
@@ -141,6 +153,12 @@ func RegisterConversions(s *runtime.Scheme) error {
 
 func autoConvert_v1alpha1_CloudControllerManagerConfig_To_alicloud_CloudControllerManagerConfig(in *CloudControllerManagerConfig, out *alicloud.CloudControllerManagerConfig, s conversion.Scope) error {
 	out.KubernetesConfig = in.KubernetesConfig
+	out.RouteReconciliationPeriod = (*v1.Duration)(unsafe.Pointer(in.RouteReconciliationPeriod))
+	out.NodeMonitorPeriod = (*v1.Duration)(unsafe.Pointer(in.NodeMonitorPeriod))
+	out.NodeStatusUpdateFrequency = (*v1.Duration)(unsafe.Pointer(in.NodeStatusUpdateFrequency))
+	out.ConcurrentServiceSyncs = (*int32)(unsafe.Pointer(in.ConcurrentServiceSyncs))
+	out.Resources = (*corev1.ResourceRequirements)(unsafe.Pointer(in.Resources))
+	out.Verbosity = (*int32)(unsafe.Pointer(in.Verbosity))
 	return nil
 }
 
@@ -151,6 +169,12 @@ func Convert_v1alpha1_CloudControllerManagerConfig_To_alicloud_CloudControllerMa
 
 func autoConvert_alicloud_CloudControllerManagerConfig_To_v1alpha1_CloudControllerManagerConfig(in *alicloud.CloudControllerManagerConfig, out *CloudControllerManagerConfig, s conversion.Scope) error {
 	out.KubernetesConfig = in.KubernetesConfig
+	out.RouteReconciliationPeriod = (*v1.Duration)(unsafe.Pointer(in.RouteReconciliationPeriod))
+	out.NodeMonitorPeriod = (*v1.Duration)(unsafe.Pointer(in.NodeMonitorPeriod))
+	out.NodeStatusUpdateFrequency = (*v1.Duration)(unsafe.Pointer(in.NodeStatusUpdateFrequency))
+	out.ConcurrentServiceSyncs = (*int32)(unsafe.Pointer(in.ConcurrentServiceSyncs))
+	out.Resources = (*corev1.ResourceRequirements)(unsafe.Pointer(in.Resources))
+	out.Verbosity = (*int32)(unsafe.Pointer(in.Verbosity))
 	return nil
 }
 
@@ -159,8 +183,29 @@ func Convert_alicloud_CloudControllerManagerConfig_To_v1alpha1_CloudControllerMa
 	return autoConvert_alicloud_CloudControllerManagerConfig_To_v1alpha1_CloudControllerManagerConfig(in, out, s)
 }
 
+func autoConvert_v1alpha1_CloudProviderConfig_To_alicloud_CloudProviderConfig(in *CloudProviderConfig, out *alicloud.CloudProviderConfig, s conversion.Scope) error {
+	out.DisablePublicSLB = (*bool)(unsafe.Pointer(in.DisablePublicSLB))
+	return nil
+}
+
+// Convert_v1alpha1_CloudProviderConfig_To_alicloud_CloudProviderConfig is an autogenerated conversion function.
+func Convert_v1alpha1_CloudProviderConfig_To_alicloud_CloudProviderConfig(in *CloudProviderConfig, out *alicloud.CloudProviderConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_CloudProviderConfig_To_alicloud_CloudProviderConfig(in, out, s)
+}
+
+func autoConvert_alicloud_CloudProviderConfig_To_v1alpha1_CloudProviderConfig(in *alicloud.CloudProviderConfig, out *CloudProviderConfig, s conversion.Scope) error {
+	out.DisablePublicSLB = (*bool)(unsafe.Pointer(in.DisablePublicSLB))
+	return nil
+}
+
+// Convert_alicloud_CloudProviderConfig_To_v1alpha1_CloudProviderConfig is an autogenerated conversion function.
+func Convert_alicloud_CloudProviderConfig_To_v1alpha1_CloudProviderConfig(in *alicloud.CloudProviderConfig, out *CloudProviderConfig, s conversion.Scope) error {
+	return autoConvert_alicloud_CloudProviderConfig_To_v1alpha1_CloudProviderConfig(in, out, s)
+}
+
 func autoConvert_v1alpha1_ControlPlaneConfig_To_alicloud_ControlPlaneConfig(in *ControlPlaneConfig, out *alicloud.ControlPlaneConfig, s conversion.Scope) error {
 	out.CloudControllerManager = (*alicloud.CloudControllerManagerConfig)(unsafe.Pointer(in.CloudControllerManager))
+	out.CloudProviderConfig = (*alicloud.CloudProviderConfig)(unsafe.Pointer(in.CloudProviderConfig))
 	return nil
 }
 
@@ -171,6 +216,7 @@ func Convert_v1alpha1_ControlPlaneConfig_To_alicloud_ControlPlaneConfig(in *Cont
 
 func autoConvert_alicloud_ControlPlaneConfig_To_v1alpha1_ControlPlaneConfig(in *alicloud.ControlPlaneConfig, out *ControlPlaneConfig, s conversion.Scope) error {
 	out.CloudControllerManager = (*CloudControllerManagerConfig)(unsafe.Pointer(in.CloudControllerManager))
+	out.CloudProviderConfig = (*CloudProviderConfig)(unsafe.Pointer(in.CloudProviderConfig))
 	return nil
 }
 
@@ -0,0 +1,83 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation_test
+
+import (
+	apisalicloud "github.com/gardener/gardener-extensions/controllers/provider-alicloud/pkg/apis/alicloud"
+	. "github.com/gardener/gardener-extensions/controllers/provider-alicloud/pkg/apis/alicloud/validation"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+var _ = Describe("InfrastructureConfig validation", func() {
+	var (
+		fldPath = field.NewPath("spec", "providerConfig")
+		infra   *apisalicloud.InfrastructureConfig
+	)
+
+	BeforeEach(func() {
+		vpcCIDR := gardencorev1alpha1.CIDR("10.250.0.0/16")
+		infra = &apisalicloud.InfrastructureConfig{
+			Networks: apisalicloud.Networks{
+				VPC: apisalicloud.VPC{CIDR: &vpcCIDR},
+				Zones: []apisalicloud.Zone{
+					{Name: "eu-central-1a", Worker: "10.250.0.0/19"},
+					{Name: "eu-central-1b", Worker: "10.250.32.0/19"},
+				},
+			},
+		}
+	})
+
+	It("should accept a valid config", func() {
+		Expect(ValidateInfrastructureConfig(infra, fldPath)).To(BeEmpty())
+	})
+
+	It("should require either a VPC id or cidr", func() {
+		infra.Networks.VPC = apisalicloud.VPC{}
+
+		Expect(ValidateInfrastructureConfig(infra, fldPath)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+			"Type":  Equal(field.ErrorTypeRequired),
+			"Field": Equal("spec.providerConfig.networks.vpc"),
+		}))))
+	})
+
+	It("should reject duplicate zones and overlapping worker cidrs", func() {
+		infra.Networks.Zones[1] = infra.Networks.Zones[0]
+
+		Expect(ValidateInfrastructureConfig(infra, fldPath)).To(ConsistOf(
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeDuplicate),
+				"Field": Equal("spec.providerConfig.networks.zones[1].name"),
+			})),
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.providerConfig.networks.zones[1].worker"),
+			})),
+		))
+	})
+
+	It("should reject worker cidrs outside of the VPC", func() {
+		infra.Networks.Zones[0].Worker = "10.251.0.0/19"
+
+		Expect(ValidateInfrastructureConfig(infra, fldPath)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+			"Type":  Equal(field.ErrorTypeInvalid),
+			"Field": Equal("spec.providerConfig.networks.zones[0].worker"),
+		}))))
+	})
+})
@@ -35,10 +35,18 @@ func NewTerraformer(factory terraformer.Factory, config *rest.Config, credential
 		return nil, err
 	}
 
-	variablesEnvironment := map[string]string{
+	return tf.SetVariablesEnvironment(TerraformVariablesEnvironment(credentials)), nil
+}
+
+// TerraformVariablesEnvironment computes the Terraformer variables environment from the given credentials.
+func TerraformVariablesEnvironment(credentials *alicloud.Credentials) map[string]string {
+	return map[string]string{
 		TerraformVarAccessKeyID:     credentials.AccessKeyID,
 		TerraformVarAccessKeySecret: credentials.AccessKeySecret,
 	}
+}
 
-	return tf.SetVariablesEnvironment(variablesEnvironment), nil
+// NewPlanner creates a new Planner for the Terraform configuration of the given purpose, namespace and name.
+func NewPlanner(config *rest.Config, purpose, namespace, name string) (terraformer.Planner, error) {
+	return terraformer.NewPlannerForConfig(logger.NewLogger("info"), config, purpose, namespace, name, imagevector.TerraformerImage())
 }
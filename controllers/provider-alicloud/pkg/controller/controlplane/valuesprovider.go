@@ -211,6 +211,12 @@ func (vp *valuesProvider) GetConfigChartValues(
 	cp *extensionsv1alpha1.ControlPlane,
 	cluster *extensionscontroller.Cluster,
 ) (map[string]interface{}, error) {
+	// Decode providerConfig
+	cpConfig := &apisalicloud.ControlPlaneConfig{}
+	if _, _, err := vp.decoder.Decode(cp.Spec.ProviderConfig.Raw, nil, cpConfig); err != nil {
+		return nil, errors.Wrapf(err, "could not decode providerConfig of controlplane '%s'", util.ObjectName(cp))
+	}
+
 	// Decode infrastructureProviderStatus
 	infraStatus := &apisalicloud.InfrastructureStatus{}
 	if _, _, err := vp.decoder.Decode(cp.Spec.InfrastructureProviderStatus.Raw, nil, infraStatus); err != nil {
@@ -224,7 +230,7 @@ func (vp *valuesProvider) GetConfigChartValues(
 	}
 
 	// Get config chart values
-	return getConfigChartValues(infraStatus, cp, credentials)
+	return getConfigChartValues(cpConfig, infraStatus, cp, credentials)
 }
 
 // GetControlPlaneChartValues returns the values for the control plane chart applied by the generic actuator.
@@ -289,11 +295,14 @@ type cloudConfig struct {
 
 		AccessKeyID     string `json:"accessKeyID"`
 		AccessKeySecret string `json:"accessKeySecret"`
+
+		DisablePublicSLB bool `json:"disablePublicSLB,omitempty"`
 	}
 }
 
 // getConfigChartValues collects and returns the configuration chart values.
 func getConfigChartValues(
+	cpConfig *apisalicloud.ControlPlaneConfig,
 	infraStatus *apisalicloud.InfrastructureStatus,
 	cp *extensionsv1alpha1.ControlPlane,
 	credentials *alicloud.Credentials,
@@ -314,6 +323,10 @@ func getConfigChartValues(
 	cfg.Global.AccessKeySecret = base64.StdEncoding.EncodeToString([]byte(credentials.AccessKeySecret))
 	cfg.Global.Region = cp.Spec.Region
 
+	if config := cpConfig.CloudProviderConfig; config != nil && config.DisablePublicSLB != nil {
+		cfg.Global.DisablePublicSLB = *config.DisablePublicSLB
+	}
+
 	cfgJSON, err := json.Marshal(cfg)
 	if err != nil {
 		return nil, errors.Wrapf(err, "could not marshal cloud config to JSON for controlplane '%s'", util.ObjectName(cp))
@@ -359,8 +372,27 @@ func getControlPlaneChartValues(
 		},
 	}
 
-	if cpConfig.CloudControllerManager != nil {
-		values["alicloud-cloud-controller-manager"].(map[string]interface{})["featureGates"] = cpConfig.CloudControllerManager.FeatureGates
+	if ccm := cpConfig.CloudControllerManager; ccm != nil {
+		ccmValues := values["alicloud-cloud-controller-manager"].(map[string]interface{})
+		ccmValues["featureGates"] = ccm.FeatureGates
+		if ccm.RouteReconciliationPeriod != nil {
+			ccmValues["routeReconciliationPeriod"] = ccm.RouteReconciliationPeriod.Duration.String()
+		}
+		if ccm.NodeMonitorPeriod != nil {
+			ccmValues["nodeMonitorPeriod"] = ccm.NodeMonitorPeriod.Duration.String()
+		}
+		if ccm.NodeStatusUpdateFrequency != nil {
+			ccmValues["nodeStatusUpdateFrequency"] = ccm.NodeStatusUpdateFrequency.Duration.String()
+		}
+		if ccm.ConcurrentServiceSyncs != nil {
+			ccmValues["concurrentServiceSyncs"] = *ccm.ConcurrentServiceSyncs
+		}
+		if ccm.Verbosity != nil {
+			ccmValues["verbosity"] = *ccm.Verbosity
+		}
+		if ccm.Resources != nil {
+			ccmValues["resources"] = ccm.Resources
+		}
 	}
 
 	return values, nil
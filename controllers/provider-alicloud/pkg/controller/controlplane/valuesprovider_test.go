@@ -17,11 +17,13 @@ package controlplane
 import (
 	"context"
 	"encoding/json"
+	"time"
 
 	"github.com/gardener/gardener-extensions/controllers/provider-alicloud/pkg/alicloud"
 	apisalicloud "github.com/gardener/gardener-extensions/controllers/provider-alicloud/pkg/apis/alicloud"
 	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
 	mockclient "github.com/gardener/gardener-extensions/pkg/mock/controller-runtime/client"
+	"github.com/gardener/gardener-extensions/pkg/util"
 
 	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
@@ -31,6 +33,7 @@ import (
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -69,6 +72,15 @@ var _ = Describe("ValuesProvider", func() {
 									"CustomResourceValidation": true,
 								},
 							},
+							RouteReconciliationPeriod: &metav1.Duration{Duration: 30 * time.Second},
+							ConcurrentServiceSyncs:    util.Int32Ptr(5),
+							Resources: &corev1.ResourceRequirements{
+								Limits: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("1Gi")},
+							},
+							Verbosity: util.Int32Ptr(4),
+						},
+						CloudProviderConfig: &apisalicloud.CloudProviderConfig{
+							DisablePublicSLB: util.BoolPtr(true),
 						},
 					}),
 				},
@@ -132,7 +144,7 @@ var _ = Describe("ValuesProvider", func() {
 		}
 
 		configChartValues = map[string]interface{}{
-			"cloudConfig": `{"Global":{"KubernetesClusterTag":"test","uid":"","vpcid":"vpc-1234","region":"eu-central-1","zoneid":"eu-central-1a","vswitchid":"vswitch-acbd1234","accessKeyID":"Zm9v","accessKeySecret":"YmFy"}}`,
+			"cloudConfig": `{"Global":{"KubernetesClusterTag":"test","uid":"","vpcid":"vpc-1234","region":"eu-central-1","zoneid":"eu-central-1a","vswitchid":"vswitch-acbd1234","accessKeyID":"Zm9v","accessKeySecret":"YmFy","disablePublicSLB":true}}`,
 		}
 
 		controlPlaneChartValues = map[string]interface{}{
@@ -149,6 +161,12 @@ var _ = Describe("ValuesProvider", func() {
 				"featureGates": map[string]bool{
 					"CustomResourceValidation": true,
 				},
+				"routeReconciliationPeriod": "30s",
+				"concurrentServiceSyncs":    int32(5),
+				"resources": &corev1.ResourceRequirements{
+					Limits: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("1Gi")},
+				},
+				"verbosity": int32(4),
 			},
 			"csi-alicloud": map[string]interface{}{
 				"replicas":          1,
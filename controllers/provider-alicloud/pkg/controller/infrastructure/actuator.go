@@ -32,6 +32,7 @@ import (
 	extensionsterraformer "github.com/gardener/gardener-extensions/pkg/gardener/terraformer"
 	chartutil "github.com/gardener/gardener-extensions/pkg/util/chart"
 
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/gardener/gardener/pkg/chartrenderer"
 	"github.com/gardener/gardener/pkg/operation/terraformer"
@@ -75,6 +76,8 @@ func NewActuatorWithDeps(
 	terraformChartOps TerraformChartOps,
 ) infrastructure.Actuator {
 	a := &actuator{
+		TerraformerMigrator: infrastructure.NewTerraformerMigrator(TerraformerPurpose),
+
 		logger: logger,
 
 		alicloudClientFactory: alicloudClientFactory,
@@ -87,6 +90,8 @@ func NewActuatorWithDeps(
 }
 
 type actuator struct {
+	infrastructure.TerraformerMigrator
+
 	decoder runtime.Decoder
 	logger  logr.Logger
 
@@ -109,7 +114,7 @@ func (a *actuator) InjectScheme(scheme *runtime.Scheme) error {
 // InjectClient implements inject.Client.
 func (a *actuator) InjectClient(client client.Client) error {
 	a.client = client
-	return nil
+	return a.TerraformerMigrator.InjectClient(client)
 }
 
 // InjectConfig implements inject.Config.
@@ -293,23 +298,97 @@ func (a *actuator) Reconcile(ctx context.Context, infra *extensionsv1alpha1.Infr
 		return err
 	}
 
-	if err := tf.InitializeWith(initializer).Apply(); err != nil {
+	extensioncontroller.ReportProgress(ctx, 10, "Initializing the Terraform configuration and state")
+	tf = tf.InitializeWith(initializer)
+
+	extensioncontroller.ReportProgress(ctx, 20, "Applying the Terraform configuration")
+	if err := tf.Apply(); err != nil {
 		a.logger.Error(err, "failed to apply the terraform config", "infrastructure", infra.Name)
+		extensioncontroller.SetCondition(ctx, extensioncontroller.ConditionTypeInfrastructureReady, gardencorev1alpha1.ConditionFalse, "TerraformApplyFailed", err.Error())
 		return &controllererrors.RequeueAfterError{
 			Cause:        err,
 			RequeueAfter: 30 * time.Second,
 		}
 	}
 
+	extensioncontroller.ReportProgress(ctx, 90, "Extracting the infrastructure provider status from the Terraform state")
 	status, err := a.extractStatus(tf, config)
 	if err != nil {
 		return err
 	}
 
-	return extensioncontroller.TryUpdateStatus(ctx, retry.DefaultBackoff, a.client, infra, func() error {
+	if err := extensioncontroller.TryUpdateStatus(ctx, retry.DefaultBackoff, a.client, infra, func() error {
 		infra.Status.ProviderStatus = &runtime.RawExtension{Object: status}
 		return nil
-	})
+	}); err != nil {
+		return err
+	}
+
+	extensioncontroller.SetCondition(ctx, extensioncontroller.ConditionTypeInfrastructureReady, gardencorev1alpha1.ConditionTrue, "InfrastructureReconciled", "The infrastructure has been reconciled.")
+	return nil
+}
+
+// Plan implements infrastructure.Planner.
+func (a *actuator) Plan(ctx context.Context, infra *extensionsv1alpha1.Infrastructure, cluster *extensioncontroller.Cluster) (*extensionsterraformer.Plan, error) {
+	config, credentials, err := a.getConfigAndCredentialsForInfra(ctx, infra)
+	if err != nil {
+		return nil, err
+	}
+
+	tf, err := a.newTerraformer(infra, credentials)
+	if err != nil {
+		return nil, err
+	}
+
+	initializerValues, err := a.getInitializerValues(tf, infra, config, credentials)
+	if err != nil {
+		return nil, err
+	}
+
+	initializer, err := a.newInitializer(infra, config, initializerValues)
+	if err != nil {
+		return nil, err
+	}
+
+	planner, err := common.NewPlanner(a.config, TerraformerPurpose, infra.Namespace, infra.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	runPlan := planner.Plan
+	if infra.DeletionTimestamp != nil {
+		runPlan = planner.PlanDestroy
+	}
+
+	return runPlan(ctx, initializer, common.TerraformVariablesEnvironment(credentials))
+}
+
+// DetectDrift implements infrastructure.DriftDetector.
+func (a *actuator) DetectDrift(ctx context.Context, infra *extensionsv1alpha1.Infrastructure, cluster *extensioncontroller.Cluster) ([]string, error) {
+	config, credentials, err := a.getConfigAndCredentialsForInfra(ctx, infra)
+	if err != nil {
+		return nil, err
+	}
+
+	tf, err := a.newTerraformer(infra, credentials)
+	if err != nil {
+		return nil, err
+	}
+
+	stateVariables, err := tf.GetStateOutputVariables(TerraformerOutputKeyVPCID, TerraformerOutputKeyVPCCIDR)
+	if err != nil {
+		if apierrors.IsNotFound(err) || terraformer.IsVariablesNotFoundError(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	vpcClient, err := a.alicloudClientFactory.NewVPC(infra.Spec.Region, credentials.AccessKeyID, credentials.AccessKeySecret)
+	if err != nil {
+		return nil, err
+	}
+
+	return DetectVPCDrift(vpcClient, stateVariables[TerraformerOutputKeyVPCID], stateVariables[TerraformerOutputKeyVPCCIDR], config.Networks.VPC.ID == nil)
 }
 
 // Delete implements infrastructure.Actuator.
@@ -101,3 +101,40 @@ func FetchEIPInternetChargeType(vpcClient alicloudclient.VPC, vpcID string) (str
 
 	return eipResp.EipAddresses.EipAddress[0].InternetChargeType, nil
 }
+
+// DetectVPCDrift compares the VPC with the given ID and CIDR from the Terraform state with the live VPC.
+// If createdVPC is true, the VPC is expected to contain the NAT gateway that was created along with it.
+// It returns a description for each drifted resource.
+func DetectVPCDrift(vpcClient alicloudclient.VPC, vpcID, vpcCIDR string, createdVPC bool) ([]string, error) {
+	describeVPCsReq := vpc.CreateDescribeVpcsRequest()
+	describeVPCsReq.VpcId = vpcID
+	describeVPCsRes, err := vpcClient.DescribeVpcs(describeVPCsReq)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(describeVPCsRes.Vpcs.Vpc) == 0 {
+		// All other resources live in the VPC, hence there is no need to check them.
+		return []string{fmt.Sprintf("VPC %s does not exist", vpcID)}, nil
+	}
+
+	var drift []string
+	if cidr := describeVPCsRes.Vpcs.Vpc[0].CidrBlock; cidr != vpcCIDR {
+		drift = append(drift, fmt.Sprintf("VPC %s has CIDR %s instead of %s", vpcID, cidr, vpcCIDR))
+	}
+
+	if createdVPC {
+		describeNATGatewaysReq := vpc.CreateDescribeNatGatewaysRequest()
+		describeNATGatewaysReq.VpcId = vpcID
+		describeNatGatewaysRes, err := vpcClient.DescribeNatGateways(describeNATGatewaysReq)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(describeNatGatewaysRes.NatGateways.NatGateway) == 0 {
+			drift = append(drift, fmt.Sprintf("NAT gateway of VPC %s does not exist", vpcID))
+		}
+	}
+
+	return drift, nil
+}
@@ -88,4 +88,73 @@ var _ = Describe("Client", func() {
 			}))
 		})
 	})
+
+	Describe("#DetectVPCDrift", func() {
+		var (
+			client  *mockclient.MockVPC
+			vpcID   = "vpcID"
+			vpcCIDR = "vpcCIDR"
+
+			describeVPCsReq        *vpc.DescribeVpcsRequest
+			describeNATGatewaysReq *vpc.DescribeNatGatewaysRequest
+		)
+		BeforeEach(func() {
+			client = mockclient.NewMockVPC(ctrl)
+
+			describeVPCsReq = vpc.CreateDescribeVpcsRequest()
+			describeVPCsReq.VpcId = vpcID
+
+			describeNATGatewaysReq = vpc.CreateDescribeNatGatewaysRequest()
+			describeNATGatewaysReq.VpcId = vpcID
+		})
+
+		It("should not report drift if the VPC and its NAT gateway exist", func() {
+			gomock.InOrder(
+				client.EXPECT().DescribeVpcs(describeVPCsReq).Return(&vpc.DescribeVpcsResponse{
+					Vpcs: vpc.Vpcs{Vpc: []vpc.Vpc{{CidrBlock: vpcCIDR}}},
+				}, nil),
+				client.EXPECT().DescribeNatGateways(describeNATGatewaysReq).Return(&vpc.DescribeNatGatewaysResponse{
+					NatGateways: vpc.NatGateways{NatGateway: []vpc.NatGateway{{NatGatewayId: "natGatewayID"}}},
+				}, nil),
+			)
+
+			drift, err := DetectVPCDrift(client, vpcID, vpcCIDR, true)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(drift).To(BeEmpty())
+		})
+
+		It("should report drift if the VPC does not exist", func() {
+			client.EXPECT().DescribeVpcs(describeVPCsReq).Return(&vpc.DescribeVpcsResponse{}, nil)
+
+			drift, err := DetectVPCDrift(client, vpcID, vpcCIDR, true)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(drift).To(ConsistOf("VPC vpcID does not exist"))
+		})
+
+		It("should report drift if the CIDR has changed and the NAT gateway does not exist", func() {
+			gomock.InOrder(
+				client.EXPECT().DescribeVpcs(describeVPCsReq).Return(&vpc.DescribeVpcsResponse{
+					Vpcs: vpc.Vpcs{Vpc: []vpc.Vpc{{CidrBlock: "otherCIDR"}}},
+				}, nil),
+				client.EXPECT().DescribeNatGateways(describeNATGatewaysReq).Return(&vpc.DescribeNatGatewaysResponse{}, nil),
+			)
+
+			drift, err := DetectVPCDrift(client, vpcID, vpcCIDR, true)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(drift).To(ConsistOf(
+				"VPC vpcID has CIDR otherCIDR instead of vpcCIDR",
+				"NAT gateway of VPC vpcID does not exist",
+			))
+		})
+
+		It("should not check the NAT gateway of an existing VPC", func() {
+			client.EXPECT().DescribeVpcs(describeVPCsReq).Return(&vpc.DescribeVpcsResponse{
+				Vpcs: vpc.Vpcs{Vpc: []vpc.Vpc{{CidrBlock: vpcCIDR}}},
+			}, nil)
+
+			drift, err := DetectVPCDrift(client, vpcID, vpcCIDR, false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(drift).To(BeEmpty())
+		})
+	})
 })
@@ -23,9 +23,11 @@ import (
 	alicloudapi "github.com/gardener/gardener-extensions/controllers/provider-alicloud/pkg/apis/alicloud"
 	alicloudapihelper "github.com/gardener/gardener-extensions/controllers/provider-alicloud/pkg/apis/alicloud/helper"
 	confighelper "github.com/gardener/gardener-extensions/controllers/provider-alicloud/pkg/apis/config/helper"
+	workerhelper "github.com/gardener/gardener-extensions/pkg/apis/worker/helper"
 	"github.com/gardener/gardener-extensions/pkg/controller/worker"
 	"github.com/gardener/gardener-extensions/pkg/util"
 
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
 	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
 	"k8s.io/apimachinery/pkg/runtime"
 )
@@ -40,6 +42,11 @@ func (w *workerDelegate) MachineClassList() runtime.Object {
 	return &machinev1alpha1.AlicloudMachineClassList{}
 }
 
+// MachineClassReplacementFields yields the fields of the Alicloud machine class specs whose changes require new machines.
+func (w *workerDelegate) MachineClassReplacementFields() []string {
+	return []string{"imageID", "instanceType", "region", "zoneID", "securityGroupID", "vSwitchID", "systemDisk", "instanceChargeType", "internetChargeType", "internetMaxBandwidthIn", "internetMaxBandwidthOut", "spotStrategy", "keyPairName", "secret"}
+}
+
 // DeployMachineClasses generates and creates the Alicloud specific machine classes.
 func (w *workerDelegate) DeployMachineClasses(ctx context.Context) error {
 	if w.machineClasses == nil {
@@ -88,6 +95,11 @@ func (w *workerDelegate) generateMachineConfig(ctx context.Context) error {
 		return err
 	}
 
+	classNamesInUse, err := worker.MachineClassNamesInUse(ctx, w.client, w.worker.Namespace)
+	if err != nil {
+		return err
+	}
+
 	shootVersionMajorMinor, err := util.VersionMajorMinor(w.cluster.Shoot.Spec.Kubernetes.Version)
 	if err != nil {
 		return err
@@ -104,13 +116,45 @@ func (w *workerDelegate) generateMachineConfig(ctx context.Context) error {
 	}
 
 	for _, pool := range w.worker.Spec.Pools {
-		zoneLen := len(pool.Zones)
+		workerConfig, err := workerhelper.WorkerConfigFromPool(pool)
+		if err != nil {
+			return err
+		}
+
+		dataVolumes := worker.DataVolumesFromConfig(workerConfig)
+		if len(dataVolumes) > 0 {
+			return fmt.Errorf("pool %q: data volumes are not supported on Alicloud by the used machine-controller-manager", pool.Name)
+		}
+
+		autoscalerOptions := worker.AutoscalerOptionsFromConfig(workerConfig)
+
+		spotSettings := worker.SpotSettingsFromConfig(workerConfig)
+		if spotSettings != nil && spotSettings.MaxPrice != nil {
+			return fmt.Errorf("pool %q: a maximum price is not supported for spot instances on Alicloud", pool.Name)
+		}
+		labels, taints := spotSettings.SpotLabels(pool.Labels), spotSettings.SpotTaints(pool.Taints)
+		nodeTemplate := worker.NodeTemplateForMachineType(w.machineTypes(), pool.MachineType, labels, taints)
+
+		poolMachineSettings := worker.MachineSettingsFromConfig(workerConfig)
+		machineSettings := poolMachineSettings.WithDefaults(w.defaultMachineSettings())
 
-		machineImage, err := confighelper.FindImage(w.machineImages, pool.MachineImage.Name, pool.MachineImage.Version)
+		zoneDistribution, err := worker.ZoneDistributionFromPool(pool, workerConfig)
 		if err != nil {
 			return err
 		}
 
+		machineImage, err := confighelper.FindMachineImage(w.machineImages, pool.MachineImage.Name, pool.MachineImage.Version)
+		if err != nil {
+			return err
+		}
+		usedMachineImage := &worker.MachineImage{
+			Name:            machineImage.Name,
+			Version:         machineImage.Version,
+			ID:              machineImage.ID,
+			DeprecationDate: machineImage.DeprecationDate,
+			ExpirationDate:  machineImage.ExpirationDate,
+		}
+
 		volumeSize, err := worker.DiskSize(pool.Volume.Size)
 		if err != nil {
 			return err
@@ -123,7 +167,7 @@ func (w *workerDelegate) generateMachineConfig(ctx context.Context) error {
 			}
 
 			machineClassSpec := map[string]interface{}{
-				"imageID":         machineImage,
+				"imageID":         machineImage.ID,
 				"instanceType":    pool.MachineType,
 				"region":          w.worker.Spec.Region,
 				"zoneID":          zone,
@@ -137,7 +181,7 @@ func (w *workerDelegate) generateMachineConfig(ctx context.Context) error {
 				"internetChargeType":      "PayByTraffic",
 				"internetMaxBandwidthIn":  5,
 				"internetMaxBandwidthOut": 5,
-				"spotStrategy":            "NoSpot",
+				"spotStrategy":            spotStrategy(spotSettings),
 				"tags": map[string]string{
 					fmt.Sprintf("kubernetes.io/cluster/%s", w.worker.Namespace):     "1",
 					fmt.Sprintf("kubernetes.io/role/worker/%s", w.worker.Namespace): "1",
@@ -149,22 +193,27 @@ func (w *workerDelegate) generateMachineConfig(ctx context.Context) error {
 			}
 
 			var (
-				machineClassSpecHash = worker.MachineClassHash(machineClassSpec, shootVersionMajorMinor)
-				deploymentName       = fmt.Sprintf("%s-%s-%s", w.worker.Namespace, pool.Name, zone)
-				className            = fmt.Sprintf("%s-%s", deploymentName, machineClassSpecHash)
+				deploymentName = fmt.Sprintf("%s-%s-%s", w.worker.Namespace, pool.Name, zone)
+				className      = worker.MachineClassName(deploymentName, machineClassSpec, shootVersionMajorMinor, classNamesInUse, w.MachineClassReplacementFields()...)
 			)
 
 			machineDeployments = append(machineDeployments, worker.MachineDeployment{
-				Name:           deploymentName,
-				ClassName:      className,
-				SecretName:     className,
-				Minimum:        worker.DistributeOverZones(zoneIndex, pool.Minimum, zoneLen),
-				Maximum:        worker.DistributeOverZones(zoneIndex, pool.Maximum, zoneLen),
-				MaxSurge:       worker.DistributePositiveIntOrPercent(zoneIndex, pool.MaxSurge, zoneLen, pool.Maximum),
-				MaxUnavailable: worker.DistributePositiveIntOrPercent(zoneIndex, pool.MaxUnavailable, zoneLen, pool.Minimum),
-				Labels:         pool.Labels,
-				Annotations:    pool.Annotations,
-				Taints:         pool.Taints,
+				Name:            deploymentName,
+				PoolName:        pool.Name,
+				Zone:            zone,
+				ClassName:       className,
+				SecretName:      className,
+				Minimum:         zoneDistribution.Minimum(zoneIndex),
+				Maximum:         zoneDistribution.Maximum(zoneIndex),
+				MaxSurge:        zoneDistribution.MaxSurge(zoneIndex),
+				MaxUnavailable:  zoneDistribution.MaxUnavailable(zoneIndex),
+				Labels:          labels,
+				Annotations:     pool.Annotations,
+				Taints:          taints,
+				Autoscaler:      autoscalerOptions,
+				NodeTemplate:    nodeTemplate,
+				MachineSettings: machineSettings,
+				MachineImage:    usedMachineImage,
 			})
 
 			machineClassSpec["name"] = className
@@ -180,3 +229,35 @@ func (w *workerDelegate) generateMachineConfig(ctx context.Context) error {
 
 	return nil
 }
+
+// spotStrategy returns the spot strategy of the machine class. Spot instances are bid for at the current market
+// price, a price limit is not supported by the machine-controller-manager.
+func spotStrategy(spotSettings *worker.SpotSettings) string {
+	if spotSettings != nil {
+		return "SpotAsPriceGo"
+	}
+	return "NoSpot"
+}
+
+// machineTypes returns the machine types of the Alicloud section of the CloudProfile, if any.
+func (w *workerDelegate) machineTypes() []gardenv1beta1.MachineType {
+	if w.cluster.CloudProfile == nil || w.cluster.CloudProfile.Spec.Alicloud == nil {
+		return nil
+	}
+	var machineTypes []gardenv1beta1.MachineType
+	for _, machineType := range w.cluster.CloudProfile.Spec.Alicloud.Constraints.MachineTypes {
+		machineTypes = append(machineTypes, machineType.MachineType)
+	}
+	return machineTypes
+}
+
+// defaultMachineSettings returns the machine settings of the controller configuration, which are used for all
+// worker pools that do not overwrite them.
+func (w *workerDelegate) defaultMachineSettings() worker.MachineSettings {
+	return worker.MachineSettings{
+		DrainTimeout:    w.machineSettings.DrainTimeout,
+		HealthTimeout:   w.machineSettings.HealthTimeout,
+		CreationTimeout: w.machineSettings.CreationTimeout,
+		MaxEvictRetries: w.machineSettings.MaxEvictRetries,
+	}
+}
@@ -62,7 +62,7 @@ var _ = Describe("Machines", func() {
 	})
 
 	Context("workerDelegate", func() {
-		workerDelegate := NewWorkerDelegate(nil, nil, nil, nil, "", nil, nil)
+		workerDelegate := NewWorkerDelegate(nil, nil, nil, config.MachineSettings{}, nil, "", nil, nil)
 
 		Describe("#MachineClassKind", func() {
 			It("should return the correct kind of the machine class", func() {
@@ -76,6 +76,12 @@ var _ = Describe("Machines", func() {
 			})
 		})
 
+		Describe("#MachineClassReplacementFields", func() {
+			It("should return the fields whose changes require new machines", func() {
+				Expect(workerDelegate.MachineClassReplacementFields()).To(ConsistOf("imageID", "instanceType", "region", "zoneID", "securityGroupID", "vSwitchID", "systemDisk", "instanceChargeType", "internetChargeType", "internetMaxBandwidthIn", "internetMaxBandwidthOut", "spotStrategy", "keyPairName", "secret"))
+			})
+		})
+
 		Describe("#GenerateMachineDeployments, #DeployMachineClasses", func() {
 			var (
 				namespace string
@@ -268,6 +274,9 @@ var _ = Describe("Machines", func() {
 									zone1,
 									zone2,
 								},
+								ProviderConfig: &runtime.RawExtension{
+									Raw: []byte(`{"apiVersion":"worker.extensions.gardener.cloud/v1alpha1","kind":"WorkerConfig","spot":{}}`),
+								},
 							},
 						},
 					},
@@ -277,11 +286,12 @@ var _ = Describe("Machines", func() {
 				_ = apisalicloud.AddToScheme(scheme)
 				decoder = serializer.NewCodecFactory(scheme).UniversalDecoder()
 
-				workerDelegate = NewWorkerDelegate(c, decoder, machineImages, chartApplier, "", w, cluster)
+				workerDelegate = NewWorkerDelegate(c, decoder, machineImages, config.MachineSettings{}, chartApplier, "", w, cluster)
 			})
 
 			It("should return the expected machine deployments", func() {
 				expectGetSecretCallToWork(c, alicloudAccessKeyID, alicloudAccessKeySecret)
+				expectListMachineDeploymentsCallToWork(c)
 
 				// Test workerDelegate.DeployMachineClasses()
 				var (
@@ -311,18 +321,18 @@ var _ = Describe("Machines", func() {
 
 					machineClassPool1Zone1 = useDefaultMachineClass(defaultMachineClass, "vSwitchID", vswitchZone1, "zoneID", zone1)
 					machineClassPool1Zone2 = useDefaultMachineClass(defaultMachineClass, "vSwitchID", vswitchZone2, "zoneID", zone2)
-					machineClassPool2Zone1 = useDefaultMachineClass(defaultMachineClass, "vSwitchID", vswitchZone1, "zoneID", zone1)
-					machineClassPool2Zone2 = useDefaultMachineClass(defaultMachineClass, "vSwitchID", vswitchZone2, "zoneID", zone2)
+					machineClassPool2Zone1 = useDefaultMachineClass(defaultMachineClass, "vSwitchID", vswitchZone1, "zoneID", zone1, "spotStrategy", "SpotAsPriceGo")
+					machineClassPool2Zone2 = useDefaultMachineClass(defaultMachineClass, "vSwitchID", vswitchZone2, "zoneID", zone2, "spotStrategy", "SpotAsPriceGo")
 
 					machineClassNamePool1Zone1 = fmt.Sprintf("%s-%s-%s", namespace, namePool1, zone1)
 					machineClassNamePool1Zone2 = fmt.Sprintf("%s-%s-%s", namespace, namePool1, zone2)
 					machineClassNamePool2Zone1 = fmt.Sprintf("%s-%s-%s", namespace, namePool2, zone1)
 					machineClassNamePool2Zone2 = fmt.Sprintf("%s-%s-%s", namespace, namePool2, zone2)
 
-					machineClassHashPool1Zone1 = worker.MachineClassHash(machineClassPool1Zone1, shootVersionMajorMinor)
-					machineClassHashPool1Zone2 = worker.MachineClassHash(machineClassPool1Zone2, shootVersionMajorMinor)
-					machineClassHashPool2Zone1 = worker.MachineClassHash(machineClassPool2Zone1, shootVersionMajorMinor)
-					machineClassHashPool2Zone2 = worker.MachineClassHash(machineClassPool2Zone2, shootVersionMajorMinor)
+					machineClassHashPool1Zone1 = worker.MachineClassHash(machineClassPool1Zone1, shootVersionMajorMinor, workerDelegate.MachineClassReplacementFields()...)
+					machineClassHashPool1Zone2 = worker.MachineClassHash(machineClassPool1Zone2, shootVersionMajorMinor, workerDelegate.MachineClassReplacementFields()...)
+					machineClassHashPool2Zone1 = worker.MachineClassHash(machineClassPool2Zone1, shootVersionMajorMinor, workerDelegate.MachineClassReplacementFields()...)
+					machineClassHashPool2Zone2 = worker.MachineClassHash(machineClassPool2Zone2, shootVersionMajorMinor, workerDelegate.MachineClassReplacementFields()...)
 
 					machineClassWithHashPool1Zone1 = fmt.Sprintf("%s-%s", machineClassNamePool1Zone1, machineClassHashPool1Zone1)
 					machineClassWithHashPool1Zone2 = fmt.Sprintf("%s-%s", machineClassNamePool1Zone2, machineClassHashPool1Zone2)
@@ -356,42 +366,69 @@ var _ = Describe("Machines", func() {
 				Expect(err).NotTo(HaveOccurred())
 
 				// Test workerDelegate.GenerateMachineDeployments()
+				var (
+					spotLabels = map[string]string{worker.LabelSpot: "true"}
+					spotTaints = []corev1.Taint{{Key: worker.TaintSpot, Value: "true", Effect: corev1.TaintEffectNoSchedule}}
+				)
+
+				usedMachineImage := &worker.MachineImage{
+					Name:    machineImageName,
+					Version: machineImageVersion,
+					ID:      machineImageID,
+				}
+
 				machineDeployments := worker.MachineDeployments{
 					{
 						Name:           machineClassNamePool1Zone1,
+						PoolName:       namePool1,
+						Zone:           zone1,
 						ClassName:      machineClassWithHashPool1Zone1,
 						SecretName:     machineClassWithHashPool1Zone1,
 						Minimum:        worker.DistributeOverZones(0, minPool1, 2),
 						Maximum:        worker.DistributeOverZones(0, maxPool1, 2),
 						MaxSurge:       worker.DistributePositiveIntOrPercent(0, maxSurgePool1, 2, maxPool1),
 						MaxUnavailable: worker.DistributePositiveIntOrPercent(0, maxUnavailablePool1, 2, minPool1),
+						MachineImage:   usedMachineImage,
 					},
 					{
 						Name:           machineClassNamePool1Zone2,
+						PoolName:       namePool1,
+						Zone:           zone2,
 						ClassName:      machineClassWithHashPool1Zone2,
 						SecretName:     machineClassWithHashPool1Zone2,
 						Minimum:        worker.DistributeOverZones(1, minPool1, 2),
 						Maximum:        worker.DistributeOverZones(1, maxPool1, 2),
 						MaxSurge:       worker.DistributePositiveIntOrPercent(1, maxSurgePool1, 2, maxPool1),
 						MaxUnavailable: worker.DistributePositiveIntOrPercent(1, maxUnavailablePool1, 2, minPool1),
+						MachineImage:   usedMachineImage,
 					},
 					{
 						Name:           machineClassNamePool2Zone1,
+						PoolName:       namePool2,
+						Zone:           zone1,
 						ClassName:      machineClassWithHashPool2Zone1,
 						SecretName:     machineClassWithHashPool2Zone1,
 						Minimum:        worker.DistributeOverZones(0, minPool2, 2),
 						Maximum:        worker.DistributeOverZones(0, maxPool2, 2),
 						MaxSurge:       worker.DistributePositiveIntOrPercent(0, maxSurgePool2, 2, maxPool2),
 						MaxUnavailable: worker.DistributePositiveIntOrPercent(0, maxUnavailablePool2, 2, minPool2),
+						Labels:         spotLabels,
+						Taints:         spotTaints,
+						MachineImage:   usedMachineImage,
 					},
 					{
 						Name:           machineClassNamePool2Zone2,
+						PoolName:       namePool2,
+						Zone:           zone2,
 						ClassName:      machineClassWithHashPool2Zone2,
 						SecretName:     machineClassWithHashPool2Zone2,
 						Minimum:        worker.DistributeOverZones(1, minPool2, 2),
 						Maximum:        worker.DistributeOverZones(1, maxPool2, 2),
 						MaxSurge:       worker.DistributePositiveIntOrPercent(1, maxSurgePool2, 2, maxPool2),
 						MaxUnavailable: worker.DistributePositiveIntOrPercent(1, maxUnavailablePool2, 2, minPool2),
+						Labels:         spotLabels,
+						Taints:         spotTaints,
+						MachineImage:   usedMachineImage,
 					},
 				}
 
@@ -412,9 +449,10 @@ var _ = Describe("Machines", func() {
 
 			It("should fail because the version is invalid", func() {
 				expectGetSecretCallToWork(c, alicloudAccessKeyID, alicloudAccessKeySecret)
+				expectListMachineDeploymentsCallToWork(c)
 
 				cluster.Shoot.Spec.Kubernetes.Version = "invalid"
-				workerDelegate = NewWorkerDelegate(c, decoder, machineImages, chartApplier, "", w, cluster)
+				workerDelegate = NewWorkerDelegate(c, decoder, machineImages, config.MachineSettings{}, chartApplier, "", w, cluster)
 
 				result, err := workerDelegate.GenerateMachineDeployments(context.TODO())
 				Expect(err).To(HaveOccurred())
@@ -423,10 +461,11 @@ var _ = Describe("Machines", func() {
 
 			It("should fail because the infrastructure status cannot be decoded", func() {
 				expectGetSecretCallToWork(c, alicloudAccessKeyID, alicloudAccessKeySecret)
+				expectListMachineDeploymentsCallToWork(c)
 
 				w.Spec.InfrastructureProviderStatus = &runtime.RawExtension{}
 
-				workerDelegate = NewWorkerDelegate(c, decoder, machineImages, chartApplier, "", w, cluster)
+				workerDelegate = NewWorkerDelegate(c, decoder, machineImages, config.MachineSettings{}, chartApplier, "", w, cluster)
 
 				result, err := workerDelegate.GenerateMachineDeployments(context.TODO())
 				Expect(err).To(HaveOccurred())
@@ -435,6 +474,7 @@ var _ = Describe("Machines", func() {
 
 			It("should fail because the security group cannot be found", func() {
 				expectGetSecretCallToWork(c, alicloudAccessKeyID, alicloudAccessKeySecret)
+				expectListMachineDeploymentsCallToWork(c)
 
 				w.Spec.InfrastructureProviderStatus = &runtime.RawExtension{
 					Raw: encode(&apisalicloud.InfrastructureStatus{
@@ -442,7 +482,7 @@ var _ = Describe("Machines", func() {
 					}),
 				}
 
-				workerDelegate = NewWorkerDelegate(c, decoder, machineImages, chartApplier, "", w, cluster)
+				workerDelegate = NewWorkerDelegate(c, decoder, machineImages, config.MachineSettings{}, chartApplier, "", w, cluster)
 
 				result, err := workerDelegate.GenerateMachineDeployments(context.TODO())
 				Expect(err).To(HaveOccurred())
@@ -451,8 +491,9 @@ var _ = Describe("Machines", func() {
 
 			It("should fail because the machine image cannot be found", func() {
 				expectGetSecretCallToWork(c, alicloudAccessKeyID, alicloudAccessKeySecret)
+				expectListMachineDeploymentsCallToWork(c)
 
-				workerDelegate = NewWorkerDelegate(c, decoder, nil, chartApplier, "", w, cluster)
+				workerDelegate = NewWorkerDelegate(c, decoder, nil, config.MachineSettings{}, chartApplier, "", w, cluster)
 
 				result, err := workerDelegate.GenerateMachineDeployments(context.TODO())
 				Expect(err).To(HaveOccurred())
@@ -461,6 +502,7 @@ var _ = Describe("Machines", func() {
 
 			It("should fail because the vswitch id cannot be found", func() {
 				expectGetSecretCallToWork(c, alicloudAccessKeyID, alicloudAccessKeySecret)
+				expectListMachineDeploymentsCallToWork(c)
 
 				w.Spec.InfrastructureProviderStatus = &runtime.RawExtension{
 					Raw: encode(&apisalicloud.InfrastructureStatus{
@@ -476,7 +518,20 @@ var _ = Describe("Machines", func() {
 					}),
 				}
 
-				workerDelegate = NewWorkerDelegate(c, decoder, machineImages, chartApplier, "", w, cluster)
+				workerDelegate = NewWorkerDelegate(c, decoder, machineImages, config.MachineSettings{}, chartApplier, "", w, cluster)
+
+				result, err := workerDelegate.GenerateMachineDeployments(context.TODO())
+				Expect(err).To(HaveOccurred())
+				Expect(result).To(BeNil())
+			})
+
+			It("should fail because a maximum price is set for a spot pool", func() {
+				expectGetSecretCallToWork(c, alicloudAccessKeyID, alicloudAccessKeySecret)
+				expectListMachineDeploymentsCallToWork(c)
+
+				w.Spec.Pools[1].ProviderConfig.Raw = []byte(`{"spot":{"maxPrice":"0.5"}}`)
+
+				workerDelegate = NewWorkerDelegate(c, decoder, machineImages, config.MachineSettings{}, chartApplier, "", w, cluster)
 
 				result, err := workerDelegate.GenerateMachineDeployments(context.TODO())
 				Expect(err).To(HaveOccurred())
@@ -485,10 +540,11 @@ var _ = Describe("Machines", func() {
 
 			It("should fail because the volume size cannot be decoded", func() {
 				expectGetSecretCallToWork(c, alicloudAccessKeyID, alicloudAccessKeySecret)
+				expectListMachineDeploymentsCallToWork(c)
 
 				w.Spec.Pools[0].Volume.Size = "not-decodeable"
 
-				workerDelegate = NewWorkerDelegate(c, decoder, machineImages, chartApplier, "", w, cluster)
+				workerDelegate = NewWorkerDelegate(c, decoder, machineImages, config.MachineSettings{}, chartApplier, "", w, cluster)
 
 				result, err := workerDelegate.GenerateMachineDeployments(context.TODO())
 				Expect(err).To(HaveOccurred())
@@ -534,3 +590,17 @@ func addNameAndSecretToMachineClass(class map[string]interface{}, alicloudAccess
 	class["secret"].(map[string]interface{})[alicloud.AccessKeyID] = alicloudAccessKeyID
 	class["secret"].(map[string]interface{})[alicloud.AccessKeySecret] = alicloudAccessKeySecret
 }
+
+func expectListMachineDeploymentsCallToWork(c *mockclient.MockClient, classNames ...string) {
+	c.EXPECT().
+		List(context.TODO(), gomock.Any(), gomock.AssignableToTypeOf(&machinev1alpha1.MachineDeploymentList{})).
+		DoAndReturn(func(_ context.Context, _ *client.ListOptions, list *machinev1alpha1.MachineDeploymentList) error {
+			for _, className := range classNames {
+				machineDeployment := machinev1alpha1.MachineDeployment{}
+				machineDeployment.Spec.Template.Spec.Class.Name = className
+				list.Items = append(list.Items, machineDeployment)
+			}
+			return nil
+		}).
+		AnyTimes()
+}
@@ -0,0 +1,125 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validator
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gardener/gardener-extensions/controllers/provider-alicloud/pkg/alicloud"
+	apisalicloud "github.com/gardener/gardener-extensions/controllers/provider-alicloud/pkg/apis/alicloud"
+	alicloudvalidation "github.com/gardener/gardener-extensions/controllers/provider-alicloud/pkg/apis/alicloud/validation"
+	utilvalidation "github.com/gardener/gardener-extensions/pkg/util/validation"
+	"github.com/gardener/gardener-extensions/pkg/webhook/validator"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// NewValidator creates a new validator that validates Alicloud infrastructures, controlplanes and workers.
+func NewValidator(scheme *runtime.Scheme) validator.Validator {
+	return &alicloudValidator{
+		decoder: serializer.NewCodecFactory(scheme).UniversalDecoder(),
+	}
+}
+
+type alicloudValidator struct {
+	decoder runtime.Decoder
+}
+
+// Validate validates the given object.
+func (v *alicloudValidator) Validate(ctx context.Context, obj runtime.Object) error {
+	switch x := obj.(type) {
+	case *extensionsv1alpha1.Infrastructure:
+		if x.Spec.Type != alicloud.Type {
+			return nil
+		}
+		return v.validateInfrastructure(x)
+	case *extensionsv1alpha1.ControlPlane:
+		if x.Spec.Type != alicloud.Type {
+			return nil
+		}
+		return v.validateControlPlane(x)
+	case *extensionsv1alpha1.Worker:
+		if x.Spec.Type != alicloud.Type {
+			return nil
+		}
+		return v.validateWorker(x)
+	}
+	return nil
+}
+
+func (v *alicloudValidator) validateInfrastructure(infra *extensionsv1alpha1.Infrastructure) error {
+	var (
+		allErrs     = field.ErrorList{}
+		configPath  = field.NewPath("spec", "providerConfig")
+		infraConfig = &apisalicloud.InfrastructureConfig{}
+	)
+
+	if infra.Spec.ProviderConfig == nil {
+		allErrs = append(allErrs, field.Required(configPath, "field is required"))
+	} else if _, _, err := v.decoder.Decode(infra.Spec.ProviderConfig.Raw, nil, infraConfig); err != nil {
+		allErrs = append(allErrs, field.Invalid(configPath, string(infra.Spec.ProviderConfig.Raw), fmt.Sprintf("could not decode provider config: %+v", err)))
+	} else {
+		allErrs = append(allErrs, alicloudvalidation.ValidateInfrastructureConfig(infraConfig, configPath)...)
+	}
+
+	if len(allErrs) > 0 {
+		return apierrors.NewInvalid(extensionsv1alpha1.Kind("Infrastructure"), infra.Name, allErrs)
+	}
+	return nil
+}
+
+func (v *alicloudValidator) validateControlPlane(cp *extensionsv1alpha1.ControlPlane) error {
+	if cp.Spec.ProviderConfig == nil {
+		return nil
+	}
+
+	var (
+		allErrs    = field.ErrorList{}
+		configPath = field.NewPath("spec", "providerConfig")
+		cpConfig   = &apisalicloud.ControlPlaneConfig{}
+	)
+
+	if _, _, err := v.decoder.Decode(cp.Spec.ProviderConfig.Raw, nil, cpConfig); err != nil {
+		allErrs = append(allErrs, field.Invalid(configPath, string(cp.Spec.ProviderConfig.Raw), fmt.Sprintf("could not decode provider config: %+v", err)))
+	} else {
+		allErrs = append(allErrs, alicloudvalidation.ValidateControlPlaneConfig(cpConfig, configPath)...)
+	}
+
+	if len(allErrs) > 0 {
+		return apierrors.NewInvalid(extensionsv1alpha1.Kind(extensionsv1alpha1.ControlPlaneResource), cp.Name, allErrs)
+	}
+	return nil
+}
+
+func (v *alicloudValidator) validateWorker(worker *extensionsv1alpha1.Worker) error {
+	var (
+		allErrs  = field.ErrorList{}
+		poolPath = field.NewPath("spec", "pools")
+	)
+
+	allErrs = append(allErrs, utilvalidation.ValidateWorkerPools(worker.Spec.Pools, true, poolPath)...)
+	// The used machine-controller-manager cannot attach additional data volumes for this provider.
+	allErrs = append(allErrs, utilvalidation.ValidateUnsupportedWorkerConfigFields(worker.Spec.Pools, []string{utilvalidation.WorkerConfigFieldDataVolumes}, poolPath)...)
+
+	if len(allErrs) > 0 {
+		return apierrors.NewInvalid(extensionsv1alpha1.Kind("Worker"), worker.Name, allErrs)
+	}
+	return nil
+}
@@ -67,3 +67,8 @@ func FindSubnetForPurposeAndZone(subnets []aws.Subnet, purpose, zone string) (*a
 	}
 	return nil, fmt.Errorf("no subnet with purpose %q in zone %q found", purpose, zone)
 }
+
+// IsCSIEnabled returns true if the deployment of the CSI driver is enabled in the given ControlPlaneConfig.
+func IsCSIEnabled(config *aws.ControlPlaneConfig) bool {
+	return config != nil && config.CSI != nil && config.CSI.Enabled
+}
@@ -72,6 +72,17 @@ var _ = Describe("Helper", func() {
 		Entry("entry not found (no zone)", []aws.Subnet{{ID: "bar", Purpose: "baz", Zone: "europe"}}, "foo", "asia", nil, true),
 		Entry("entry exists", []aws.Subnet{{ID: "bar", Purpose: "baz", Zone: "europe"}}, "baz", "europe", &aws.Subnet{ID: "bar", Purpose: "baz", Zone: "europe"}, false),
 	)
+
+	DescribeTable("#IsCSIEnabled",
+		func(config *aws.ControlPlaneConfig, expected bool) {
+			Expect(IsCSIEnabled(config)).To(Equal(expected))
+		},
+
+		Entry("config is nil", nil, false),
+		Entry("csi is nil", &aws.ControlPlaneConfig{}, false),
+		Entry("csi is disabled", &aws.ControlPlaneConfig{CSI: &aws.CSIConfig{Enabled: false}}, false),
+		Entry("csi is enabled", &aws.ControlPlaneConfig{CSI: &aws.CSIConfig{Enabled: true}}, true),
+	)
 })
 
 func expectResults(result, expected interface{}, err error, expectErr bool) {
@@ -21,3 +21,15 @@ import (
 func addDefaultingFuncs(scheme *runtime.Scheme) error {
 	return RegisterDefaults(scheme)
 }
+
+// SetDefaults_CloudControllerManagerConfig sets the defaults of the given CloudControllerManagerConfig.
+func SetDefaults_CloudControllerManagerConfig(obj *CloudControllerManagerConfig) {
+	if obj.ConcurrentServiceSyncs == nil {
+		v := int32(10)
+		obj.ConcurrentServiceSyncs = &v
+	}
+	if obj.Verbosity == nil {
+		v := int32(2)
+		obj.Verbosity = &v
+	}
+}
@@ -16,6 +16,7 @@ package v1alpha1
 
 import (
 	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -28,9 +29,53 @@ type ControlPlaneConfig struct {
 	// CloudControllerManager contains configuration settings for the cloud-controller-manager.
 	// +optional
 	CloudControllerManager *CloudControllerManagerConfig `json:"cloudControllerManager,omitempty"`
+
+	// CloudProviderConfig contains settings for the cloud provider configuration used by the Kubernetes components.
+	// +optional
+	CloudProviderConfig *CloudProviderConfig `json:"cloudProviderConfig,omitempty"`
+
+	// CSI contains configuration settings for the Container Storage Interface (CSI) driver.
+	// +optional
+	CSI *CSIConfig `json:"csi,omitempty"`
 }
 
 // CloudControllerManagerConfig contains configuration settings for the cloud-controller-manager.
 type CloudControllerManagerConfig struct {
 	gardenv1beta1.KubernetesConfig `json:",inline"`
+
+	// RouteReconciliationPeriod is the period for reconciling the routes created for nodes by the cloud provider.
+	// +optional
+	RouteReconciliationPeriod *metav1.Duration `json:"routeReconciliationPeriod,omitempty"`
+	// NodeMonitorPeriod is the period for syncing the status of the nodes.
+	// +optional
+	NodeMonitorPeriod *metav1.Duration `json:"nodeMonitorPeriod,omitempty"`
+	// NodeStatusUpdateFrequency is the frequency in which the node status is updated from the cloud provider.
+	// +optional
+	NodeStatusUpdateFrequency *metav1.Duration `json:"nodeStatusUpdateFrequency,omitempty"`
+	// ConcurrentServiceSyncs is the number of services that are allowed to sync concurrently.
+	// +optional
+	ConcurrentServiceSyncs *int32 `json:"concurrentServiceSyncs,omitempty"`
+	// Resources are the compute resource requirements of the cloud-controller-manager. Requests and limits that are
+	// not given keep their default values.
+	// +optional
+	Resources *corev1.ResourceRequirements `json:"resources,omitempty"`
+	// Verbosity is the log level verbosity of the cloud-controller-manager.
+	// +optional
+	Verbosity *int32 `json:"verbosity,omitempty"`
+}
+
+// CSIConfig contains configuration settings for the Container Storage Interface (CSI) driver.
+type CSIConfig struct {
+	// Enabled specifies whether the CSI driver is deployed and the matching Kubernetes feature gates are enabled.
+	// It can only be enabled for shoots with Kubernetes version 1.13 or higher. Disabling it again deletes the CSI driver.
+	// Volumes provisioned by the in-tree volume plugin are not migrated and keep being handled by it.
+	Enabled bool `json:"enabled"`
+}
+
+// CloudProviderConfig contains settings for the cloud provider configuration used by the Kubernetes components.
+type CloudProviderConfig struct {
+	// ELBSecurityGroup is the ID of an existing security group that is attached to all load balancers instead of
+	// creating a dedicated security group per load balancer.
+	// +optional
+	ELBSecurityGroup *string `json:"elbSecurityGroup,omitempty"`
 }
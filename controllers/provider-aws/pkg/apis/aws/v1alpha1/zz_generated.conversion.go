@@ -26,6 +26,8 @@ import (
 	aws "github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/apis/aws"
 	core "github.com/gardener/gardener/pkg/apis/core"
 	corev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	conversion "k8s.io/apimachinery/pkg/conversion"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
@@ -37,6 +39,16 @@ func init() {
 // RegisterConversions adds conversion functions to the given scheme.
 // Public to allow building arbitrary schemes.
 func RegisterConversions(s *runtime.Scheme) error {
+	if err := s.AddGeneratedConversionFunc((*CSIConfig)(nil), (*aws.CSIConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_CSIConfig_To_aws_CSIConfig(a.(*CSIConfig), b.(*aws.CSIConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*aws.CSIConfig)(nil), (*CSIConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_aws_CSIConfig_To_v1alpha1_CSIConfig(a.(*aws.CSIConfig), b.(*CSIConfig), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*CloudControllerManagerConfig)(nil), (*aws.CloudControllerManagerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_CloudControllerManagerConfig_To_aws_CloudControllerManagerConfig(a.(*CloudControllerManagerConfig), b.(*aws.CloudControllerManagerConfig), scope)
 	}); err != nil {
@@ -47,6 +59,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*CloudProviderConfig)(nil), (*aws.CloudProviderConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_CloudProviderConfig_To_aws_CloudProviderConfig(a.(*CloudProviderConfig), b.(*aws.CloudProviderConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*aws.CloudProviderConfig)(nil), (*CloudProviderConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_aws_CloudProviderConfig_To_v1alpha1_CloudProviderConfig(a.(*aws.CloudProviderConfig), b.(*CloudProviderConfig), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ControlPlaneConfig)(nil), (*aws.ControlPlaneConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_ControlPlaneConfig_To_aws_ControlPlaneConfig(a.(*ControlPlaneConfig), b.(*aws.ControlPlaneConfig), scope)
 	}); err != nil {
@@ -180,8 +202,34 @@ func RegisterConversions(s *runtime.Scheme) error {
 	return nil
 }
 
+func autoConvert_v1alpha1_CSIConfig_To_aws_CSIConfig(in *CSIConfig, out *aws.CSIConfig, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	return nil
+}
+
+// Convert_v1alpha1_CSIConfig_To_aws_CSIConfig is an autogenerated conversion function.
+func Convert_v1alpha1_CSIConfig_To_aws_CSIConfig(in *CSIConfig, out *aws.CSIConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_CSIConfig_To_aws_CSIConfig(in, out, s)
+}
+
+func autoConvert_aws_CSIConfig_To_v1alpha1_CSIConfig(in *aws.CSIConfig, out *CSIConfig, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	return nil
+}
+
+// Convert_aws_CSIConfig_To_v1alpha1_CSIConfig is an autogenerated conversion function.
+func Convert_aws_CSIConfig_To_v1alpha1_CSIConfig(in *aws.CSIConfig, out *CSIConfig, s conversion.Scope) error {
+	return autoConvert_aws_CSIConfig_To_v1alpha1_CSIConfig(in, out, s)
+}
+
 func autoConvert_v1alpha1_CloudControllerManagerConfig_To_aws_CloudControllerManagerConfig(in *CloudControllerManagerConfig, out *aws.CloudControllerManagerConfig, s conversion.Scope) error {
 	out.KubernetesConfig = in.KubernetesConfig
+	out.RouteReconciliationPeriod = (*v1.Duration)(unsafe.Pointer(in.RouteReconciliationPeriod))
+	out.NodeMonitorPeriod = (*v1.Duration)(unsafe.Pointer(in.NodeMonitorPeriod))
+	out.NodeStatusUpdateFrequency = (*v1.Duration)(unsafe.Pointer(in.NodeStatusUpdateFrequency))
+	out.ConcurrentServiceSyncs = (*int32)(unsafe.Pointer(in.ConcurrentServiceSyncs))
+	out.Resources = (*corev1.ResourceRequirements)(unsafe.Pointer(in.Resources))
+	out.Verbosity = (*int32)(unsafe.Pointer(in.Verbosity))
 	return nil
 }
 
@@ -192,6 +240,12 @@ func Convert_v1alpha1_CloudControllerManagerConfig_To_aws_CloudControllerManager
 
 func autoConvert_aws_CloudControllerManagerConfig_To_v1alpha1_CloudControllerManagerConfig(in *aws.CloudControllerManagerConfig, out *CloudControllerManagerConfig, s conversion.Scope) error {
 	out.KubernetesConfig = in.KubernetesConfig
+	out.RouteReconciliationPeriod = (*v1.Duration)(unsafe.Pointer(in.RouteReconciliationPeriod))
+	out.NodeMonitorPeriod = (*v1.Duration)(unsafe.Pointer(in.NodeMonitorPeriod))
+	out.NodeStatusUpdateFrequency = (*v1.Duration)(unsafe.Pointer(in.NodeStatusUpdateFrequency))
+	out.ConcurrentServiceSyncs = (*int32)(unsafe.Pointer(in.ConcurrentServiceSyncs))
+	out.Resources = (*corev1.ResourceRequirements)(unsafe.Pointer(in.Resources))
+	out.Verbosity = (*int32)(unsafe.Pointer(in.Verbosity))
 	return nil
 }
 
@@ -200,8 +254,30 @@ func Convert_aws_CloudControllerManagerConfig_To_v1alpha1_CloudControllerManager
 	return autoConvert_aws_CloudControllerManagerConfig_To_v1alpha1_CloudControllerManagerConfig(in, out, s)
 }
 
+func autoConvert_v1alpha1_CloudProviderConfig_To_aws_CloudProviderConfig(in *CloudProviderConfig, out *aws.CloudProviderConfig, s conversion.Scope) error {
+	out.ELBSecurityGroup = (*string)(unsafe.Pointer(in.ELBSecurityGroup))
+	return nil
+}
+
+// Convert_v1alpha1_CloudProviderConfig_To_aws_CloudProviderConfig is an autogenerated conversion function.
+func Convert_v1alpha1_CloudProviderConfig_To_aws_CloudProviderConfig(in *CloudProviderConfig, out *aws.CloudProviderConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_CloudProviderConfig_To_aws_CloudProviderConfig(in, out, s)
+}
+
+func autoConvert_aws_CloudProviderConfig_To_v1alpha1_CloudProviderConfig(in *aws.CloudProviderConfig, out *CloudProviderConfig, s conversion.Scope) error {
+	out.ELBSecurityGroup = (*string)(unsafe.Pointer(in.ELBSecurityGroup))
+	return nil
+}
+
+// Convert_aws_CloudProviderConfig_To_v1alpha1_CloudProviderConfig is an autogenerated conversion function.
+func Convert_aws_CloudProviderConfig_To_v1alpha1_CloudProviderConfig(in *aws.CloudProviderConfig, out *CloudProviderConfig, s conversion.Scope) error {
+	return autoConvert_aws_CloudProviderConfig_To_v1alpha1_CloudProviderConfig(in, out, s)
+}
+
 func autoConvert_v1alpha1_ControlPlaneConfig_To_aws_ControlPlaneConfig(in *ControlPlaneConfig, out *aws.ControlPlaneConfig, s conversion.Scope) error {
 	out.CloudControllerManager = (*aws.CloudControllerManagerConfig)(unsafe.Pointer(in.CloudControllerManager))
+	out.CloudProviderConfig = (*aws.CloudProviderConfig)(unsafe.Pointer(in.CloudProviderConfig))
+	out.CSI = (*aws.CSIConfig)(unsafe.Pointer(in.CSI))
 	return nil
 }
 
@@ -212,6 +288,8 @@ func Convert_v1alpha1_ControlPlaneConfig_To_aws_ControlPlaneConfig(in *ControlPl
 
 func autoConvert_aws_ControlPlaneConfig_To_v1alpha1_ControlPlaneConfig(in *aws.ControlPlaneConfig, out *ControlPlaneConfig, s conversion.Scope) error {
 	out.CloudControllerManager = (*CloudControllerManagerConfig)(unsafe.Pointer(in.CloudControllerManager))
+	out.CloudProviderConfig = (*CloudProviderConfig)(unsafe.Pointer(in.CloudProviderConfig))
+	out.CSI = (*CSIConfig)(unsafe.Pointer(in.CSI))
 	return nil
 }
 
@@ -0,0 +1,59 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	"regexp"
+
+	apisaws "github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/apis/aws"
+	utilvalidation "github.com/gardener/gardener-extensions/pkg/util/validation"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// securityGroupIDRegex matches the IDs of AWS security groups.
+var securityGroupIDRegex = regexp.MustCompile(`^sg-[0-9a-f]+$`)
+
+// ValidateControlPlaneConfig validates the given ControlPlaneConfig.
+func ValidateControlPlaneConfig(cp *apisaws.ControlPlaneConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if ccm := cp.CloudControllerManager; ccm != nil {
+		allErrs = append(allErrs, utilvalidation.ValidateCloudControllerManagerSettings(utilvalidation.CloudControllerManagerSettings{
+			RouteReconciliationPeriod: ccm.RouteReconciliationPeriod,
+			NodeMonitorPeriod:         ccm.NodeMonitorPeriod,
+			NodeStatusUpdateFrequency: ccm.NodeStatusUpdateFrequency,
+			ConcurrentServiceSyncs:    ccm.ConcurrentServiceSyncs,
+			Verbosity:                 ccm.Verbosity,
+			Resources:                 ccm.Resources,
+		}, fldPath.Child("cloudControllerManager"))...)
+	}
+
+	if cp.CloudProviderConfig != nil {
+		allErrs = append(allErrs, validateCloudProviderConfig(cp.CloudProviderConfig, fldPath.Child("cloudProviderConfig"))...)
+	}
+
+	return allErrs
+}
+
+func validateCloudProviderConfig(config *apisaws.CloudProviderConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if config.ELBSecurityGroup != nil && !securityGroupIDRegex.MatchString(*config.ELBSecurityGroup) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("elbSecurityGroup"), *config.ELBSecurityGroup, "must be a valid security group ID"))
+	}
+
+	return allErrs
+}
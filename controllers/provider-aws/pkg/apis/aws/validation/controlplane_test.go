@@ -0,0 +1,63 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation_test
+
+import (
+	apisaws "github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/apis/aws"
+	. "github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/apis/aws/validation"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+var _ = Describe("ControlPlaneConfig validation", func() {
+	var (
+		fldPath = field.NewPath("spec", "providerConfig")
+
+		elbSecurityGroup        = "sg-0123456789abcdef0"
+		invalidELBSecurityGroup = "sg-1234\"\nDisableSecurityGroupIngress=false"
+	)
+
+	It("should accept an empty config", func() {
+		Expect(ValidateControlPlaneConfig(&apisaws.ControlPlaneConfig{}, fldPath)).To(BeEmpty())
+	})
+
+	It("should accept a valid cloud provider config", func() {
+		cpConfig := &apisaws.ControlPlaneConfig{
+			CloudProviderConfig: &apisaws.CloudProviderConfig{
+				ELBSecurityGroup: &elbSecurityGroup,
+			},
+		}
+
+		Expect(ValidateControlPlaneConfig(cpConfig, fldPath)).To(BeEmpty())
+	})
+
+	It("should reject an invalid ELB security group", func() {
+		cpConfig := &apisaws.ControlPlaneConfig{
+			CloudProviderConfig: &apisaws.CloudProviderConfig{
+				ELBSecurityGroup: &invalidELBSecurityGroup,
+			},
+		}
+
+		Expect(ValidateControlPlaneConfig(cpConfig, fldPath)).To(ConsistOf(
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.providerConfig.cloudProviderConfig.elbSecurityGroup"),
+			})),
+		))
+	})
+})
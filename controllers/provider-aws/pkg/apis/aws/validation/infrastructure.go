@@ -0,0 +1,84 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	apisaws "github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/apis/aws"
+	utilvalidation "github.com/gardener/gardener-extensions/pkg/util/validation"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// ValidateInfrastructureConfig validates the given InfrastructureConfig.
+func ValidateInfrastructureConfig(infra *apisaws.InfrastructureConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	networksPath := fldPath.Child("networks")
+	allErrs = append(allErrs, validateVPC(&infra.Networks.VPC, networksPath.Child("vpc"))...)
+
+	zonesPath := networksPath.Child("zones")
+	if len(infra.Networks.Zones) == 0 {
+		allErrs = append(allErrs, field.Required(zonesPath, "at least one zone must be specified"))
+	}
+
+	var (
+		names = sets.NewString()
+		cidrs []utilvalidation.CIDR
+	)
+	for i, zone := range infra.Networks.Zones {
+		idxPath := zonesPath.Index(i)
+
+		if len(zone.Name) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("name"), "field is required"))
+		} else if names.Has(zone.Name) {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), zone.Name))
+		}
+		names.Insert(zone.Name)
+
+		cidrs = append(cidrs,
+			utilvalidation.NewCIDR(string(zone.Internal), idxPath.Child("internal")),
+			utilvalidation.NewCIDR(string(zone.Public), idxPath.Child("public")),
+			utilvalidation.NewCIDR(string(zone.Workers), idxPath.Child("workers")),
+		)
+	}
+
+	allErrs = append(allErrs, utilvalidation.ValidateCIDRParse(cidrs...)...)
+	if infra.Networks.VPC.CIDR != nil {
+		allErrs = append(allErrs, utilvalidation.ValidateCIDRIsSubset(utilvalidation.NewCIDR(string(*infra.Networks.VPC.CIDR), networksPath.Child("vpc", "cidr")), cidrs...)...)
+	}
+	allErrs = append(allErrs, utilvalidation.ValidateCIDROverlap(cidrs...)...)
+
+	return allErrs
+}
+
+func validateVPC(vpc *apisaws.VPC, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	switch {
+	case vpc.ID == nil && vpc.CIDR == nil:
+		allErrs = append(allErrs, field.Required(fldPath, "either id or cidr must be specified"))
+	case vpc.ID != nil && vpc.CIDR != nil:
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("cidr"), "must not be specified if id is specified"))
+	case vpc.ID != nil && len(*vpc.ID) == 0:
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("id"), *vpc.ID, "must not be empty"))
+	}
+
+	if vpc.CIDR != nil {
+		allErrs = append(allErrs, utilvalidation.ValidateCIDRParse(utilvalidation.NewCIDR(string(*vpc.CIDR), fldPath.Child("cidr")))...)
+	}
+
+	return allErrs
+}
@@ -0,0 +1,113 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation_test
+
+import (
+	apisaws "github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/apis/aws"
+	. "github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/apis/aws/validation"
+
+	gardencore "github.com/gardener/gardener/pkg/apis/core"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+var _ = Describe("InfrastructureConfig validation", func() {
+	var (
+		fldPath = field.NewPath("spec", "providerConfig")
+		infra   *apisaws.InfrastructureConfig
+	)
+
+	BeforeEach(func() {
+		vpcCIDR := gardencore.CIDR("10.250.0.0/16")
+		infra = &apisaws.InfrastructureConfig{
+			Networks: apisaws.Networks{
+				VPC: apisaws.VPC{CIDR: &vpcCIDR},
+				Zones: []apisaws.Zone{
+					{Name: "eu-west-1a", Internal: "10.250.112.0/22", Public: "10.250.96.0/22", Workers: "10.250.0.0/19"},
+					{Name: "eu-west-1b", Internal: "10.250.116.0/22", Public: "10.250.100.0/22", Workers: "10.250.32.0/19"},
+				},
+			},
+		}
+	})
+
+	It("should accept a valid config", func() {
+		Expect(ValidateInfrastructureConfig(infra, fldPath)).To(BeEmpty())
+	})
+
+	It("should require at least one zone", func() {
+		infra.Networks.Zones = nil
+
+		Expect(ValidateInfrastructureConfig(infra, fldPath)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+			"Type":  Equal(field.ErrorTypeRequired),
+			"Field": Equal("spec.providerConfig.networks.zones"),
+		}))))
+	})
+
+	It("should require either a VPC id or cidr", func() {
+		infra.Networks.VPC = apisaws.VPC{}
+
+		Expect(ValidateInfrastructureConfig(infra, fldPath)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+			"Type":  Equal(field.ErrorTypeRequired),
+			"Field": Equal("spec.providerConfig.networks.vpc"),
+		}))))
+	})
+
+	It("should forbid specifying both a VPC id and cidr", func() {
+		id := "vpc-123"
+		infra.Networks.VPC.ID = &id
+
+		Expect(ValidateInfrastructureConfig(infra, fldPath)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+			"Type":  Equal(field.ErrorTypeForbidden),
+			"Field": Equal("spec.providerConfig.networks.vpc.cidr"),
+		}))))
+	})
+
+	It("should reject overlapping zone CIDRs", func() {
+		infra.Networks.Zones[1].Workers = "10.250.16.0/20"
+
+		Expect(ValidateInfrastructureConfig(infra, fldPath)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+			"Type":  Equal(field.ErrorTypeInvalid),
+			"Field": Equal("spec.providerConfig.networks.zones[1].workers"),
+		}))))
+	})
+
+	It("should reject zone CIDRs outside of the VPC and duplicate zones", func() {
+		infra.Networks.Zones[1].Name = "eu-west-1a"
+		infra.Networks.Zones[1].Public = "10.251.100.0/22"
+
+		Expect(ValidateInfrastructureConfig(infra, fldPath)).To(ConsistOf(
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeDuplicate),
+				"Field": Equal("spec.providerConfig.networks.zones[1].name"),
+			})),
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.providerConfig.networks.zones[1].public"),
+			})),
+		))
+	})
+
+	It("should reject invalid CIDRs", func() {
+		infra.Networks.Zones[0].Internal = "10.250.112.0"
+
+		Expect(ValidateInfrastructureConfig(infra, fldPath)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+			"Type":  Equal(field.ErrorTypeInvalid),
+			"Field": Equal("spec.providerConfig.networks.zones[0].internal"),
+		}))))
+	})
+})
@@ -38,3 +38,16 @@ func FindAMIForRegion(machineImages []config.MachineImage, imageName, version, r
 
 	return "", fmt.Errorf("could not find an AMI for region %q and machine image %q in version %q", regionName, imageName, version)
 }
+
+// FindMachineImage takes a list of machine images, and the desired image name and version. It tries
+// to find the machine image with the given name and version. If it cannot be found then an error
+// is returned.
+func FindMachineImage(machineImages []config.MachineImage, imageName, version string) (*config.MachineImage, error) {
+	for _, machineImage := range machineImages {
+		if machineImage.Name == imageName && machineImage.Version == version {
+			return &machineImage, nil
+		}
+	}
+
+	return nil, fmt.Errorf("could not find a machine image for name %q in version %q", imageName, version)
+}
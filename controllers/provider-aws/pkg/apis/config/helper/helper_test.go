@@ -43,6 +43,24 @@ var _ = Describe("Helper", func() {
 		Entry("entry not found (region does not exist)", makeMachineImages("ubuntu", "1", "asia", "0"), "ubuntu", "1", "europe", ""),
 		Entry("entry", makeMachineImages("ubuntu", "1", "europe", "ami-1234"), "ubuntu", "1", "europe", "ami-1234"),
 	)
+
+	DescribeTable("#FindMachineImage",
+		func(machineImages []config.MachineImage, imageName, version string, expectedMachineImage *config.MachineImage) {
+			machineImage, err := FindMachineImage(machineImages, imageName, version)
+
+			Expect(machineImage).To(Equal(expectedMachineImage))
+			if expectedMachineImage != nil {
+				Expect(err).NotTo(HaveOccurred())
+			} else {
+				Expect(err).To(HaveOccurred())
+			}
+		},
+
+		Entry("list is nil", nil, "ubuntu", "1", nil),
+		Entry("entry not found (image does not exist)", makeMachineImages("debian", "1", "europe", "0"), "ubuntu", "1", nil),
+		Entry("entry not found (version does not exist)", makeMachineImages("ubuntu", "2", "europe", "0"), "ubuntu", "1", nil),
+		Entry("entry", makeMachineImages("ubuntu", "1", "europe", "ami-1234"), "ubuntu", "1", &makeMachineImages("ubuntu", "1", "europe", "ami-1234")[0]),
+	)
 })
 
 func makeMachineImages(name, version, region, ami string) []config.MachineImage {
@@ -21,6 +21,7 @@ limitations under the License.
 package config
 
 import (
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -36,6 +37,7 @@ func (in *ControllerConfiguration) DeepCopyInto(out *ControllerConfiguration) {
 		}
 	}
 	in.ETCD.DeepCopyInto(&out.ETCD)
+	in.MachineSettings.DeepCopyInto(&out.MachineSettings)
 	return
 }
 
@@ -130,6 +132,14 @@ func (in *MachineImage) DeepCopyInto(out *MachineImage) {
 		*out = make([]RegionAMIMapping, len(*in))
 		copy(*out, *in)
 	}
+	if in.DeprecationDate != nil {
+		in, out := &in.DeprecationDate, &out.DeprecationDate
+		*out = (*in).DeepCopy()
+	}
+	if in.ExpirationDate != nil {
+		in, out := &in.ExpirationDate, &out.ExpirationDate
+		*out = (*in).DeepCopy()
+	}
 	return
 }
 
@@ -143,6 +153,42 @@ func (in *MachineImage) DeepCopy() *MachineImage {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachineSettings) DeepCopyInto(out *MachineSettings) {
+	*out = *in
+	if in.DrainTimeout != nil {
+		in, out := &in.DrainTimeout, &out.DrainTimeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.HealthTimeout != nil {
+		in, out := &in.HealthTimeout, &out.HealthTimeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.CreationTimeout != nil {
+		in, out := &in.CreationTimeout, &out.CreationTimeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.MaxEvictRetries != nil {
+		in, out := &in.MaxEvictRetries, &out.MaxEvictRetries
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineSettings.
+func (in *MachineSettings) DeepCopy() *MachineSettings {
+	if in == nil {
+		return nil
+	}
+	out := new(MachineSettings)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RegionAMIMapping) DeepCopyInto(out *RegionAMIMapping) {
 	*out = *in
@@ -86,6 +86,74 @@ func (c *Client) GetInternetGateway(ctx context.Context, vpcID string) (string,
 	return "", fmt.Errorf("no attached internet gateway found for vpc %s", vpcID)
 }
 
+// VPCExists checks whether the VPC with the given <vpcID> exists.
+func (c *Client) VPCExists(ctx context.Context, vpcID string) (bool, error) {
+	output, err := c.EC2.DescribeVpcsWithContext(ctx, &ec2.DescribeVpcsInput{VpcIds: []*string{aws.String(vpcID)}})
+	if err != nil {
+		if aerr, ok := err.(awserr.Error); ok && aerr.Code() == "InvalidVpcID.NotFound" {
+			return false, nil
+		}
+		return false, err
+	}
+	return len(output.Vpcs) > 0, nil
+}
+
+// SubnetExists checks whether the subnet with the given <subnetID> exists.
+func (c *Client) SubnetExists(ctx context.Context, subnetID string) (bool, error) {
+	output, err := c.EC2.DescribeSubnetsWithContext(ctx, &ec2.DescribeSubnetsInput{SubnetIds: []*string{aws.String(subnetID)}})
+	if err != nil {
+		if aerr, ok := err.(awserr.Error); ok && aerr.Code() == "InvalidSubnetID.NotFound" {
+			return false, nil
+		}
+		return false, err
+	}
+	return len(output.Subnets) > 0, nil
+}
+
+// SecurityGroupExists checks whether the security group with the given <groupID> exists.
+func (c *Client) SecurityGroupExists(ctx context.Context, groupID string) (bool, error) {
+	output, err := c.EC2.DescribeSecurityGroupsWithContext(ctx, &ec2.DescribeSecurityGroupsInput{GroupIds: []*string{aws.String(groupID)}})
+	if err != nil {
+		if aerr, ok := err.(awserr.Error); ok && aerr.Code() == "InvalidGroup.NotFound" {
+			return false, nil
+		}
+		return false, err
+	}
+	return len(output.SecurityGroups) > 0, nil
+}
+
+// InternetGatewayExists checks whether an internet gateway is attached to the VPC with the given <vpcID>.
+func (c *Client) InternetGatewayExists(ctx context.Context, vpcID string) (bool, error) {
+	output, err := c.EC2.DescribeInternetGatewaysWithContext(ctx, &ec2.DescribeInternetGatewaysInput{
+		Filters: []*ec2.Filter{
+			{
+				Name:   aws.String("attachment.vpc-id"),
+				Values: []*string{aws.String(vpcID)},
+			},
+		},
+	})
+	if err != nil {
+		return false, err
+	}
+	return len(output.InternetGateways) > 0, nil
+}
+
+// SubnetRouteTableExists checks whether a route table is associated with the subnet with the given <subnetID>.
+func (c *Client) SubnetRouteTableExists(ctx context.Context, subnetID string) (bool, error) {
+	output, err := c.EC2.DescribeRouteTablesWithContext(ctx, &ec2.DescribeRouteTablesInput{
+		Filters: []*ec2.Filter{
+			{
+				Name:   aws.String("association.subnet-id"),
+				Values: []*string{aws.String(subnetID)},
+			},
+		},
+	})
+	if err != nil {
+		return false, err
+	}
+	return len(output.RouteTables) > 0, nil
+}
+
 // The following functions are only temporary needed due to https://github.com/gardener/gardener/issues/129.
 
 // ListKubernetesELBs returns the list of load balancers in the given <vpcID> tagged with <clusterName>.
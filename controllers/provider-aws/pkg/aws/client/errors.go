@@ -0,0 +1,54 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"strings"
+
+	controllererror "github.com/gardener/gardener-extensions/pkg/controller/error"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+)
+
+// ErrorPatterns map the error codes of the AWS API to Gardener error codes.
+var ErrorPatterns = []controllererror.Pattern{
+	controllererror.NewPattern(`\b(AuthFailure|InvalidClientTokenId|SignatureDoesNotMatch|UnrecognizedClientException|InvalidAccessKeyId|ExpiredToken|MissingAuthenticationToken)\b`, gardencorev1alpha1.ErrorInfraUnauthorized),
+	controllererror.NewPattern(`\b(UnauthorizedOperation|AccessDenied|AccessDeniedException|Blocked)\b`, gardencorev1alpha1.ErrorInfraInsufficientPrivileges),
+	controllererror.NewPattern(`\b(VpcLimitExceeded|AddressLimitExceeded|InstanceLimitExceeded|NatGatewayLimitExceeded|InternetGatewayLimitExceeded|RouteLimitExceeded|RouteTableLimitExceeded|RulesPerSecurityGroupLimitExceeded|SecurityGroupLimitExceeded|SubnetLimitExceeded|VolumeLimitExceeded|VcpuLimitExceeded|MaxSpotInstanceCountExceeded|TooManyLoadBalancers)\b`, gardencorev1alpha1.ErrorInfraQuotaExceeded),
+	controllererror.NewPattern(`\b(DependencyViolation|InvalidGroup\.InUse|InvalidIPAddress\.InUse|ResourceInUse|OptInRequired|PendingVerification)\b`, gardencorev1alpha1.ErrorInfraDependencies),
+}
+
+// ErrorClassifier determines the Gardener error codes of errors returned by the AWS API. Errors that lost
+// their type, e.g. because they have been formatted into another error, are classified by their message.
+var ErrorClassifier controllererror.Classifier = controllererror.ClassifierFunc(classifyError)
+
+func classifyError(err error) []gardencorev1alpha1.ErrorCode {
+	if err == nil {
+		return nil
+	}
+
+	var awsCodes []string
+	for _, err := range controllererror.Errors(err) {
+		if awsErr, ok := err.(awserr.Error); ok {
+			awsCodes = append(awsCodes, awsErr.Code())
+		}
+	}
+	if len(awsCodes) > 0 {
+		return controllererror.MatchPatterns(strings.Join(awsCodes, " "), ErrorPatterns...)
+	}
+
+	return controllererror.MatchPatterns(err.Error(), ErrorPatterns...)
+}
@@ -26,6 +26,11 @@ import (
 type Interface interface {
 	GetAccountID(ctx context.Context) (string, error)
 	GetInternetGateway(ctx context.Context, vpcID string) (string, error)
+	VPCExists(ctx context.Context, vpcID string) (bool, error)
+	SubnetExists(ctx context.Context, subnetID string) (bool, error)
+	SecurityGroupExists(ctx context.Context, groupID string) (bool, error)
+	InternetGatewayExists(ctx context.Context, vpcID string) (bool, error)
+	SubnetRouteTableExists(ctx context.Context, subnetID string) (bool, error)
 
 	// The following functions are only temporary needed due to https://github.com/gardener/gardener/issues/129.
 	ListKubernetesELBs(ctx context.Context, vpcID, clusterName string) ([]string, error)
@@ -14,7 +14,10 @@
 
 package aws
 
-import "path/filepath"
+import (
+	"fmt"
+	"path/filepath"
+)
 
 const (
 	// Name is the name of the AWS provider.
@@ -30,6 +33,14 @@ const (
 	HyperkubeImageName = "hyperkube"
 	// ETCDBackupRestoreImageName is the name of the etcd backup and restore image.
 	ETCDBackupRestoreImageName = "etcd-backup-restore"
+	// CSIAttacherImageName is the name of the CSI attacher image.
+	CSIAttacherImageName = "csi-attacher"
+	// CSINodeDriverRegistrarImageName is the name of the CSI driver registrar image.
+	CSINodeDriverRegistrarImageName = "csi-node-driver-registrar"
+	// CSIProvisionerImageName is the name of the CSI provisioner image.
+	CSIProvisionerImageName = "csi-provisioner"
+	// CSIPluginImageName is the name of the CSI plugin image.
+	CSIPluginImageName = "csi-plugin-aws"
 
 	// AccessKeyID is a constant for the key in a cloud provider secret and backup secret that holds the AWS access key id.
 	AccessKeyID = "accessKeyID"
@@ -68,6 +79,10 @@ const (
 	MachineControllerManagerName = "machine-controller-manager"
 	// BackupSecretName is the name of the secret containing the credentials for storing the backups of Shoot clusters.
 	BackupSecretName = "etcd-backup"
+
+	// MaxDataVolumes is the maximum number of data volumes of a worker pool, i.e. the number of device names from
+	// /dev/xvdf to /dev/xvdz.
+	MaxDataVolumes = 21
 )
 
 var (
@@ -82,3 +97,9 @@ type Credentials struct {
 	AccessKeyID     []byte
 	SecretAccessKey []byte
 }
+
+// DataVolumeDeviceName returns the device name of the data volume with the given index. Data volumes are attached
+// starting with /dev/xvdf, the first of the device names recommended by AWS for EBS volumes.
+func DataVolumeDeviceName(index int) string {
+	return fmt.Sprintf("/dev/xvd%c", 'f'+index)
+}
@@ -16,6 +16,7 @@ package controlplane
 
 import (
 	"github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/aws"
+	awsclient "github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/aws/client"
 	"github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/imagevector"
 	"github.com/gardener/gardener-extensions/pkg/controller/controlplane"
 	"github.com/gardener/gardener-extensions/pkg/controller/controlplane/genericactuator"
@@ -27,25 +28,35 @@ import (
 )
 
 var (
-	// Options are the default controller.Options for AddToManager.
-	Options = controller.Options{}
+	// DefaultAddOptions are the default AddOptions for AddToManager.
+	DefaultAddOptions = AddOptions{}
 
 	logger = log.Log.WithName("aws-controlplane-controller")
 )
 
+// AddOptions are options to apply when adding the AWS controlplane controller to the manager.
+type AddOptions struct {
+	// Controller are the controller.Options.
+	Controller controller.Options
+	// IgnoreOperationAnnotation specifies whether to ignore the operation annotation or not.
+	IgnoreOperationAnnotation bool
+}
+
 // AddToManagerWithOptions adds a controller with the given Options to the given manager.
 // The opts.Reconciler is being set with a newly instantiated actuator.
-func AddToManagerWithOptions(mgr manager.Manager, opts controller.Options) error {
+func AddToManagerWithOptions(mgr manager.Manager, opts AddOptions) error {
 	return controlplane.Add(mgr, controlplane.AddArgs{
-		Actuator: genericactuator.NewActuator(controlPlaneSecrets, configChart, ccmChart, ccmShootChart,
+		Actuator: controlplane.OperationAnnotationWrapper(genericactuator.NewActuator(controlPlaneSecrets, configChart, controlPlaneChart, controlPlaneShootChart,
 			NewValuesProvider(logger), genericactuator.ShootClientsFactoryFunc(util.NewClientsForShoot),
-			imagevector.ImageVector(), aws.CloudProviderConfigName, logger),
+			imagevector.ImageVector(), aws.CloudProviderConfigName, logger)),
 		Type:              aws.Type,
-		ControllerOptions: opts,
+		ControllerOptions: opts.Controller,
+		Predicates:        controlplane.DefaultPredicates(mgr, opts.IgnoreOperationAnnotation),
+		ErrorClassifier:   awsclient.ErrorClassifier,
 	})
 }
 
 // AddToManager adds a controller with the default Options.
 func AddToManager(mgr manager.Manager) error {
-	return AddToManagerWithOptions(mgr, Options)
+	return AddToManagerWithOptions(mgr, DefaultAddOptions)
 }
@@ -21,6 +21,7 @@ import (
 	"github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/aws"
 	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
 	"github.com/gardener/gardener-extensions/pkg/controller/infrastructure"
+	extensionsterraformer "github.com/gardener/gardener-extensions/pkg/gardener/terraformer"
 
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	glogger "github.com/gardener/gardener/pkg/logger"
@@ -38,6 +39,8 @@ import (
 )
 
 type actuator struct {
+	infrastructure.TerraformerMigrator
+
 	logger logr.Logger
 
 	restConfig *rest.Config
@@ -50,7 +53,8 @@ type actuator struct {
 // NewActuator creates a new Actuator that updates the status of the handled Infrastructure resources.
 func NewActuator() infrastructure.Actuator {
 	return &actuator{
-		logger: log.Log.WithName("infrastructure-actuator"),
+		TerraformerMigrator: infrastructure.NewTerraformerMigrator(aws.TerraformerPurposeInfra),
+		logger:              log.Log.WithName("infrastructure-actuator"),
 	}
 }
 
@@ -62,7 +66,7 @@ func (a *actuator) InjectScheme(scheme *runtime.Scheme) error {
 
 func (a *actuator) InjectClient(client client.Client) error {
 	a.client = client
-	return nil
+	return a.TerraformerMigrator.InjectClient(client)
 }
 
 func (a *actuator) InjectConfig(config *rest.Config) error {
@@ -78,12 +82,24 @@ func (a *actuator) Delete(ctx context.Context, config *extensionsv1alpha1.Infras
 	return a.delete(ctx, config, cluster)
 }
 
+func (a *actuator) DetectDrift(ctx context.Context, config *extensionsv1alpha1.Infrastructure, cluster *extensionscontroller.Cluster) ([]string, error) {
+	return a.detectDrift(ctx, config, cluster)
+}
+
+func (a *actuator) Plan(ctx context.Context, config *extensionsv1alpha1.Infrastructure, cluster *extensionscontroller.Cluster) (*extensionsterraformer.Plan, error) {
+	return a.plan(ctx, config, cluster)
+}
+
 // Helper functions
 
 func (a *actuator) newTerraformer(purpose, namespace, name string) (*terraformer.Terraformer, error) {
 	return terraformer.NewForConfig(glogger.NewLogger("info"), a.restConfig, purpose, namespace, name, imagevector.TerraformerImage())
 }
 
+func (a *actuator) newPlanner(purpose, namespace, name string) (extensionsterraformer.Planner, error) {
+	return extensionsterraformer.NewPlannerForConfig(glogger.NewLogger("info"), a.restConfig, purpose, namespace, name, imagevector.TerraformerImage())
+}
+
 func generateTerraformInfraVariablesEnvironment(secret *corev1.Secret) map[string]string {
 	return terraformer.GenerateVariablesEnvironment(secret, map[string]string{
 		"ACCESS_KEY_ID":     aws.AccessKeyID,
@@ -0,0 +1,132 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package infrastructure
+
+import (
+	"context"
+	"fmt"
+
+	awsapi "github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/apis/aws"
+	"github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/aws"
+	awsclient "github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/aws/client"
+	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/operation/terraformer"
+	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+)
+
+func (a *actuator) detectDrift(ctx context.Context, infrastructure *extensionsv1alpha1.Infrastructure, cluster *extensionscontroller.Cluster) ([]string, error) {
+	infrastructureConfig := &awsapi.InfrastructureConfig{}
+	if _, _, err := a.decoder.Decode(infrastructure.Spec.ProviderConfig.Raw, nil, infrastructureConfig); err != nil {
+		return nil, fmt.Errorf("could not decode provider config: %+v", err)
+	}
+
+	tf, err := a.newTerraformer(aws.TerraformerPurposeInfra, infrastructure.Namespace, infrastructure.Name)
+	if err != nil {
+		return nil, fmt.Errorf("could not create terraformer object: %+v", err)
+	}
+
+	outputVarKeys := []string{
+		aws.VPCIDKey,
+		aws.SecurityGroupsNodes,
+	}
+	for zoneIndex := range infrastructureConfig.Networks.Zones {
+		outputVarKeys = append(outputVarKeys, fmt.Sprintf("%s%d", aws.SubnetNodesPrefix, zoneIndex))
+		outputVarKeys = append(outputVarKeys, fmt.Sprintf("%s%d", aws.SubnetPublicPrefix, zoneIndex))
+	}
+
+	output, err := tf.GetStateOutputVariables(outputVarKeys...)
+	if err != nil {
+		if apierrors.IsNotFound(err) || terraformer.IsVariablesNotFoundError(err) {
+			a.logger.Info("Skipping drift detection because not all variables have been found in the Terraform state", "infrastructure", infrastructure.Name)
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	providerSecret := &corev1.Secret{}
+	if err := a.client.Get(ctx, kutil.Key(infrastructure.Spec.SecretRef.Namespace, infrastructure.Spec.SecretRef.Name), providerSecret); err != nil {
+		return nil, err
+	}
+
+	awsClient, err := awsclient.NewClient(string(providerSecret.Data[aws.AccessKeyID]), string(providerSecret.Data[aws.SecretAccessKey]), infrastructure.Spec.Region)
+	if err != nil {
+		return nil, err
+	}
+
+	return detectDrift(ctx, awsClient, infrastructureConfig, output)
+}
+
+// detectDrift compares the given Terraform state output variables with the live resources of the given AWS client.
+func detectDrift(ctx context.Context, awsClient awsclient.Interface, infrastructureConfig *awsapi.InfrastructureConfig, output map[string]string) ([]string, error) {
+	var drift []string
+
+	vpcID := output[aws.VPCIDKey]
+	exists, err := awsClient.VPCExists(ctx, vpcID)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		// All other resources live in the VPC, hence there is no need to check them.
+		return []string{fmt.Sprintf("VPC %s does not exist", vpcID)}, nil
+	}
+
+	if infrastructureConfig.Networks.VPC.ID == nil {
+		exists, err := awsClient.InternetGatewayExists(ctx, vpcID)
+		if err != nil {
+			return nil, err
+		}
+		if !exists {
+			drift = append(drift, fmt.Sprintf("no internet gateway is attached to VPC %s", vpcID))
+		}
+	}
+
+	securityGroupID := output[aws.SecurityGroupsNodes]
+	exists, err = awsClient.SecurityGroupExists(ctx, securityGroupID)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		drift = append(drift, fmt.Sprintf("security group %s does not exist", securityGroupID))
+	}
+
+	for zoneIndex := range infrastructureConfig.Networks.Zones {
+		for _, prefix := range []string{aws.SubnetNodesPrefix, aws.SubnetPublicPrefix} {
+			subnetID := output[fmt.Sprintf("%s%d", prefix, zoneIndex)]
+			exists, err := awsClient.SubnetExists(ctx, subnetID)
+			if err != nil {
+				return nil, err
+			}
+			if !exists {
+				drift = append(drift, fmt.Sprintf("subnet %s does not exist", subnetID))
+				continue
+			}
+
+			exists, err = awsClient.SubnetRouteTableExists(ctx, subnetID)
+			if err != nil {
+				return nil, err
+			}
+			if !exists {
+				drift = append(drift, fmt.Sprintf("no route table is associated with subnet %s", subnetID))
+			}
+		}
+	}
+
+	return drift, nil
+}
@@ -0,0 +1,127 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package infrastructure
+
+import (
+	"context"
+
+	awsapi "github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/apis/aws"
+	awsclient "github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/aws/client"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+type fakeAWSClient struct {
+	awsclient.Interface
+
+	missing map[string]bool
+	err     error
+}
+
+func (c *fakeAWSClient) exists(id string) (bool, error) {
+	if c.err != nil {
+		return false, c.err
+	}
+	return !c.missing[id], nil
+}
+
+func (c *fakeAWSClient) VPCExists(_ context.Context, vpcID string) (bool, error) {
+	return c.exists(vpcID)
+}
+
+func (c *fakeAWSClient) SubnetExists(_ context.Context, subnetID string) (bool, error) {
+	return c.exists(subnetID)
+}
+
+func (c *fakeAWSClient) SecurityGroupExists(_ context.Context, groupID string) (bool, error) {
+	return c.exists(groupID)
+}
+
+func (c *fakeAWSClient) InternetGatewayExists(_ context.Context, vpcID string) (bool, error) {
+	return c.exists("igw-" + vpcID)
+}
+
+func (c *fakeAWSClient) SubnetRouteTableExists(_ context.Context, subnetID string) (bool, error) {
+	return c.exists("rtb-" + subnetID)
+}
+
+var _ = Describe("Drift", func() {
+	var (
+		ctx                  = context.TODO()
+		awsClient            *fakeAWSClient
+		infrastructureConfig *awsapi.InfrastructureConfig
+		output               = map[string]string{
+			"vpc_id":                   "vpc-1",
+			"security_group_nodes":     "sg-1",
+			"subnet_nodes_z0":          "subnet-1",
+			"subnet_public_utility_z0": "subnet-2",
+		}
+	)
+
+	BeforeEach(func() {
+		awsClient = &fakeAWSClient{missing: map[string]bool{}}
+		infrastructureConfig = &awsapi.InfrastructureConfig{
+			Networks: awsapi.Networks{
+				Zones: []awsapi.Zone{{Name: "eu-west-1a"}},
+			},
+		}
+	})
+
+	Describe("#detectDrift", func() {
+		It("should not report drift if all resources exist", func() {
+			Expect(detectDrift(ctx, awsClient, infrastructureConfig, output)).To(BeEmpty())
+		})
+
+		It("should only report the VPC if it does not exist", func() {
+			awsClient.missing["vpc-1"] = true
+			awsClient.missing["sg-1"] = true
+
+			Expect(detectDrift(ctx, awsClient, infrastructureConfig, output)).To(ConsistOf("VPC vpc-1 does not exist"))
+		})
+
+		It("should report missing internet gateways, security groups, subnets and route tables", func() {
+			awsClient.missing["igw-vpc-1"] = true
+			awsClient.missing["sg-1"] = true
+			awsClient.missing["subnet-1"] = true
+			awsClient.missing["rtb-subnet-2"] = true
+
+			Expect(detectDrift(ctx, awsClient, infrastructureConfig, output)).To(ConsistOf(
+				"no internet gateway is attached to VPC vpc-1",
+				"security group sg-1 does not exist",
+				"subnet subnet-1 does not exist",
+				"no route table is associated with subnet subnet-2",
+			))
+		})
+
+		It("should not check the internet gateway of existing VPCs", func() {
+			vpcID := "vpc-1"
+			infrastructureConfig.Networks.VPC.ID = &vpcID
+			awsClient.missing["igw-vpc-1"] = true
+
+			Expect(detectDrift(ctx, awsClient, infrastructureConfig, output)).To(BeEmpty())
+		})
+
+		It("should return errors instead of reporting drift", func() {
+			awsClient.err = awserr.New("Throttling", "Rate exceeded", nil)
+
+			drift, err := detectDrift(ctx, awsClient, infrastructureConfig, output)
+
+			Expect(err).To(HaveOccurred())
+			Expect(drift).To(BeNil())
+		})
+	})
+})
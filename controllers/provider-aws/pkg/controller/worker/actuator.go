@@ -48,13 +48,15 @@ type delegateFactory struct {
 	decoder runtime.Decoder
 
 	machineImageToAMIMapping []config.MachineImage
+	machineSettings          config.MachineSettings
 }
 
 // NewActuator creates a new Actuator that updates the status of the handled WorkerPoolConfigs.
-func NewActuator(machineImageToAMIMapping []config.MachineImage) worker.Actuator {
+func NewActuator(machineImageToAMIMapping []config.MachineImage, machineSettings config.MachineSettings, rolloutSettings worker.RolloutSettings) worker.Actuator {
 	delegateFactory := &delegateFactory{
 		logger:                   log.Log.WithName("worker-actuator"),
 		machineImageToAMIMapping: machineImageToAMIMapping,
+		machineSettings:          machineSettings,
 	}
 	return genericactuator.NewActuator(
 		log.Log.WithName("aws-worker-actuator"),
@@ -63,6 +65,7 @@ func NewActuator(machineImageToAMIMapping []config.MachineImage) worker.Actuator
 		mcmChart,
 		mcmShootChart,
 		imagevector.ImageVector(),
+		rolloutSettings,
 	)
 }
 
@@ -103,6 +106,7 @@ func (d *delegateFactory) WorkerDelegate(ctx context.Context, worker *extensions
 		d.decoder,
 
 		d.machineImageToAMIMapping,
+		d.machineSettings,
 		seedChartApplier,
 		serverVersion.GitVersion,
 
@@ -116,6 +120,7 @@ type workerDelegate struct {
 	decoder runtime.Decoder
 
 	machineImageToAMIMapping []config.MachineImage
+	machineSettings          config.MachineSettings
 	seedChartApplier         gardener.ChartApplier
 	serverVersion            string
 
@@ -132,6 +137,7 @@ func NewWorkerDelegate(
 	decoder runtime.Decoder,
 
 	machineImageToAMIMapping []config.MachineImage,
+	machineSettings config.MachineSettings,
 	seedChartApplier gardener.ChartApplier,
 	serverVersion string,
 
@@ -143,6 +149,7 @@ func NewWorkerDelegate(
 		decoder: decoder,
 
 		machineImageToAMIMapping: machineImageToAMIMapping,
+		machineSettings:          machineSettings,
 		seedChartApplier:         seedChartApplier,
 		serverVersion:            serverVersion,
 
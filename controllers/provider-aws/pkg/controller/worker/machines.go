@@ -23,9 +23,12 @@ import (
 	awsapihelper "github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/apis/aws/helper"
 	confighelper "github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/apis/config/helper"
 	"github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/aws"
+	workerhelper "github.com/gardener/gardener-extensions/pkg/apis/worker/helper"
 	"github.com/gardener/gardener-extensions/pkg/controller/worker"
 	"github.com/gardener/gardener-extensions/pkg/util"
 
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
 	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
 
 	"k8s.io/apimachinery/pkg/runtime"
@@ -41,6 +44,11 @@ func (w *workerDelegate) MachineClassList() runtime.Object {
 	return &machinev1alpha1.AWSMachineClassList{}
 }
 
+// MachineClassReplacementFields yields the fields of the AWS machine class specs whose changes require new machines.
+func (w *workerDelegate) MachineClassReplacementFields() []string {
+	return []string{"ami", "region", "machineType", "iamInstanceProfile", "keyName", "networkInterfaces", "blockDevices", "secret"}
+}
+
 // DeployMachineClasses generates and creates the AWS specific machine classes.
 func (w *workerDelegate) DeployMachineClasses(ctx context.Context) error {
 	if w.machineClasses == nil {
@@ -89,6 +97,11 @@ func (w *workerDelegate) generateMachineConfig(ctx context.Context) error {
 		return err
 	}
 
+	classNamesInUse, err := worker.MachineClassNamesInUse(ctx, w.client, w.worker.Namespace)
+	if err != nil {
+		return err
+	}
+
 	shootVersionMajorMinor, err := util.VersionMajorMinor(w.cluster.Shoot.Spec.Kubernetes.Version)
 	if err != nil {
 		return err
@@ -109,18 +122,53 @@ func (w *workerDelegate) generateMachineConfig(ctx context.Context) error {
 	}
 
 	for _, pool := range w.worker.Spec.Pools {
-		zoneLen := len(pool.Zones)
+		workerConfig, err := workerhelper.WorkerConfigFromPool(pool)
+		if err != nil {
+			return err
+		}
+
+		spotSettings := worker.SpotSettingsFromConfig(workerConfig)
+		if spotSettings != nil {
+			return fmt.Errorf("pool %q: spot instances are not supported on AWS by the used machine-controller-manager", pool.Name)
+		}
+
+		autoscalerOptions := worker.AutoscalerOptionsFromConfig(workerConfig)
+		nodeTemplate := worker.NodeTemplateForMachineType(w.machineTypes(), pool.MachineType, pool.Labels, pool.Taints)
+
+		poolMachineSettings := worker.MachineSettingsFromConfig(workerConfig)
+		machineSettings := poolMachineSettings.WithDefaults(w.defaultMachineSettings())
+
+		zoneDistribution, err := worker.ZoneDistributionFromPool(pool, workerConfig)
+		if err != nil {
+			return err
+		}
 
 		ami, err := confighelper.FindAMIForRegion(w.machineImageToAMIMapping, pool.MachineImage.Name, pool.MachineImage.Version, w.worker.Spec.Region)
 		if err != nil {
 			return err
 		}
+		machineImage, err := confighelper.FindMachineImage(w.machineImageToAMIMapping, pool.MachineImage.Name, pool.MachineImage.Version)
+		if err != nil {
+			return err
+		}
+		usedMachineImage := &worker.MachineImage{
+			Name:            machineImage.Name,
+			Version:         machineImage.Version,
+			ID:              ami,
+			DeprecationDate: machineImage.DeprecationDate,
+			ExpirationDate:  machineImage.ExpirationDate,
+		}
 
 		volumeSize, err := worker.DiskSize(pool.Volume.Size)
 		if err != nil {
 			return err
 		}
 
+		blockDevices, err := computeBlockDevices(pool, volumeSize, worker.DataVolumesFromConfig(workerConfig))
+		if err != nil {
+			return err
+		}
+
 		for zoneIndex, zone := range pool.Zones {
 			nodesSubnet, err := awsapihelper.FindSubnetForPurposeAndZone(infrastructureStatus.VPC.Subnets, awsapi.PurposeNodes, zone)
 			if err != nil {
@@ -146,33 +194,31 @@ func (w *workerDelegate) generateMachineConfig(ctx context.Context) error {
 				"secret": map[string]interface{}{
 					"cloudConfig": string(pool.UserData),
 				},
-				"blockDevices": []map[string]interface{}{
-					{
-						"ebs": map[string]interface{}{
-							"volumeSize": volumeSize,
-							"volumeType": pool.Volume.Type,
-						},
-					},
-				},
+				"blockDevices": blockDevices,
 			}
 
 			var (
-				machineClassSpecHash = worker.MachineClassHash(machineClassSpec, shootVersionMajorMinor)
-				deploymentName       = fmt.Sprintf("%s-%s-z%d", w.worker.Namespace, pool.Name, zoneIndex+1)
-				className            = fmt.Sprintf("%s-%s", deploymentName, machineClassSpecHash)
+				deploymentName = fmt.Sprintf("%s-%s-z%d", w.worker.Namespace, pool.Name, zoneIndex+1)
+				className      = worker.MachineClassName(deploymentName, machineClassSpec, shootVersionMajorMinor, classNamesInUse, w.MachineClassReplacementFields()...)
 			)
 
 			machineDeployments = append(machineDeployments, worker.MachineDeployment{
-				Name:           deploymentName,
-				ClassName:      className,
-				SecretName:     className,
-				Minimum:        worker.DistributeOverZones(zoneIndex, pool.Minimum, zoneLen),
-				Maximum:        worker.DistributeOverZones(zoneIndex, pool.Maximum, zoneLen),
-				MaxSurge:       worker.DistributePositiveIntOrPercent(zoneIndex, pool.MaxSurge, zoneLen, pool.Maximum),
-				MaxUnavailable: worker.DistributePositiveIntOrPercent(zoneIndex, pool.MaxUnavailable, zoneLen, pool.Minimum),
-				Labels:         pool.Labels,
-				Annotations:    pool.Annotations,
-				Taints:         pool.Taints,
+				Name:            deploymentName,
+				PoolName:        pool.Name,
+				Zone:            zone,
+				ClassName:       className,
+				SecretName:      className,
+				Minimum:         zoneDistribution.Minimum(zoneIndex),
+				Maximum:         zoneDistribution.Maximum(zoneIndex),
+				MaxSurge:        zoneDistribution.MaxSurge(zoneIndex),
+				MaxUnavailable:  zoneDistribution.MaxUnavailable(zoneIndex),
+				Labels:          pool.Labels,
+				Annotations:     pool.Annotations,
+				Taints:          pool.Taints,
+				Autoscaler:      autoscalerOptions,
+				NodeTemplate:    nodeTemplate,
+				MachineSettings: machineSettings,
+				MachineImage:    usedMachineImage,
 			})
 
 			machineClassSpec["name"] = className
@@ -188,3 +234,62 @@ func (w *workerDelegate) generateMachineConfig(ctx context.Context) error {
 
 	return nil
 }
+
+// computeBlockDevices returns the block devices of the machine class, i.e. the root disk followed by the data volumes
+// of the given pool.
+func computeBlockDevices(pool extensionsv1alpha1.WorkerPool, volumeSize int, dataVolumes []worker.DataVolume) ([]map[string]interface{}, error) {
+	if len(dataVolumes) > aws.MaxDataVolumes {
+		return nil, fmt.Errorf("pool %q has %d data volumes, at most %d are supported", pool.Name, len(dataVolumes), aws.MaxDataVolumes)
+	}
+
+	blockDevices := []map[string]interface{}{
+		{
+			"ebs": map[string]interface{}{
+				"volumeSize": volumeSize,
+				"volumeType": pool.Volume.Type,
+			},
+		},
+	}
+
+	for i, volume := range dataVolumes {
+		size, err := worker.DiskSize(volume.Size)
+		if err != nil {
+			return nil, err
+		}
+
+		volumeType := pool.Volume.Type
+		if volume.Type != nil {
+			volumeType = *volume.Type
+		}
+
+		blockDevices = append(blockDevices, map[string]interface{}{
+			"deviceName": aws.DataVolumeDeviceName(i),
+			"ebs": map[string]interface{}{
+				"volumeSize":          size,
+				"volumeType":          volumeType,
+				"encrypted":           volume.Encrypted != nil && *volume.Encrypted,
+				"deleteOnTermination": true,
+			},
+		})
+	}
+	return blockDevices, nil
+}
+
+// machineTypes returns the machine types of the AWS section of the CloudProfile, if any.
+func (w *workerDelegate) machineTypes() []gardenv1beta1.MachineType {
+	if w.cluster.CloudProfile == nil || w.cluster.CloudProfile.Spec.AWS == nil {
+		return nil
+	}
+	return w.cluster.CloudProfile.Spec.AWS.Constraints.MachineTypes
+}
+
+// defaultMachineSettings returns the machine settings of the controller configuration, which are used for all
+// worker pools that do not overwrite them.
+func (w *workerDelegate) defaultMachineSettings() worker.MachineSettings {
+	return worker.MachineSettings{
+		DrainTimeout:    w.machineSettings.DrainTimeout,
+		HealthTimeout:   w.machineSettings.HealthTimeout,
+		CreationTimeout: w.machineSettings.CreationTimeout,
+		MaxEvictRetries: w.machineSettings.MaxEvictRetries,
+	}
+}
@@ -19,6 +19,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"path/filepath"
+	"time"
 
 	apisaws "github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/apis/aws"
 	"github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/apis/config"
@@ -36,10 +37,12 @@ import (
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/serializer"
 	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/pointer"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -62,7 +65,7 @@ var _ = Describe("Machines", func() {
 	})
 
 	Context("workerDelegate", func() {
-		workerDelegate := NewWorkerDelegate(nil, nil, nil, nil, "", nil, nil)
+		workerDelegate := NewWorkerDelegate(nil, nil, nil, config.MachineSettings{}, nil, "", nil, nil)
 
 		Describe("#MachineClassKind", func() {
 			It("should return the correct kind of the machine class", func() {
@@ -76,6 +79,12 @@ var _ = Describe("Machines", func() {
 			})
 		})
 
+		Describe("#MachineClassReplacementFields", func() {
+			It("should return the fields whose changes require new machines", func() {
+				Expect(workerDelegate.MachineClassReplacementFields()).To(ConsistOf("ami", "region", "machineType", "iamInstanceProfile", "keyName", "networkInterfaces", "blockDevices", "secret"))
+			})
+		})
+
 		Describe("#GenerateMachineDeployments, #DeployMachineClasses", func() {
 			var (
 				namespace string
@@ -88,8 +97,14 @@ var _ = Describe("Machines", func() {
 				machineImageVersion string
 				machineImageAMI     string
 
+				machineImageExpirationDate metav1.Time
+
 				vpcID               string
 				machineType         string
+				machineTypeCPU      resource.Quantity
+				machineTypeGPU      resource.Quantity
+				machineTypeMemory   resource.Quantity
+				nodeTemplate        *worker.NodeTemplate
 				userData            []byte
 				instanceProfileName string
 				securityGroupID     string
@@ -109,6 +124,8 @@ var _ = Describe("Machines", func() {
 				maxPool2            int
 				maxSurgePool2       intstr.IntOrString
 				maxUnavailablePool2 intstr.IntOrString
+				priorityPool2       int32
+				drainTimeoutPool2   metav1.Duration
 
 				subnetZone1 string
 				subnetZone2 string
@@ -118,6 +135,7 @@ var _ = Describe("Machines", func() {
 				shootVersionMajorMinor   string
 				shootVersion             string
 				machineImageToAMIMapping []config.MachineImage
+				machineSettings          config.MachineSettings
 				scheme                   *runtime.Scheme
 				decoder                  runtime.Decoder
 				cluster                  *extensionscontroller.Cluster
@@ -134,9 +152,20 @@ var _ = Describe("Machines", func() {
 				machineImageName = "my-os"
 				machineImageVersion = "123"
 				machineImageAMI = "ami-123456"
+				machineImageExpirationDate = metav1.NewTime(time.Date(2030, time.January, 1, 0, 0, 0, 0, time.UTC))
 
 				vpcID = "vpc-1234"
 				machineType = "large"
+				machineTypeCPU = resource.MustParse("2")
+				machineTypeGPU = resource.MustParse("0")
+				machineTypeMemory = resource.MustParse("8Gi")
+				nodeTemplate = &worker.NodeTemplate{
+					Capacity: corev1.ResourceList{
+						corev1.ResourceCPU:    machineTypeCPU,
+						corev1.ResourceMemory: machineTypeMemory,
+						worker.ResourceGPU:    machineTypeGPU,
+					},
+				}
 				userData = []byte("some-user-data")
 				instanceProfileName = "nodes-instance-prof"
 				securityGroupID = "sg-12345"
@@ -156,6 +185,8 @@ var _ = Describe("Machines", func() {
 				maxPool2 = 45
 				maxSurgePool2 = intstr.FromInt(10)
 				maxUnavailablePool2 = intstr.FromInt(15)
+				priorityPool2 = 10
+				drainTimeoutPool2 = metav1.Duration{Duration: time.Hour}
 
 				subnetZone1 = "subnet-acbd1234"
 				subnetZone2 = "subnet-4321dbca"
@@ -175,10 +206,32 @@ var _ = Describe("Machines", func() {
 								AMI:  machineImageAMI,
 							},
 						},
+						ExpirationDate: &machineImageExpirationDate,
 					},
 				}
 
+				machineSettings = config.MachineSettings{
+					DrainTimeout:    &metav1.Duration{Duration: 30 * time.Minute},
+					MaxEvictRetries: pointer.Int32Ptr(20),
+				}
+
 				cluster = &extensionscontroller.Cluster{
+					CloudProfile: &gardenv1beta1.CloudProfile{
+						Spec: gardenv1beta1.CloudProfileSpec{
+							AWS: &gardenv1beta1.AWSProfile{
+								Constraints: gardenv1beta1.AWSConstraints{
+									MachineTypes: []gardenv1beta1.MachineType{
+										{
+											Name:   machineType,
+											CPU:    machineTypeCPU,
+											GPU:    machineTypeGPU,
+											Memory: machineTypeMemory,
+										},
+									},
+								},
+							},
+						},
+					},
 					Shoot: &gardenv1beta1.Shoot{
 						Spec: gardenv1beta1.ShootSpec{
 							Kubernetes: gardenv1beta1.Kubernetes{
@@ -263,6 +316,9 @@ var _ = Describe("Machines", func() {
 								MaxSurge:       maxSurgePool2,
 								MaxUnavailable: maxUnavailablePool2,
 								MachineType:    machineType,
+								ProviderConfig: &runtime.RawExtension{
+									Raw: []byte(fmt.Sprintf(`{"apiVersion":"worker.extensions.gardener.cloud/v1alpha1","kind":"WorkerConfig","autoscaler":{"scaleDownDisabled":true,"priority":%d},"machineDrainTimeout":%q,"dataVolumes":[{"name":"cache","size":"50Gi","type":"io1","encrypted":true,"mountPath":"/var/lib/cache"}]}`, priorityPool2, drainTimeoutPool2.Duration)),
+								},
 								MachineImage: extensionsv1alpha1.MachineImage{
 									Name:    machineImageName,
 									Version: machineImageVersion,
@@ -285,11 +341,12 @@ var _ = Describe("Machines", func() {
 				_ = apisaws.AddToScheme(scheme)
 				decoder = serializer.NewCodecFactory(scheme).UniversalDecoder()
 
-				workerDelegate = NewWorkerDelegate(c, decoder, machineImageToAMIMapping, chartApplier, "", w, cluster)
+				workerDelegate = NewWorkerDelegate(c, decoder, machineImageToAMIMapping, machineSettings, chartApplier, "", w, cluster)
 			})
 
 			It("should return the expected machine deployments", func() {
 				expectGetSecretCallToWork(c, awsAccessKeyID, awsSecretAccessKey)
+				expectListMachineDeploymentsCallToWork(c)
 
 				// Test workerDelegate.DeployMachineClasses()
 				var (
@@ -316,6 +373,24 @@ var _ = Describe("Machines", func() {
 						},
 					}
 
+					defaultMachineClassWithDataVolume = useDefaultMachineClass(defaultMachineClass, "blockDevices", []map[string]interface{}{
+						{
+							"ebs": map[string]interface{}{
+								"volumeSize": volumeSize,
+								"volumeType": volumeType,
+							},
+						},
+						{
+							"deviceName": "/dev/xvdf",
+							"ebs": map[string]interface{}{
+								"volumeSize":          50,
+								"volumeType":          "io1",
+								"encrypted":           true,
+								"deleteOnTermination": true,
+							},
+						},
+					})
+
 					machineClassPool1Zone1 = useDefaultMachineClass(defaultMachineClass, "networkInterfaces", []map[string]interface{}{
 						{
 							"subnetID":         subnetZone1,
@@ -328,13 +403,13 @@ var _ = Describe("Machines", func() {
 							"securityGroupIDs": []string{securityGroupID},
 						},
 					})
-					machineClassPool2Zone1 = useDefaultMachineClass(defaultMachineClass, "networkInterfaces", []map[string]interface{}{
+					machineClassPool2Zone1 = useDefaultMachineClass(defaultMachineClassWithDataVolume, "networkInterfaces", []map[string]interface{}{
 						{
 							"subnetID":         subnetZone1,
 							"securityGroupIDs": []string{securityGroupID},
 						},
 					})
-					machineClassPool2Zone2 = useDefaultMachineClass(defaultMachineClass, "networkInterfaces", []map[string]interface{}{
+					machineClassPool2Zone2 = useDefaultMachineClass(defaultMachineClassWithDataVolume, "networkInterfaces", []map[string]interface{}{
 						{
 							"subnetID":         subnetZone2,
 							"securityGroupIDs": []string{securityGroupID},
@@ -346,10 +421,10 @@ var _ = Describe("Machines", func() {
 					machineClassNamePool2Zone1 = fmt.Sprintf("%s-%s-z1", namespace, namePool2)
 					machineClassNamePool2Zone2 = fmt.Sprintf("%s-%s-z2", namespace, namePool2)
 
-					machineClassHashPool1Zone1 = worker.MachineClassHash(machineClassPool1Zone1, shootVersionMajorMinor)
-					machineClassHashPool1Zone2 = worker.MachineClassHash(machineClassPool1Zone2, shootVersionMajorMinor)
-					machineClassHashPool2Zone1 = worker.MachineClassHash(machineClassPool2Zone1, shootVersionMajorMinor)
-					machineClassHashPool2Zone2 = worker.MachineClassHash(machineClassPool2Zone2, shootVersionMajorMinor)
+					machineClassHashPool1Zone1 = worker.MachineClassHash(machineClassPool1Zone1, shootVersionMajorMinor, workerDelegate.MachineClassReplacementFields()...)
+					machineClassHashPool1Zone2 = worker.MachineClassHash(machineClassPool1Zone2, shootVersionMajorMinor, workerDelegate.MachineClassReplacementFields()...)
+					machineClassHashPool2Zone1 = worker.MachineClassHash(machineClassPool2Zone1, shootVersionMajorMinor, workerDelegate.MachineClassReplacementFields()...)
+					machineClassHashPool2Zone2 = worker.MachineClassHash(machineClassPool2Zone2, shootVersionMajorMinor, workerDelegate.MachineClassReplacementFields()...)
 
 					machineClassWithHashPool1Zone1 = fmt.Sprintf("%s-%s", machineClassNamePool1Zone1, machineClassHashPool1Zone1)
 					machineClassWithHashPool1Zone2 = fmt.Sprintf("%s-%s", machineClassNamePool1Zone2, machineClassHashPool1Zone2)
@@ -383,42 +458,89 @@ var _ = Describe("Machines", func() {
 				Expect(err).NotTo(HaveOccurred())
 
 				// Test workerDelegate.GenerateMachineDeployments()
+				usedMachineImage := &worker.MachineImage{
+					Name:           machineImageName,
+					Version:        machineImageVersion,
+					ID:             machineImageAMI,
+					ExpirationDate: &machineImageExpirationDate,
+				}
+
 				machineDeployments := worker.MachineDeployments{
 					{
 						Name:           machineClassNamePool1Zone1,
+						PoolName:       namePool1,
+						Zone:           zone1,
 						ClassName:      machineClassWithHashPool1Zone1,
 						SecretName:     machineClassWithHashPool1Zone1,
 						Minimum:        worker.DistributeOverZones(0, minPool1, 2),
 						Maximum:        worker.DistributeOverZones(0, maxPool1, 2),
 						MaxSurge:       worker.DistributePositiveIntOrPercent(0, maxSurgePool1, 2, maxPool1),
 						MaxUnavailable: worker.DistributePositiveIntOrPercent(0, maxUnavailablePool1, 2, minPool1),
+						NodeTemplate:   nodeTemplate,
+						MachineSettings: worker.MachineSettings{
+							DrainTimeout:    machineSettings.DrainTimeout,
+							MaxEvictRetries: machineSettings.MaxEvictRetries,
+						},
+						MachineImage: usedMachineImage,
 					},
 					{
 						Name:           machineClassNamePool1Zone2,
+						PoolName:       namePool1,
+						Zone:           zone2,
 						ClassName:      machineClassWithHashPool1Zone2,
 						SecretName:     machineClassWithHashPool1Zone2,
 						Minimum:        worker.DistributeOverZones(1, minPool1, 2),
 						Maximum:        worker.DistributeOverZones(1, maxPool1, 2),
 						MaxSurge:       worker.DistributePositiveIntOrPercent(1, maxSurgePool1, 2, maxPool1),
 						MaxUnavailable: worker.DistributePositiveIntOrPercent(1, maxUnavailablePool1, 2, minPool1),
+						NodeTemplate:   nodeTemplate,
+						MachineSettings: worker.MachineSettings{
+							DrainTimeout:    machineSettings.DrainTimeout,
+							MaxEvictRetries: machineSettings.MaxEvictRetries,
+						},
+						MachineImage: usedMachineImage,
 					},
 					{
 						Name:           machineClassNamePool2Zone1,
+						PoolName:       namePool2,
+						Zone:           zone1,
 						ClassName:      machineClassWithHashPool2Zone1,
 						SecretName:     machineClassWithHashPool2Zone1,
 						Minimum:        worker.DistributeOverZones(0, minPool2, 2),
 						Maximum:        worker.DistributeOverZones(0, maxPool2, 2),
 						MaxSurge:       worker.DistributePositiveIntOrPercent(0, maxSurgePool2, 2, maxPool2),
 						MaxUnavailable: worker.DistributePositiveIntOrPercent(0, maxUnavailablePool2, 2, minPool2),
+						Autoscaler: worker.AutoscalerOptions{
+							ScaleDownDisabled: true,
+							Priority:          &priorityPool2,
+						},
+						NodeTemplate: nodeTemplate,
+						MachineSettings: worker.MachineSettings{
+							DrainTimeout:    &drainTimeoutPool2,
+							MaxEvictRetries: machineSettings.MaxEvictRetries,
+						},
+						MachineImage: usedMachineImage,
 					},
 					{
 						Name:           machineClassNamePool2Zone2,
+						PoolName:       namePool2,
+						Zone:           zone2,
 						ClassName:      machineClassWithHashPool2Zone2,
 						SecretName:     machineClassWithHashPool2Zone2,
 						Minimum:        worker.DistributeOverZones(1, minPool2, 2),
 						Maximum:        worker.DistributeOverZones(1, maxPool2, 2),
 						MaxSurge:       worker.DistributePositiveIntOrPercent(1, maxSurgePool2, 2, maxPool2),
 						MaxUnavailable: worker.DistributePositiveIntOrPercent(1, maxUnavailablePool2, 2, minPool2),
+						Autoscaler: worker.AutoscalerOptions{
+							ScaleDownDisabled: true,
+							Priority:          &priorityPool2,
+						},
+						NodeTemplate: nodeTemplate,
+						MachineSettings: worker.MachineSettings{
+							DrainTimeout:    &drainTimeoutPool2,
+							MaxEvictRetries: machineSettings.MaxEvictRetries,
+						},
+						MachineImage: usedMachineImage,
 					},
 				}
 
@@ -437,11 +559,23 @@ var _ = Describe("Machines", func() {
 				Expect(result).To(BeNil())
 			})
 
+			It("should fail because the machine deployments cannot be listed", func() {
+				expectGetSecretCallToWork(c, awsAccessKeyID, awsSecretAccessKey)
+				c.EXPECT().
+					List(context.TODO(), gomock.Any(), gomock.AssignableToTypeOf(&machinev1alpha1.MachineDeploymentList{})).
+					Return(fmt.Errorf("error"))
+
+				result, err := workerDelegate.GenerateMachineDeployments(context.TODO())
+				Expect(err).To(HaveOccurred())
+				Expect(result).To(BeNil())
+			})
+
 			It("should fail because the version is invalid", func() {
 				expectGetSecretCallToWork(c, awsAccessKeyID, awsSecretAccessKey)
+				expectListMachineDeploymentsCallToWork(c)
 
 				cluster.Shoot.Spec.Kubernetes.Version = "invalid"
-				workerDelegate = NewWorkerDelegate(c, decoder, machineImageToAMIMapping, chartApplier, "", w, cluster)
+				workerDelegate = NewWorkerDelegate(c, decoder, machineImageToAMIMapping, machineSettings, chartApplier, "", w, cluster)
 
 				result, err := workerDelegate.GenerateMachineDeployments(context.TODO())
 				Expect(err).To(HaveOccurred())
@@ -450,10 +584,11 @@ var _ = Describe("Machines", func() {
 
 			It("should fail because the infrastructure status cannot be decoded", func() {
 				expectGetSecretCallToWork(c, awsAccessKeyID, awsSecretAccessKey)
+				expectListMachineDeploymentsCallToWork(c)
 
 				w.Spec.InfrastructureProviderStatus = &runtime.RawExtension{}
 
-				workerDelegate = NewWorkerDelegate(c, decoder, machineImageToAMIMapping, chartApplier, "", w, cluster)
+				workerDelegate = NewWorkerDelegate(c, decoder, machineImageToAMIMapping, machineSettings, chartApplier, "", w, cluster)
 
 				result, err := workerDelegate.GenerateMachineDeployments(context.TODO())
 				Expect(err).To(HaveOccurred())
@@ -462,12 +597,13 @@ var _ = Describe("Machines", func() {
 
 			It("should fail because the nodes instance profile cannot be found", func() {
 				expectGetSecretCallToWork(c, awsAccessKeyID, awsSecretAccessKey)
+				expectListMachineDeploymentsCallToWork(c)
 
 				w.Spec.InfrastructureProviderStatus = &runtime.RawExtension{
 					Raw: encode(&apisaws.InfrastructureStatus{}),
 				}
 
-				workerDelegate = NewWorkerDelegate(c, decoder, machineImageToAMIMapping, chartApplier, "", w, cluster)
+				workerDelegate = NewWorkerDelegate(c, decoder, machineImageToAMIMapping, machineSettings, chartApplier, "", w, cluster)
 
 				result, err := workerDelegate.GenerateMachineDeployments(context.TODO())
 				Expect(err).To(HaveOccurred())
@@ -476,6 +612,7 @@ var _ = Describe("Machines", func() {
 
 			It("should fail because the security group cannot be found", func() {
 				expectGetSecretCallToWork(c, awsAccessKeyID, awsSecretAccessKey)
+				expectListMachineDeploymentsCallToWork(c)
 
 				w.Spec.InfrastructureProviderStatus = &runtime.RawExtension{
 					Raw: encode(&apisaws.InfrastructureStatus{
@@ -490,7 +627,7 @@ var _ = Describe("Machines", func() {
 					}),
 				}
 
-				workerDelegate = NewWorkerDelegate(c, decoder, machineImageToAMIMapping, chartApplier, "", w, cluster)
+				workerDelegate = NewWorkerDelegate(c, decoder, machineImageToAMIMapping, machineSettings, chartApplier, "", w, cluster)
 
 				result, err := workerDelegate.GenerateMachineDeployments(context.TODO())
 				Expect(err).To(HaveOccurred())
@@ -499,10 +636,11 @@ var _ = Describe("Machines", func() {
 
 			It("should fail because the ami for this region cannot be found", func() {
 				expectGetSecretCallToWork(c, awsAccessKeyID, awsSecretAccessKey)
+				expectListMachineDeploymentsCallToWork(c)
 
 				w.Spec.Region = "another-region"
 
-				workerDelegate = NewWorkerDelegate(c, decoder, machineImageToAMIMapping, chartApplier, "", w, cluster)
+				workerDelegate = NewWorkerDelegate(c, decoder, machineImageToAMIMapping, machineSettings, chartApplier, "", w, cluster)
 
 				result, err := workerDelegate.GenerateMachineDeployments(context.TODO())
 				Expect(err).To(HaveOccurred())
@@ -511,6 +649,7 @@ var _ = Describe("Machines", func() {
 
 			It("should fail because the subnet id cannot be found", func() {
 				expectGetSecretCallToWork(c, awsAccessKeyID, awsSecretAccessKey)
+				expectListMachineDeploymentsCallToWork(c)
 
 				w.Spec.InfrastructureProviderStatus = &runtime.RawExtension{
 					Raw: encode(&apisaws.InfrastructureStatus{
@@ -534,7 +673,33 @@ var _ = Describe("Machines", func() {
 					}),
 				}
 
-				workerDelegate = NewWorkerDelegate(c, decoder, machineImageToAMIMapping, chartApplier, "", w, cluster)
+				workerDelegate = NewWorkerDelegate(c, decoder, machineImageToAMIMapping, machineSettings, chartApplier, "", w, cluster)
+
+				result, err := workerDelegate.GenerateMachineDeployments(context.TODO())
+				Expect(err).To(HaveOccurred())
+				Expect(result).To(BeNil())
+			})
+
+			It("should fail because spot instances are requested", func() {
+				expectGetSecretCallToWork(c, awsAccessKeyID, awsSecretAccessKey)
+				expectListMachineDeploymentsCallToWork(c)
+
+				w.Spec.Pools[1].ProviderConfig.Raw = []byte(`{"spot":{"maxPrice":"0.5"}}`)
+
+				workerDelegate = NewWorkerDelegate(c, decoder, machineImageToAMIMapping, machineSettings, chartApplier, "", w, cluster)
+
+				result, err := workerDelegate.GenerateMachineDeployments(context.TODO())
+				Expect(err).To(HaveOccurred())
+				Expect(result).To(BeNil())
+			})
+
+			It("should fail because the data volumes are invalid", func() {
+				expectGetSecretCallToWork(c, awsAccessKeyID, awsSecretAccessKey)
+				expectListMachineDeploymentsCallToWork(c)
+
+				w.Spec.Pools[1].ProviderConfig.Raw = []byte(`{"apiVersion":"worker.extensions.gardener.cloud/v1alpha1","kind":"WorkerConfig","dataVolumes":[{"name":"cache","size":"large"}]}`)
+
+				workerDelegate = NewWorkerDelegate(c, decoder, machineImageToAMIMapping, machineSettings, chartApplier, "", w, cluster)
 
 				result, err := workerDelegate.GenerateMachineDeployments(context.TODO())
 				Expect(err).To(HaveOccurred())
@@ -543,10 +708,11 @@ var _ = Describe("Machines", func() {
 
 			It("should fail because the volume size cannot be decoded", func() {
 				expectGetSecretCallToWork(c, awsAccessKeyID, awsSecretAccessKey)
+				expectListMachineDeploymentsCallToWork(c)
 
 				w.Spec.Pools[0].Volume.Size = "not-decodeable"
 
-				workerDelegate = NewWorkerDelegate(c, decoder, machineImageToAMIMapping, chartApplier, "", w, cluster)
+				workerDelegate = NewWorkerDelegate(c, decoder, machineImageToAMIMapping, machineSettings, chartApplier, "", w, cluster)
 
 				result, err := workerDelegate.GenerateMachineDeployments(context.TODO())
 				Expect(err).To(HaveOccurred())
@@ -589,3 +755,17 @@ func addNameAndSecretToMachineClass(class map[string]interface{}, awsAccessKeyID
 	class["secret"].(map[string]interface{})[aws.AccessKeyID] = awsAccessKeyID
 	class["secret"].(map[string]interface{})[aws.SecretAccessKey] = awsSecretAccessKey
 }
+
+func expectListMachineDeploymentsCallToWork(c *mockclient.MockClient, classNames ...string) {
+	c.EXPECT().
+		List(context.TODO(), gomock.Any(), gomock.AssignableToTypeOf(&machinev1alpha1.MachineDeploymentList{})).
+		DoAndReturn(func(_ context.Context, _ *client.ListOptions, list *machinev1alpha1.MachineDeploymentList) error {
+			for _, className := range classNames {
+				machineDeployment := machinev1alpha1.MachineDeployment{}
+				machineDeployment.Spec.Template.Spec.Class.Name = className
+				list.Items = append(list.Items, machineDeployment)
+			}
+			return nil
+		}).
+		AnyTimes()
+}
@@ -20,10 +20,13 @@ import (
 	"testing"
 
 	"github.com/coreos/go-systemd/unit"
+	awsinstall "github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/apis/aws/install"
 	"github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/aws"
+	"github.com/gardener/gardener-extensions/pkg/controller/worker"
 	mockclient "github.com/gardener/gardener-extensions/pkg/mock/controller-runtime/client"
 	"github.com/gardener/gardener-extensions/pkg/webhook/controlplane"
 	"github.com/gardener/gardener-extensions/pkg/webhook/controlplane/test"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/gardener/gardener/pkg/operation/common"
 	"github.com/golang/mock/gomock"
 	. "github.com/onsi/ginkgo"
@@ -50,6 +53,19 @@ var _ = Describe("Ensurer", func() {
 	var (
 		ctrl *gomock.Controller
 
+		// Build scheme
+		scheme = runtime.NewScheme()
+		_      = awsinstall.AddToScheme(scheme)
+
+		cpCSI = &extensionsv1alpha1.ControlPlane{
+			ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: "control-plane"},
+			Spec: extensionsv1alpha1.ControlPlaneSpec{
+				ProviderConfig: &runtime.RawExtension{
+					Raw: []byte(`{"apiVersion":"aws.provider.extensions.gardener.cloud/v1alpha1","kind":"ControlPlaneConfig","csi":{"enabled":true}}`),
+				},
+			},
+		}
+
 		secretKey = client.ObjectKey{Namespace: namespace, Name: common.CloudProviderSecretName}
 		secret    = &corev1.Secret{
 			ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: common.CloudProviderSecretName},
@@ -97,11 +113,12 @@ var _ = Describe("Ensurer", func() {
 
 			// Create mock client
 			client := mockclient.NewMockClient(ctrl)
+			client.EXPECT().List(context.TODO(), gomock.Any(), &extensionsv1alpha1.ControlPlaneList{}).DoAndReturn(clientList())
 			client.EXPECT().Get(context.TODO(), secretKey, &corev1.Secret{}).DoAndReturn(clientGet(secret))
 			client.EXPECT().Get(context.TODO(), cmKey, &corev1.ConfigMap{}).DoAndReturn(clientGet(cm))
 
 			// Create ensurer
-			ensurer := NewEnsurer(logger)
+			ensurer := NewEnsurer(scheme, logger)
 			err := ensurer.(inject.Client).InjectClient(client)
 			Expect(err).To(Not(HaveOccurred()))
 
@@ -147,11 +164,47 @@ var _ = Describe("Ensurer", func() {
 
 			// Create mock client
 			client := mockclient.NewMockClient(ctrl)
+			client.EXPECT().List(context.TODO(), gomock.Any(), &extensionsv1alpha1.ControlPlaneList{}).DoAndReturn(clientList())
+			client.EXPECT().Get(context.TODO(), secretKey, &corev1.Secret{}).DoAndReturn(clientGet(secret))
+			client.EXPECT().Get(context.TODO(), cmKey, &corev1.ConfigMap{}).DoAndReturn(clientGet(cm))
+
+			// Create ensurer
+			ensurer := NewEnsurer(scheme, logger)
+			err := ensurer.(inject.Client).InjectClient(client)
+			Expect(err).To(Not(HaveOccurred()))
+
+			// Call EnsureKubeAPIServerDeployment method and check the result
+			err = ensurer.EnsureKubeAPIServerDeployment(context.TODO(), dep)
+			Expect(err).To(Not(HaveOccurred()))
+			checkKubeAPIServerDeployment(dep, annotations)
+		})
+
+		It("should add CSI feature gates to kube-apiserver deployment if CSI is enabled", func() {
+			var (
+				dep = &appsv1.Deployment{
+					ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: common.KubeAPIServerDeploymentName},
+					Spec: appsv1.DeploymentSpec{
+						Template: corev1.PodTemplateSpec{
+							Spec: corev1.PodSpec{
+								Containers: []corev1.Container{
+									{
+										Name: "kube-apiserver",
+									},
+								},
+							},
+						},
+					},
+				}
+			)
+
+			// Create mock client
+			client := mockclient.NewMockClient(ctrl)
+			client.EXPECT().List(context.TODO(), gomock.Any(), &extensionsv1alpha1.ControlPlaneList{}).DoAndReturn(clientList(*cpCSI))
 			client.EXPECT().Get(context.TODO(), secretKey, &corev1.Secret{}).DoAndReturn(clientGet(secret))
 			client.EXPECT().Get(context.TODO(), cmKey, &corev1.ConfigMap{}).DoAndReturn(clientGet(cm))
 
 			// Create ensurer
-			ensurer := NewEnsurer(logger)
+			ensurer := NewEnsurer(scheme, logger)
 			err := ensurer.(inject.Client).InjectClient(client)
 			Expect(err).To(Not(HaveOccurred()))
 
@@ -159,6 +212,7 @@ var _ = Describe("Ensurer", func() {
 			err = ensurer.EnsureKubeAPIServerDeployment(context.TODO(), dep)
 			Expect(err).To(Not(HaveOccurred()))
 			checkKubeAPIServerDeployment(dep, annotations)
+			checkCSIFeatureGates(dep, "kube-apiserver")
 		})
 	})
 
@@ -183,11 +237,12 @@ var _ = Describe("Ensurer", func() {
 
 			// Create mock client
 			client := mockclient.NewMockClient(ctrl)
+			client.EXPECT().List(context.TODO(), gomock.Any(), &extensionsv1alpha1.ControlPlaneList{}).DoAndReturn(clientList())
 			client.EXPECT().Get(context.TODO(), secretKey, &corev1.Secret{}).DoAndReturn(clientGet(secret))
 			client.EXPECT().Get(context.TODO(), cmKey, &corev1.ConfigMap{}).DoAndReturn(clientGet(cm))
 
 			// Create ensurer
-			ensurer := NewEnsurer(logger)
+			ensurer := NewEnsurer(scheme, logger)
 			err := ensurer.(inject.Client).InjectClient(client)
 			Expect(err).To(Not(HaveOccurred()))
 
@@ -232,11 +287,12 @@ var _ = Describe("Ensurer", func() {
 
 			// Create mock client
 			client := mockclient.NewMockClient(ctrl)
+			client.EXPECT().List(context.TODO(), gomock.Any(), &extensionsv1alpha1.ControlPlaneList{}).DoAndReturn(clientList())
 			client.EXPECT().Get(context.TODO(), secretKey, &corev1.Secret{}).DoAndReturn(clientGet(secret))
 			client.EXPECT().Get(context.TODO(), cmKey, &corev1.ConfigMap{}).DoAndReturn(clientGet(cm))
 
 			// Create ensurer
-			ensurer := NewEnsurer(logger)
+			ensurer := NewEnsurer(scheme, logger)
 			err := ensurer.(inject.Client).InjectClient(client)
 			Expect(err).To(Not(HaveOccurred()))
 
@@ -245,6 +301,42 @@ var _ = Describe("Ensurer", func() {
 			Expect(err).To(Not(HaveOccurred()))
 			checkKubeControllerManagerDeployment(dep, annotations)
 		})
+
+		It("should add CSI feature gates to kube-controller-manager deployment if CSI is enabled", func() {
+			var (
+				dep = &appsv1.Deployment{
+					ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: common.KubeControllerManagerDeploymentName},
+					Spec: appsv1.DeploymentSpec{
+						Template: corev1.PodTemplateSpec{
+							Spec: corev1.PodSpec{
+								Containers: []corev1.Container{
+									{
+										Name: "kube-controller-manager",
+									},
+								},
+							},
+						},
+					},
+				}
+			)
+
+			// Create mock client
+			client := mockclient.NewMockClient(ctrl)
+			client.EXPECT().List(context.TODO(), gomock.Any(), &extensionsv1alpha1.ControlPlaneList{}).DoAndReturn(clientList(*cpCSI))
+			client.EXPECT().Get(context.TODO(), secretKey, &corev1.Secret{}).DoAndReturn(clientGet(secret))
+			client.EXPECT().Get(context.TODO(), cmKey, &corev1.ConfigMap{}).DoAndReturn(clientGet(cm))
+
+			// Create ensurer
+			ensurer := NewEnsurer(scheme, logger)
+			err := ensurer.(inject.Client).InjectClient(client)
+			Expect(err).To(Not(HaveOccurred()))
+
+			// Call EnsureKubeControllerManagerDeployment method and check the result
+			err = ensurer.EnsureKubeControllerManagerDeployment(context.TODO(), dep)
+			Expect(err).To(Not(HaveOccurred()))
+			checkKubeControllerManagerDeployment(dep, annotations)
+			checkCSIFeatureGates(dep, "kube-controller-manager")
+		})
 	})
 
 	Describe("#EnsureKubeletServiceUnitOptions", func() {
@@ -270,10 +362,10 @@ var _ = Describe("Ensurer", func() {
 			)
 
 			// Create ensurer
-			ensurer := NewEnsurer(logger)
+			ensurer := NewEnsurer(scheme, logger)
 
 			// Call EnsureKubeletServiceUnitOptions method and check the result
-			opts, err := ensurer.EnsureKubeletServiceUnitOptions(context.TODO(), oldUnitOptions)
+			opts, err := ensurer.EnsureKubeletServiceUnitOptions(context.TODO(), oldUnitOptions, nil)
 			Expect(err).To(Not(HaveOccurred()))
 			Expect(opts).To(Equal(newUnitOptions))
 		})
@@ -297,11 +389,37 @@ var _ = Describe("Ensurer", func() {
 			)
 
 			// Create ensurer
-			ensurer := NewEnsurer(logger)
+			ensurer := NewEnsurer(scheme, logger)
 
 			// Call EnsureKubeletConfiguration method and check the result
 			kubeletConfig := *oldKubeletConfig
-			err := ensurer.EnsureKubeletConfiguration(context.TODO(), &kubeletConfig)
+			err := ensurer.EnsureKubeletConfiguration(context.TODO(), &kubeletConfig, nil)
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(&kubeletConfig).To(Equal(newKubeletConfig))
+		})
+
+		It("should enable CSI-related feature gates in kubelet configuration if CSI is enabled", func() {
+			var (
+				oldKubeletConfig = &kubeletconfigv1beta1.KubeletConfiguration{
+					FeatureGates: map[string]bool{
+						"Foo": true,
+					},
+				}
+				newKubeletConfig = &kubeletconfigv1beta1.KubeletConfiguration{
+					FeatureGates: map[string]bool{
+						"Foo":               true,
+						"CSINodeInfo":       true,
+						"CSIDriverRegistry": true,
+					},
+				}
+			)
+
+			// Create ensurer
+			ensurer := NewEnsurer(scheme, logger)
+
+			// Call EnsureKubeletConfiguration method and check the result
+			kubeletConfig := *oldKubeletConfig
+			err := ensurer.EnsureKubeletConfiguration(context.TODO(), &kubeletConfig, cpCSI)
 			Expect(err).To(Not(HaveOccurred()))
 			Expect(&kubeletConfig).To(Equal(newKubeletConfig))
 		})
@@ -319,7 +437,7 @@ var _ = Describe("Ensurer", func() {
 			)
 
 			// Create ensurer
-			ensurer := NewEnsurer(logger)
+			ensurer := NewEnsurer(scheme, logger)
 
 			// Call EnsureKubernetesGeneralConfiguration method and check the result
 			err := ensurer.EnsureKubernetesGeneralConfiguration(context.TODO(), data)
@@ -327,6 +445,28 @@ var _ = Describe("Ensurer", func() {
 			Expect(*data).To(Equal(result))
 		})
 	})
+
+	Describe("#EnsureDataVolumeUnits", func() {
+		It("should add units that format and mount the data volumes on their EBS device names", func() {
+			var (
+				units   = []extensionsv1alpha1.Unit{{Name: "kubelet.service"}}
+				volumes = []worker.DataVolume{
+					{Name: "raw", Size: "100Gi"},
+					{Name: "cache", Size: "50Gi", MountPath: util.StringPtr("/var/lib/cache")},
+				}
+			)
+
+			// Create ensurer
+			ensurer := NewEnsurer(scheme, logger)
+
+			// Call EnsureDataVolumeUnits method and check the result
+			err := ensurer.EnsureDataVolumeUnits(context.TODO(), &units, volumes)
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(units).To(HaveLen(3))
+			Expect(*controlplane.UnitWithName(units, "format-data-volume-cache.service").Content).To(ContainSubstring("mkfs.ext4 /dev/xvdg"))
+			Expect(*controlplane.UnitWithName(units, "var-lib-cache.mount").Content).To(ContainSubstring("What=/dev/xvdg"))
+		})
+	})
 })
 
 func checkKubeAPIServerDeployment(dep *appsv1.Deployment, annotations map[string]string) {
@@ -368,6 +508,13 @@ func checkKubeControllerManagerDeployment(dep *appsv1.Deployment, annotations ma
 	Expect(dep.Spec.Template.Annotations).To(Equal(annotations))
 }
 
+func checkCSIFeatureGates(dep *appsv1.Deployment, containerName string) {
+	c := controlplane.ContainerWithName(dep.Spec.Template.Spec.Containers, containerName)
+	Expect(c).To(Not(BeNil()))
+	Expect(c.Command).To(test.ContainElementWithPrefixContaining("--feature-gates=", "CSINodeInfo=true", ","))
+	Expect(c.Command).To(test.ContainElementWithPrefixContaining("--feature-gates=", "CSIDriverRegistry=true", ","))
+}
+
 func clientGet(result runtime.Object) interface{} {
 	return func(ctx context.Context, key client.ObjectKey, obj runtime.Object) error {
 		switch obj.(type) {
@@ -379,3 +526,10 @@ func clientGet(result runtime.Object) interface{} {
 		return nil
 	}
 }
+
+func clientList(cps ...extensionsv1alpha1.ControlPlane) interface{} {
+	return func(ctx context.Context, opts *client.ListOptions, list runtime.Object) error {
+		list.(*extensionsv1alpha1.ControlPlaneList).Items = cps
+		return nil
+	}
+}
@@ -0,0 +1,180 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validator
+
+import (
+	"context"
+	"testing"
+
+	awsinstall "github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/apis/aws/install"
+	"github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/aws"
+	mockclient "github.com/gardener/gardener-extensions/pkg/mock/controller-runtime/client"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/runtime/inject"
+)
+
+func TestValidator(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "AWS Validator Webhook Suite")
+}
+
+var _ = Describe("Validator", func() {
+	var (
+		ctx    = context.TODO()
+		scheme *runtime.Scheme
+	)
+
+	BeforeEach(func() {
+		scheme = runtime.NewScheme()
+		Expect(awsinstall.AddToScheme(scheme)).To(Succeed())
+	})
+
+	Describe("#Validate", func() {
+		It("should accept a valid infrastructure", func() {
+			infra := newInfrastructure(aws.Type, `{"apiVersion":"aws.provider.extensions.gardener.cloud/v1alpha1","kind":"InfrastructureConfig","networks":{"vpc":{"cidr":"10.250.0.0/16"},"zones":[{"name":"eu-west-1a","internal":"10.250.112.0/22","public":"10.250.96.0/22","workers":"10.250.0.0/19"}]}}`)
+
+			Expect(NewValidator(scheme).Validate(ctx, infra)).To(Succeed())
+		})
+
+		It("should reject an infrastructure with an invalid provider config", func() {
+			infra := newInfrastructure(aws.Type, `{"apiVersion":"aws.provider.extensions.gardener.cloud/v1alpha1","kind":"InfrastructureConfig","networks":{"vpc":{"cidr":"10.250.0.0/16"}}}`)
+
+			err := NewValidator(scheme).Validate(ctx, infra)
+			Expect(apierrors.IsInvalid(err)).To(BeTrue())
+		})
+
+		It("should reject an infrastructure with an undecodable provider config", func() {
+			infra := newInfrastructure(aws.Type, `{"apiVersion":"aws.provider.extensions.gardener.cloud/v1alpha1","kind":"Unknown"}`)
+
+			err := NewValidator(scheme).Validate(ctx, infra)
+			Expect(apierrors.IsInvalid(err)).To(BeTrue())
+		})
+
+		It("should ignore infrastructures of other providers", func() {
+			infra := newInfrastructure("other", `{}`)
+
+			Expect(NewValidator(scheme).Validate(ctx, infra)).To(Succeed())
+		})
+
+		It("should reject a worker with invalid pools", func() {
+			worker := &extensionsv1alpha1.Worker{
+				ObjectMeta: metav1.ObjectMeta{Name: "worker", Namespace: "shoot--foo--bar"},
+				Spec: extensionsv1alpha1.WorkerSpec{
+					DefaultSpec: extensionsv1alpha1.DefaultSpec{Type: aws.Type},
+					Pools:       []extensionsv1alpha1.WorkerPool{{Name: "pool"}},
+				},
+			}
+
+			err := NewValidator(scheme).Validate(ctx, worker)
+			Expect(apierrors.IsInvalid(err)).To(BeTrue())
+		})
+
+		It("should reject a worker with a pool requesting spot instances", func() {
+			worker := &extensionsv1alpha1.Worker{
+				ObjectMeta: metav1.ObjectMeta{Name: "worker", Namespace: "shoot--foo--bar"},
+				Spec: extensionsv1alpha1.WorkerSpec{
+					DefaultSpec: extensionsv1alpha1.DefaultSpec{Type: aws.Type},
+					Pools: []extensionsv1alpha1.WorkerPool{{
+						Name:           "pool",
+						MachineType:    "m4.large",
+						MachineImage:   extensionsv1alpha1.MachineImage{Name: "coreos", Version: "2023.5.0"},
+						Minimum:        1,
+						Maximum:        1,
+						MaxSurge:       intstr.FromInt(1),
+						Zones:          []string{"eu-west-1a"},
+						ProviderConfig: &runtime.RawExtension{Raw: []byte(`{"apiVersion":"worker.extensions.gardener.cloud/v1alpha1","kind":"WorkerConfig","spot":{"maxPrice":"0.5"}}`)},
+					}},
+				},
+			}
+
+			err := NewValidator(scheme).Validate(ctx, worker)
+			Expect(apierrors.IsInvalid(err)).To(BeTrue())
+			Expect(err.Error()).To(ContainSubstring("spec.pools[0].providerConfig.spot"))
+		})
+
+		Context("controlplane enabling the CSI driver", func() {
+			var (
+				ctrl *gomock.Controller
+				c    *mockclient.MockClient
+				cp   *extensionsv1alpha1.ControlPlane
+			)
+
+			BeforeEach(func() {
+				ctrl = gomock.NewController(GinkgoT())
+				c = mockclient.NewMockClient(ctrl)
+				cp = &extensionsv1alpha1.ControlPlane{
+					ObjectMeta: metav1.ObjectMeta{Name: "control-plane", Namespace: "shoot--foo--bar"},
+					Spec: extensionsv1alpha1.ControlPlaneSpec{
+						DefaultSpec:    extensionsv1alpha1.DefaultSpec{Type: aws.Type},
+						ProviderConfig: &runtime.RawExtension{Raw: []byte(`{"apiVersion":"aws.provider.extensions.gardener.cloud/v1alpha1","kind":"ControlPlaneConfig","csi":{"enabled":true}}`)},
+					},
+				}
+			})
+
+			AfterEach(func() {
+				ctrl.Finish()
+			})
+
+			expectCluster := func(kubernetesVersion string) {
+				c.EXPECT().Get(ctx, kutil.Key(cp.Namespace), &extensionsv1alpha1.Cluster{}).DoAndReturn(func(_ context.Context, _ client.ObjectKey, cluster *extensionsv1alpha1.Cluster) error {
+					cluster.Spec = extensionsv1alpha1.ClusterSpec{
+						CloudProfile: runtime.RawExtension{Raw: []byte(`{"apiVersion":"garden.sapcloud.io/v1beta1","kind":"CloudProfile"}`)},
+						Seed:         runtime.RawExtension{Raw: []byte(`{"apiVersion":"garden.sapcloud.io/v1beta1","kind":"Seed"}`)},
+						Shoot:        runtime.RawExtension{Raw: []byte(`{"apiVersion":"garden.sapcloud.io/v1beta1","kind":"Shoot","spec":{"kubernetes":{"version":"` + kubernetesVersion + `"}}}`)},
+					}
+					return nil
+				})
+			}
+
+			It("should accept it for a Kubernetes version supporting CSI drivers", func() {
+				expectCluster("1.13.4")
+
+				v := NewValidator(scheme)
+				Expect(inject.ClientInto(c, v)).To(BeTrue())
+				Expect(v.Validate(ctx, cp)).To(Succeed())
+			})
+
+			It("should reject it for a Kubernetes version not supporting CSI drivers", func() {
+				expectCluster("1.12.7")
+
+				v := NewValidator(scheme)
+				Expect(inject.ClientInto(c, v)).To(BeTrue())
+				err := v.Validate(ctx, cp)
+				Expect(apierrors.IsInvalid(err)).To(BeTrue())
+				Expect(err.Error()).To(ContainSubstring("spec.providerConfig.csi.enabled"))
+			})
+		})
+	})
+})
+
+func newInfrastructure(providerType, providerConfig string) *extensionsv1alpha1.Infrastructure {
+	return &extensionsv1alpha1.Infrastructure{
+		ObjectMeta: metav1.ObjectMeta{Name: "infrastructure", Namespace: "shoot--foo--bar"},
+		Spec: extensionsv1alpha1.InfrastructureSpec{
+			DefaultSpec:    extensionsv1alpha1.DefaultSpec{Type: providerType},
+			ProviderConfig: &runtime.RawExtension{Raw: []byte(providerConfig)},
+		},
+	}
+}
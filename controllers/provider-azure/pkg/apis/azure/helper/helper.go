@@ -67,3 +67,8 @@ func FindAvailabilitySetByPurpose(availabilitySets []azure.AvailabilitySet, purp
 	}
 	return nil, fmt.Errorf("cannot find availability set with purpose %q", purpose)
 }
+
+// IsCSIEnabled returns true if the deployment of the CSI driver is enabled in the given ControlPlaneConfig.
+func IsCSIEnabled(config *azure.ControlPlaneConfig) bool {
+	return config != nil && config.CSI != nil && config.CSI.Enabled
+}
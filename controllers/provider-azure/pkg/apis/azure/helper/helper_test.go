@@ -76,6 +76,17 @@ var _ = Describe("Helper", func() {
 		Entry("entry not found", []azure.AvailabilitySet{{ID: "bar", Purpose: purposeWrong}}, purpose, nil, true),
 		Entry("entry exists", []azure.AvailabilitySet{{ID: "bar", Purpose: purpose}}, purpose, &azure.AvailabilitySet{ID: "bar", Purpose: purpose}, false),
 	)
+
+	DescribeTable("#IsCSIEnabled",
+		func(config *azure.ControlPlaneConfig, expected bool) {
+			Expect(IsCSIEnabled(config)).To(Equal(expected))
+		},
+
+		Entry("config is nil", nil, false),
+		Entry("csi is nil", &azure.ControlPlaneConfig{}, false),
+		Entry("csi is disabled", &azure.ControlPlaneConfig{CSI: &azure.CSIConfig{Enabled: false}}, false),
+		Entry("csi is enabled", &azure.ControlPlaneConfig{CSI: &azure.CSIConfig{Enabled: true}}, true),
+	)
 })
 
 func expectResults(result, expected interface{}, err error, expectErr bool) {
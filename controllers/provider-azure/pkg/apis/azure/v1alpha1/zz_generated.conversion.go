@@ -25,6 +25,8 @@ import (
 
 	azure "github.com/gardener/gardener-extensions/controllers/provider-azure/pkg/apis/azure"
 	corev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	conversion "k8s.io/apimachinery/pkg/conversion"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
@@ -46,6 +48,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*CSIConfig)(nil), (*azure.CSIConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_CSIConfig_To_azure_CSIConfig(a.(*CSIConfig), b.(*azure.CSIConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*azure.CSIConfig)(nil), (*CSIConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_azure_CSIConfig_To_v1alpha1_CSIConfig(a.(*azure.CSIConfig), b.(*CSIConfig), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*CloudControllerManagerConfig)(nil), (*azure.CloudControllerManagerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_CloudControllerManagerConfig_To_azure_CloudControllerManagerConfig(a.(*CloudControllerManagerConfig), b.(*azure.CloudControllerManagerConfig), scope)
 	}); err != nil {
@@ -56,6 +68,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*CloudProviderConfig)(nil), (*azure.CloudProviderConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_CloudProviderConfig_To_azure_CloudProviderConfig(a.(*CloudProviderConfig), b.(*azure.CloudProviderConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*azure.CloudProviderConfig)(nil), (*CloudProviderConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_azure_CloudProviderConfig_To_v1alpha1_CloudProviderConfig(a.(*azure.CloudProviderConfig), b.(*CloudProviderConfig), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ControlPlaneConfig)(nil), (*azure.ControlPlaneConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_ControlPlaneConfig_To_azure_ControlPlaneConfig(a.(*ControlPlaneConfig), b.(*azure.ControlPlaneConfig), scope)
 	}); err != nil {
@@ -193,8 +215,34 @@ func Convert_azure_AvailabilitySet_To_v1alpha1_AvailabilitySet(in *azure.Availab
 	return autoConvert_azure_AvailabilitySet_To_v1alpha1_AvailabilitySet(in, out, s)
 }
 
+func autoConvert_v1alpha1_CSIConfig_To_azure_CSIConfig(in *CSIConfig, out *azure.CSIConfig, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	return nil
+}
+
+// Convert_v1alpha1_CSIConfig_To_azure_CSIConfig is an autogenerated conversion function.
+func Convert_v1alpha1_CSIConfig_To_azure_CSIConfig(in *CSIConfig, out *azure.CSIConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_CSIConfig_To_azure_CSIConfig(in, out, s)
+}
+
+func autoConvert_azure_CSIConfig_To_v1alpha1_CSIConfig(in *azure.CSIConfig, out *CSIConfig, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	return nil
+}
+
+// Convert_azure_CSIConfig_To_v1alpha1_CSIConfig is an autogenerated conversion function.
+func Convert_azure_CSIConfig_To_v1alpha1_CSIConfig(in *azure.CSIConfig, out *CSIConfig, s conversion.Scope) error {
+	return autoConvert_azure_CSIConfig_To_v1alpha1_CSIConfig(in, out, s)
+}
+
 func autoConvert_v1alpha1_CloudControllerManagerConfig_To_azure_CloudControllerManagerConfig(in *CloudControllerManagerConfig, out *azure.CloudControllerManagerConfig, s conversion.Scope) error {
 	out.KubernetesConfig = in.KubernetesConfig
+	out.RouteReconciliationPeriod = (*v1.Duration)(unsafe.Pointer(in.RouteReconciliationPeriod))
+	out.NodeMonitorPeriod = (*v1.Duration)(unsafe.Pointer(in.NodeMonitorPeriod))
+	out.NodeStatusUpdateFrequency = (*v1.Duration)(unsafe.Pointer(in.NodeStatusUpdateFrequency))
+	out.ConcurrentServiceSyncs = (*int32)(unsafe.Pointer(in.ConcurrentServiceSyncs))
+	out.Resources = (*corev1.ResourceRequirements)(unsafe.Pointer(in.Resources))
+	out.Verbosity = (*int32)(unsafe.Pointer(in.Verbosity))
 	return nil
 }
 
@@ -205,6 +253,12 @@ func Convert_v1alpha1_CloudControllerManagerConfig_To_azure_CloudControllerManag
 
 func autoConvert_azure_CloudControllerManagerConfig_To_v1alpha1_CloudControllerManagerConfig(in *azure.CloudControllerManagerConfig, out *CloudControllerManagerConfig, s conversion.Scope) error {
 	out.KubernetesConfig = in.KubernetesConfig
+	out.RouteReconciliationPeriod = (*v1.Duration)(unsafe.Pointer(in.RouteReconciliationPeriod))
+	out.NodeMonitorPeriod = (*v1.Duration)(unsafe.Pointer(in.NodeMonitorPeriod))
+	out.NodeStatusUpdateFrequency = (*v1.Duration)(unsafe.Pointer(in.NodeStatusUpdateFrequency))
+	out.ConcurrentServiceSyncs = (*int32)(unsafe.Pointer(in.ConcurrentServiceSyncs))
+	out.Resources = (*corev1.ResourceRequirements)(unsafe.Pointer(in.Resources))
+	out.Verbosity = (*int32)(unsafe.Pointer(in.Verbosity))
 	return nil
 }
 
@@ -213,8 +267,30 @@ func Convert_azure_CloudControllerManagerConfig_To_v1alpha1_CloudControllerManag
 	return autoConvert_azure_CloudControllerManagerConfig_To_v1alpha1_CloudControllerManagerConfig(in, out, s)
 }
 
+func autoConvert_v1alpha1_CloudProviderConfig_To_azure_CloudProviderConfig(in *CloudProviderConfig, out *azure.CloudProviderConfig, s conversion.Scope) error {
+	out.LoadBalancerSKU = (*string)(unsafe.Pointer(in.LoadBalancerSKU))
+	return nil
+}
+
+// Convert_v1alpha1_CloudProviderConfig_To_azure_CloudProviderConfig is an autogenerated conversion function.
+func Convert_v1alpha1_CloudProviderConfig_To_azure_CloudProviderConfig(in *CloudProviderConfig, out *azure.CloudProviderConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_CloudProviderConfig_To_azure_CloudProviderConfig(in, out, s)
+}
+
+func autoConvert_azure_CloudProviderConfig_To_v1alpha1_CloudProviderConfig(in *azure.CloudProviderConfig, out *CloudProviderConfig, s conversion.Scope) error {
+	out.LoadBalancerSKU = (*string)(unsafe.Pointer(in.LoadBalancerSKU))
+	return nil
+}
+
+// Convert_azure_CloudProviderConfig_To_v1alpha1_CloudProviderConfig is an autogenerated conversion function.
+func Convert_azure_CloudProviderConfig_To_v1alpha1_CloudProviderConfig(in *azure.CloudProviderConfig, out *CloudProviderConfig, s conversion.Scope) error {
+	return autoConvert_azure_CloudProviderConfig_To_v1alpha1_CloudProviderConfig(in, out, s)
+}
+
 func autoConvert_v1alpha1_ControlPlaneConfig_To_azure_ControlPlaneConfig(in *ControlPlaneConfig, out *azure.ControlPlaneConfig, s conversion.Scope) error {
 	out.CloudControllerManager = (*azure.CloudControllerManagerConfig)(unsafe.Pointer(in.CloudControllerManager))
+	out.CloudProviderConfig = (*azure.CloudProviderConfig)(unsafe.Pointer(in.CloudProviderConfig))
+	out.CSI = (*azure.CSIConfig)(unsafe.Pointer(in.CSI))
 	return nil
 }
 
@@ -225,6 +301,8 @@ func Convert_v1alpha1_ControlPlaneConfig_To_azure_ControlPlaneConfig(in *Control
 
 func autoConvert_azure_ControlPlaneConfig_To_v1alpha1_ControlPlaneConfig(in *azure.ControlPlaneConfig, out *ControlPlaneConfig, s conversion.Scope) error {
 	out.CloudControllerManager = (*CloudControllerManagerConfig)(unsafe.Pointer(in.CloudControllerManager))
+	out.CloudProviderConfig = (*CloudProviderConfig)(unsafe.Pointer(in.CloudProviderConfig))
+	out.CSI = (*CSIConfig)(unsafe.Pointer(in.CSI))
 	return nil
 }
 
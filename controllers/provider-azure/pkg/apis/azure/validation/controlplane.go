@@ -0,0 +1,57 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	apisazure "github.com/gardener/gardener-extensions/controllers/provider-azure/pkg/apis/azure"
+	utilvalidation "github.com/gardener/gardener-extensions/pkg/util/validation"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+var availableLoadBalancerSKUs = sets.NewString(apisazure.LoadBalancerSKUBasic, apisazure.LoadBalancerSKUStandard)
+
+// ValidateControlPlaneConfig validates the given ControlPlaneConfig.
+func ValidateControlPlaneConfig(cp *apisazure.ControlPlaneConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if ccm := cp.CloudControllerManager; ccm != nil {
+		allErrs = append(allErrs, utilvalidation.ValidateCloudControllerManagerSettings(utilvalidation.CloudControllerManagerSettings{
+			RouteReconciliationPeriod: ccm.RouteReconciliationPeriod,
+			NodeMonitorPeriod:         ccm.NodeMonitorPeriod,
+			NodeStatusUpdateFrequency: ccm.NodeStatusUpdateFrequency,
+			ConcurrentServiceSyncs:    ccm.ConcurrentServiceSyncs,
+			Verbosity:                 ccm.Verbosity,
+			Resources:                 ccm.Resources,
+		}, fldPath.Child("cloudControllerManager"))...)
+	}
+
+	if cp.CloudProviderConfig != nil {
+		allErrs = append(allErrs, validateCloudProviderConfig(cp.CloudProviderConfig, fldPath.Child("cloudProviderConfig"))...)
+	}
+
+	return allErrs
+}
+
+func validateCloudProviderConfig(config *apisazure.CloudProviderConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if config.LoadBalancerSKU != nil && !availableLoadBalancerSKUs.Has(*config.LoadBalancerSKU) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("loadBalancerSKU"), *config.LoadBalancerSKU, availableLoadBalancerSKUs.List()))
+	}
+
+	return allErrs
+}
@@ -0,0 +1,63 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation_test
+
+import (
+	apisazure "github.com/gardener/gardener-extensions/controllers/provider-azure/pkg/apis/azure"
+	. "github.com/gardener/gardener-extensions/controllers/provider-azure/pkg/apis/azure/validation"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+var _ = Describe("ControlPlaneConfig validation", func() {
+	var (
+		fldPath = field.NewPath("spec", "providerConfig")
+
+		loadBalancerSKU        = apisazure.LoadBalancerSKUStandard
+		invalidLoadBalancerSKU = "premium"
+	)
+
+	It("should accept an empty config", func() {
+		Expect(ValidateControlPlaneConfig(&apisazure.ControlPlaneConfig{}, fldPath)).To(BeEmpty())
+	})
+
+	It("should accept a valid cloud provider config", func() {
+		cpConfig := &apisazure.ControlPlaneConfig{
+			CloudProviderConfig: &apisazure.CloudProviderConfig{
+				LoadBalancerSKU: &loadBalancerSKU,
+			},
+		}
+
+		Expect(ValidateControlPlaneConfig(cpConfig, fldPath)).To(BeEmpty())
+	})
+
+	It("should reject an unsupported load balancer SKU", func() {
+		cpConfig := &apisazure.ControlPlaneConfig{
+			CloudProviderConfig: &apisazure.CloudProviderConfig{
+				LoadBalancerSKU: &invalidLoadBalancerSKU,
+			},
+		}
+
+		Expect(ValidateControlPlaneConfig(cpConfig, fldPath)).To(ConsistOf(
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeNotSupported),
+				"Field": Equal("spec.providerConfig.cloudProviderConfig.loadBalancerSKU"),
+			})),
+		))
+	})
+})
@@ -0,0 +1,55 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	apisazure "github.com/gardener/gardener-extensions/controllers/provider-azure/pkg/apis/azure"
+	utilvalidation "github.com/gardener/gardener-extensions/pkg/util/validation"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// ValidateInfrastructureConfig validates the given InfrastructureConfig.
+func ValidateInfrastructureConfig(infra *apisazure.InfrastructureConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if infra.ResourceGroup != nil && len(infra.ResourceGroup.Name) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("resourceGroup", "name"), "field is required"))
+	}
+
+	var (
+		networksPath = fldPath.Child("networks")
+		vnetPath     = networksPath.Child("vnet")
+		workers      = utilvalidation.NewCIDR(string(infra.Networks.Workers), networksPath.Child("workers"))
+	)
+
+	allErrs = append(allErrs, utilvalidation.ValidateCIDRParse(workers)...)
+
+	if infra.Networks.VNet.Name != nil && len(*infra.Networks.VNet.Name) == 0 {
+		allErrs = append(allErrs, field.Invalid(vnetPath.Child("name"), *infra.Networks.VNet.Name, "must not be empty"))
+	}
+
+	if infra.Networks.VNet.Name == nil {
+		if infra.Networks.VNet.CIDR == nil {
+			allErrs = append(allErrs, field.Required(vnetPath.Child("cidr"), "must be specified if no existing vnet name is given"))
+		} else {
+			vnet := utilvalidation.NewCIDR(string(*infra.Networks.VNet.CIDR), vnetPath.Child("cidr"))
+			allErrs = append(allErrs, utilvalidation.ValidateCIDRParse(vnet)...)
+			allErrs = append(allErrs, utilvalidation.ValidateCIDRIsSubset(vnet, workers)...)
+		}
+	}
+
+	return allErrs
+}
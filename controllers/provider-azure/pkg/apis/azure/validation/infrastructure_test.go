@@ -0,0 +1,81 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation_test
+
+import (
+	apisazure "github.com/gardener/gardener-extensions/controllers/provider-azure/pkg/apis/azure"
+	. "github.com/gardener/gardener-extensions/controllers/provider-azure/pkg/apis/azure/validation"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+var _ = Describe("InfrastructureConfig validation", func() {
+	var (
+		fldPath = field.NewPath("spec", "providerConfig")
+		infra   *apisazure.InfrastructureConfig
+	)
+
+	BeforeEach(func() {
+		vnetCIDR := gardencorev1alpha1.CIDR("10.250.0.0/16")
+		infra = &apisazure.InfrastructureConfig{
+			Networks: apisazure.NetworkConfig{
+				VNet:    apisazure.VNet{CIDR: &vnetCIDR},
+				Workers: "10.250.0.0/19",
+			},
+		}
+	})
+
+	It("should accept a valid config", func() {
+		Expect(ValidateInfrastructureConfig(infra, fldPath)).To(BeEmpty())
+	})
+
+	It("should accept an existing vnet without cidr", func() {
+		name := "my-vnet"
+		infra.Networks.VNet = apisazure.VNet{Name: &name}
+
+		Expect(ValidateInfrastructureConfig(infra, fldPath)).To(BeEmpty())
+	})
+
+	It("should require the vnet cidr if no vnet name is given", func() {
+		infra.Networks.VNet.CIDR = nil
+
+		Expect(ValidateInfrastructureConfig(infra, fldPath)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+			"Type":  Equal(field.ErrorTypeRequired),
+			"Field": Equal("spec.providerConfig.networks.vnet.cidr"),
+		}))))
+	})
+
+	It("should require the resource group name", func() {
+		infra.ResourceGroup = &apisazure.ResourceGroup{}
+
+		Expect(ValidateInfrastructureConfig(infra, fldPath)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+			"Type":  Equal(field.ErrorTypeRequired),
+			"Field": Equal("spec.providerConfig.resourceGroup.name"),
+		}))))
+	})
+
+	It("should reject a workers cidr outside of the vnet", func() {
+		infra.Networks.Workers = "10.251.0.0/19"
+
+		Expect(ValidateInfrastructureConfig(infra, fldPath)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+			"Type":  Equal(field.ErrorTypeInvalid),
+			"Field": Equal("spec.providerConfig.networks.workers"),
+		}))))
+	})
+})
@@ -31,6 +31,10 @@ type ControllerConfiguration struct {
 
 	// ETCD is the etcd configuration.
 	ETCD ETCD
+
+	// MachineSettings are the default settings of the machine-controller-manager for the machines of all worker
+	// pools. They can be overwritten in the providerConfig of each worker pool.
+	MachineSettings MachineSettings
 }
 
 // MachineImage is a mapping from logical names and versions to Azure-specific identifiers, i.e. AMIs.
@@ -45,6 +49,11 @@ type MachineImage struct {
 	Offer string
 	// SKU is the stock keeping unit to pull images from.
 	SKU string
+	// DeprecationDate is the date from which on the machine image is considered deprecated. If it is not set,
+	// machine images with an expiration date are considered deprecated.
+	DeprecationDate *metav1.Time
+	// ExpirationDate is the date after which the machine image must no longer be used.
+	ExpirationDate *metav1.Time
 }
 
 // ETCD is an etcd configuration.
@@ -68,3 +77,15 @@ type ETCDBackup struct {
 	// Schedule is the etcd backup schedule.
 	Schedule *string
 }
+
+// MachineSettings contains settings of the machine-controller-manager for machines.
+type MachineSettings struct {
+	// DrainTimeout is the maximum time to drain a machine before it is deleted.
+	DrainTimeout *metav1.Duration
+	// HealthTimeout is the maximum time a machine may be unhealthy before it is replaced.
+	HealthTimeout *metav1.Duration
+	// CreationTimeout is the maximum time to wait until a new machine has joined the cluster.
+	CreationTimeout *metav1.Duration
+	// MaxEvictRetries is the maximum number of times the eviction of a pod is retried while draining a machine.
+	MaxEvictRetries *int32
+}
@@ -25,6 +25,7 @@ import (
 
 	config "github.com/gardener/gardener-extensions/controllers/provider-azure/pkg/apis/config"
 	resource "k8s.io/apimachinery/pkg/api/resource"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	conversion "k8s.io/apimachinery/pkg/conversion"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
@@ -86,6 +87,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*MachineSettings)(nil), (*config.MachineSettings)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_MachineSettings_To_config_MachineSettings(a.(*MachineSettings), b.(*config.MachineSettings), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.MachineSettings)(nil), (*MachineSettings)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_MachineSettings_To_v1alpha1_MachineSettings(a.(*config.MachineSettings), b.(*MachineSettings), scope)
+	}); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -94,6 +105,9 @@ func autoConvert_v1alpha1_ControllerConfiguration_To_config_ControllerConfigurat
 	if err := Convert_v1alpha1_ETCD_To_config_ETCD(&in.ETCD, &out.ETCD, s); err != nil {
 		return err
 	}
+	if err := Convert_v1alpha1_MachineSettings_To_config_MachineSettings(&in.MachineSettings, &out.MachineSettings, s); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -107,6 +121,9 @@ func autoConvert_config_ControllerConfiguration_To_v1alpha1_ControllerConfigurat
 	if err := Convert_config_ETCD_To_v1alpha1_ETCD(&in.ETCD, &out.ETCD, s); err != nil {
 		return err
 	}
+	if err := Convert_config_MachineSettings_To_v1alpha1_MachineSettings(&in.MachineSettings, &out.MachineSettings, s); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -193,6 +210,8 @@ func autoConvert_v1alpha1_MachineImage_To_config_MachineImage(in *MachineImage,
 	out.Publisher = in.Publisher
 	out.Offer = in.Offer
 	out.SKU = in.SKU
+	out.DeprecationDate = (*v1.Time)(unsafe.Pointer(in.DeprecationDate))
+	out.ExpirationDate = (*v1.Time)(unsafe.Pointer(in.ExpirationDate))
 	return nil
 }
 
@@ -207,6 +226,8 @@ func autoConvert_config_MachineImage_To_v1alpha1_MachineImage(in *config.Machine
 	out.Publisher = in.Publisher
 	out.Offer = in.Offer
 	out.SKU = in.SKU
+	out.DeprecationDate = (*v1.Time)(unsafe.Pointer(in.DeprecationDate))
+	out.ExpirationDate = (*v1.Time)(unsafe.Pointer(in.ExpirationDate))
 	return nil
 }
 
@@ -214,3 +235,29 @@ func autoConvert_config_MachineImage_To_v1alpha1_MachineImage(in *config.Machine
 func Convert_config_MachineImage_To_v1alpha1_MachineImage(in *config.MachineImage, out *MachineImage, s conversion.Scope) error {
 	return autoConvert_config_MachineImage_To_v1alpha1_MachineImage(in, out, s)
 }
+
+func autoConvert_v1alpha1_MachineSettings_To_config_MachineSettings(in *MachineSettings, out *config.MachineSettings, s conversion.Scope) error {
+	out.DrainTimeout = (*v1.Duration)(unsafe.Pointer(in.DrainTimeout))
+	out.HealthTimeout = (*v1.Duration)(unsafe.Pointer(in.HealthTimeout))
+	out.CreationTimeout = (*v1.Duration)(unsafe.Pointer(in.CreationTimeout))
+	out.MaxEvictRetries = (*int32)(unsafe.Pointer(in.MaxEvictRetries))
+	return nil
+}
+
+// Convert_v1alpha1_MachineSettings_To_config_MachineSettings is an autogenerated conversion function.
+func Convert_v1alpha1_MachineSettings_To_config_MachineSettings(in *MachineSettings, out *config.MachineSettings, s conversion.Scope) error {
+	return autoConvert_v1alpha1_MachineSettings_To_config_MachineSettings(in, out, s)
+}
+
+func autoConvert_config_MachineSettings_To_v1alpha1_MachineSettings(in *config.MachineSettings, out *MachineSettings, s conversion.Scope) error {
+	out.DrainTimeout = (*v1.Duration)(unsafe.Pointer(in.DrainTimeout))
+	out.HealthTimeout = (*v1.Duration)(unsafe.Pointer(in.HealthTimeout))
+	out.CreationTimeout = (*v1.Duration)(unsafe.Pointer(in.CreationTimeout))
+	out.MaxEvictRetries = (*int32)(unsafe.Pointer(in.MaxEvictRetries))
+	return nil
+}
+
+// Convert_config_MachineSettings_To_v1alpha1_MachineSettings is an autogenerated conversion function.
+func Convert_config_MachineSettings_To_v1alpha1_MachineSettings(in *config.MachineSettings, out *MachineSettings, s conversion.Scope) error {
+	return autoConvert_config_MachineSettings_To_v1alpha1_MachineSettings(in, out, s)
+}
@@ -16,9 +16,11 @@ package controlplane
 
 import (
 	"github.com/gardener/gardener-extensions/controllers/provider-azure/pkg/azure"
+	"github.com/gardener/gardener-extensions/controllers/provider-azure/pkg/internal"
 	"github.com/gardener/gardener-extensions/controllers/provider-azure/pkg/internal/imagevector"
 	"github.com/gardener/gardener-extensions/pkg/controller/controlplane"
 	"github.com/gardener/gardener-extensions/pkg/controller/controlplane/genericactuator"
+	controllererror "github.com/gardener/gardener-extensions/pkg/controller/error"
 	"github.com/gardener/gardener-extensions/pkg/util"
 
 	"sigs.k8s.io/controller-runtime/pkg/controller"
@@ -27,25 +29,35 @@ import (
 )
 
 var (
-	// Options are the default controller.Options for AddToManager.
-	Options = controller.Options{}
+	// DefaultAddOptions are the default AddOptions for AddToManager.
+	DefaultAddOptions = AddOptions{}
 
 	logger = log.Log.WithName("azure-controlplane-controller")
 )
 
+// AddOptions are options to apply when adding the Azure controlplane controller to the manager.
+type AddOptions struct {
+	// Controller are the controller.Options.
+	Controller controller.Options
+	// IgnoreOperationAnnotation specifies whether to ignore the operation annotation or not.
+	IgnoreOperationAnnotation bool
+}
+
 // AddToManagerWithOptions adds a controller with the given Options to the given manager.
 // The opts.Reconciler is being set with a newly instantiated actuator.
-func AddToManagerWithOptions(mgr manager.Manager, opts controller.Options) error {
+func AddToManagerWithOptions(mgr manager.Manager, opts AddOptions) error {
 	return controlplane.Add(mgr, controlplane.AddArgs{
-		Actuator: genericactuator.NewActuator(controlPlaneSecrets, configChart, ccmChart, ccmShootChart,
+		Actuator: controlplane.OperationAnnotationWrapper(genericactuator.NewActuator(controlPlaneSecrets, configChart, controlPlaneChart, controlPlaneShootChart,
 			NewValuesProvider(logger), genericactuator.ShootClientsFactoryFunc(util.NewClientsForShoot),
-			imagevector.ImageVector(), azure.CloudProviderConfigName, logger),
+			imagevector.ImageVector(), azure.CloudProviderConfigName, logger)),
 		Type:              azure.Type,
-		ControllerOptions: opts,
+		ControllerOptions: opts.Controller,
+		Predicates:        controlplane.DefaultPredicates(mgr, opts.IgnoreOperationAnnotation),
+		ErrorClassifier:   controllererror.NewPatternClassifier(internal.ErrorPatterns...),
 	})
 }
 
 // AddToManager adds a controller with the default Options.
 func AddToManager(mgr manager.Manager) error {
-	return AddToManagerWithOptions(mgr, Options)
+	return AddToManagerWithOptions(mgr, DefaultAddOptions)
 }
@@ -18,11 +18,13 @@ import (
 	"context"
 	"encoding/json"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"time"
 
 	apisazure "github.com/gardener/gardener-extensions/controllers/provider-azure/pkg/apis/azure"
 	"github.com/gardener/gardener-extensions/controllers/provider-azure/pkg/azure"
 	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
 	mockclient "github.com/gardener/gardener-extensions/pkg/mock/controller-runtime/client"
+	"github.com/gardener/gardener-extensions/pkg/util"
 
 	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
@@ -35,6 +37,7 @@ import (
 	. "github.com/onsi/gomega"
 
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 
@@ -73,6 +76,15 @@ var _ = Describe("ValuesProvider", func() {
 									"CustomResourceValidation": true,
 								},
 							},
+							RouteReconciliationPeriod: &metav1.Duration{Duration: 30 * time.Second},
+							ConcurrentServiceSyncs:    util.Int32Ptr(5),
+							Resources: &corev1.ResourceRequirements{
+								Limits: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("1Gi")},
+							},
+							Verbosity: util.Int32Ptr(4),
+						},
+						CloudProviderConfig: &apisazure.CloudProviderConfig{
+							LoadBalancerSKU: util.StringPtr(apisazure.LoadBalancerSKUStandard),
 						},
 					}),
 				},
@@ -97,6 +109,22 @@ var _ = Describe("ValuesProvider", func() {
 			},
 		}
 
+		cpCSI = &extensionsv1alpha1.ControlPlane{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "control-plane",
+				Namespace: namespace,
+			},
+			Spec: extensionsv1alpha1.ControlPlaneSpec{
+				ProviderConfig: &runtime.RawExtension{
+					Raw: encode(&apisazure.ControlPlaneConfig{
+						CSI: &apisazure.CSIConfig{
+							Enabled: true,
+						},
+					}),
+				},
+			},
+		}
+
 		cidr    = gardencorev1alpha1.CIDR("10.250.0.0/19")
 		cluster = &extensionscontroller.Cluster{
 			Shoot: &gardenv1beta1.Shoot{
@@ -137,6 +165,8 @@ var _ = Describe("ValuesProvider", func() {
 			azure.CloudProviderConfigName:     "08a7bc7fe8f59b055f173145e211760a83f02cf89635cef26ebb351378635606",
 			"cloud-controller-manager":        "3d791b164a808638da9a8df03924be2a41e34cd664e42231c00fe369e3588272",
 			"cloud-controller-manager-server": "6dff2a2e6f14444b66d8e4a351c049f7e89ee24ba3eaab95dbec40ba6bdebb52",
+			"csi-attacher":                    "2da58ad61c401a2af779a909d22fb42eed93a1524cbfdab974ceedb413fcb914",
+			"csi-provisioner":                 "f75b42d40ab501428c383dfb2336cb1fc892bbee1fc1d739675171e4acc4d911",
 		}
 
 		configChartValues = map[string]interface{}{
@@ -152,6 +182,7 @@ var _ = Describe("ValuesProvider", func() {
 			"routeTableName":      "",
 			"securityGroupName":   "",
 			"kubernetesVersion":   "1.13.4",
+			"loadBalancerSku":     "standard",
 		}
 
 		ccmChartValues = map[string]interface{}{
@@ -168,6 +199,41 @@ var _ = Describe("ValuesProvider", func() {
 			"featureGates": map[string]bool{
 				"CustomResourceValidation": true,
 			},
+			"routeReconciliationPeriod": "30s",
+			"concurrentServiceSyncs":    int32(5),
+			"resources": &corev1.ResourceRequirements{
+				Limits: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("1Gi")},
+			},
+			"verbosity": int32(4),
+		}
+
+		controlPlaneChartValues = map[string]interface{}{
+			"cloud-controller-manager": ccmChartValues,
+			"csi-azure": map[string]interface{}{
+				"enabled": false,
+			},
+		}
+
+		csiControllerChartValues = map[string]interface{}{
+			"enabled":           true,
+			"replicas":          1,
+			"kubernetesVersion": "1.13.4",
+			"podAnnotations": map[string]interface{}{
+				"checksum/secret-csi-attacher":             "2da58ad61c401a2af779a909d22fb42eed93a1524cbfdab974ceedb413fcb914",
+				"checksum/secret-csi-provisioner":          "f75b42d40ab501428c383dfb2336cb1fc892bbee1fc1d739675171e4acc4d911",
+				"checksum/configmap-cloud-provider-config": "08a7bc7fe8f59b055f173145e211760a83f02cf89635cef26ebb351378635606",
+			},
+		}
+
+		cpConfigKey = client.ObjectKey{Namespace: namespace, Name: azure.CloudProviderConfigName}
+		cpConfig    = &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      azure.CloudProviderConfigName,
+				Namespace: namespace,
+			},
+			Data: map[string]string{
+				common.CloudProviderConfigMapKey: "some cloud provider config",
+			},
 		}
 
 		logger = log.Log.WithName("test")
@@ -211,7 +277,45 @@ var _ = Describe("ValuesProvider", func() {
 			// Call GetControlPlaneChartValues method and check the result
 			values, err := vp.GetControlPlaneChartValues(context.TODO(), cp, cluster, checksums)
 			Expect(err).NotTo(HaveOccurred())
-			Expect(values).To(Equal(ccmChartValues))
+			Expect(values).To(Equal(controlPlaneChartValues))
+		})
+
+		It("should return correct control plane chart values if the CSI driver is enabled", func() {
+			// Create valuesProvider
+			vp := NewValuesProvider(logger)
+			err := vp.(inject.Scheme).InjectScheme(scheme)
+			Expect(err).NotTo(HaveOccurred())
+
+			// Call GetControlPlaneChartValues method and check the result
+			values, err := vp.GetControlPlaneChartValues(context.TODO(), cpCSI, cluster, checksums)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values).To(HaveKeyWithValue("csi-azure", csiControllerChartValues))
+		})
+	})
+
+	Describe("#GetControlPlaneShootChartValues", func() {
+		It("should return correct control plane shoot chart values", func() {
+			// Create mock client
+			client := mockclient.NewMockClient(ctrl)
+			client.EXPECT().Get(context.TODO(), cpConfigKey, &corev1.ConfigMap{}).DoAndReturn(clientGet(cpConfig))
+
+			// Create valuesProvider
+			vp := NewValuesProvider(logger)
+			err := vp.(inject.Scheme).InjectScheme(scheme)
+			Expect(err).NotTo(HaveOccurred())
+			err = vp.(inject.Client).InjectClient(client)
+			Expect(err).NotTo(HaveOccurred())
+
+			// Call GetControlPlaneShootChartValues method and check the result
+			values, err := vp.GetControlPlaneShootChartValues(context.TODO(), cpCSI, cluster)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values).To(Equal(map[string]interface{}{
+				"csi-azure": map[string]interface{}{
+					"enabled":             true,
+					"kubernetesVersion":   "1.13.4",
+					"cloudProviderConfig": "some cloud provider config",
+				},
+			}))
 		})
 	})
 })
@@ -226,6 +330,8 @@ func clientGet(result runtime.Object) interface{} {
 		switch obj.(type) {
 		case *corev1.Secret:
 			*obj.(*corev1.Secret) = *result.(*corev1.Secret)
+		case *corev1.ConfigMap:
+			*obj.(*corev1.ConfigMap) = *result.(*corev1.ConfigMap)
 		}
 		return nil
 	}
@@ -36,6 +36,8 @@ import (
 )
 
 type actuator struct {
+	infrastructure.TerraformerMigrator
+
 	logger        logr.Logger
 	client        client.Client
 	restConfig    *rest.Config
@@ -45,14 +47,15 @@ type actuator struct {
 // NewActuator creates a new infrastructure.Actuator.
 func NewActuator() infrastructure.Actuator {
 	return &actuator{
-		logger: log.Log.WithName("infrastructure-actuator"),
+		TerraformerMigrator: infrastructure.NewTerraformerMigrator(infrainternal.TerraformerPurpose),
+		logger:              log.Log.WithName("infrastructure-actuator"),
 	}
 }
 
 // InjectClient implements inject.Client.
 func (a *actuator) InjectClient(client client.Client) error {
 	a.client = client
-	return nil
+	return a.TerraformerMigrator.InjectClient(client)
 }
 
 // InjectConfig implements inject.Config.
@@ -0,0 +1,65 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package infrastructure
+
+import (
+	"context"
+
+	"github.com/gardener/gardener-extensions/controllers/provider-azure/pkg/internal"
+	"github.com/gardener/gardener-extensions/controllers/provider-azure/pkg/internal/infrastructure"
+	"github.com/gardener/gardener-extensions/pkg/controller"
+	extensionsterraformer "github.com/gardener/gardener-extensions/pkg/gardener/terraformer"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+)
+
+// Plan implements infrastructure.Planner.
+func (a *actuator) Plan(ctx context.Context, infra *extensionsv1alpha1.Infrastructure, cluster *controller.Cluster) (*extensionsterraformer.Plan, error) {
+	config, err := internal.InfrastructureConfigFromInfrastructure(infra)
+	if err != nil {
+		return nil, err
+	}
+
+	clientAuth, err := infrastructure.GetClientAuthFromInfrastructure(ctx, a.client, infra)
+	if err != nil {
+		return nil, err
+	}
+
+	terraformFiles, err := infrastructure.RenderTerraformerChart(a.chartRenderer, infra, clientAuth, config, cluster)
+	if err != nil {
+		return nil, err
+	}
+
+	variables, err := internal.TerraformVariablesEnvironmentFromClientAuth(clientAuth)
+	if err != nil {
+		return nil, err
+	}
+
+	planner, err := internal.NewPlanner(a.restConfig, infrastructure.TerraformerPurpose, infra.Namespace, infra.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	runPlan := planner.Plan
+	if infra.DeletionTimestamp != nil {
+		runPlan = planner.PlanDestroy
+	}
+
+	return runPlan(
+		ctx,
+		extensionsterraformer.DefaultFactory().DefaultInitializer(a.client, terraformFiles.Main, terraformFiles.Variables, terraformFiles.TFVars),
+		variables,
+	)
+}
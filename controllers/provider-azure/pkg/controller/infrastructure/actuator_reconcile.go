@@ -23,6 +23,7 @@ import (
 	"github.com/gardener/gardener-extensions/pkg/controller"
 	controllererrors "github.com/gardener/gardener-extensions/pkg/controller/error"
 
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/gardener/gardener/pkg/operation/terraformer"
 )
@@ -49,16 +50,24 @@ func (a *actuator) Reconcile(ctx context.Context, infra *extensionsv1alpha1.Infr
 		return err
 	}
 
-	if err := tf.
-		InitializeWith(terraformer.DefaultInitializer(a.client, terraformFiles.Main, terraformFiles.Variables, terraformFiles.TFVars)).
-		Apply(); err != nil {
+	controller.ReportProgress(ctx, 10, "Initializing the Terraform configuration and state")
+	tf = tf.InitializeWith(terraformer.DefaultInitializer(a.client, terraformFiles.Main, terraformFiles.Variables, terraformFiles.TFVars))
 
+	controller.ReportProgress(ctx, 20, "Applying the Terraform configuration")
+	if err := tf.Apply(); err != nil {
 		a.logger.Error(err, "failed to apply the terraform config", "infrastructure", infra.Name)
+		controller.SetCondition(ctx, controller.ConditionTypeInfrastructureReady, gardencorev1alpha1.ConditionFalse, "TerraformApplyFailed", err.Error())
 		return &controllererrors.RequeueAfterError{
 			Cause:        err,
 			RequeueAfter: 30 * time.Second,
 		}
 	}
 
-	return a.updateProviderStatus(ctx, tf, infra, config)
+	controller.ReportProgress(ctx, 90, "Extracting the infrastructure provider status from the Terraform state")
+	if err := a.updateProviderStatus(ctx, tf, infra, config); err != nil {
+		return err
+	}
+
+	controller.SetCondition(ctx, controller.ConditionTypeInfrastructureReady, gardencorev1alpha1.ConditionTrue, "InfrastructureReconciled", "The infrastructure has been reconciled.")
+	return nil
 }
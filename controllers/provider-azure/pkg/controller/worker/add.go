@@ -17,6 +17,8 @@ package worker
 import (
 	"github.com/gardener/gardener-extensions/controllers/provider-azure/pkg/apis/config"
 	"github.com/gardener/gardener-extensions/controllers/provider-azure/pkg/azure"
+	"github.com/gardener/gardener-extensions/controllers/provider-azure/pkg/internal"
+	controllererror "github.com/gardener/gardener-extensions/pkg/controller/error"
 	"github.com/gardener/gardener-extensions/pkg/controller/worker"
 
 	machinescheme "github.com/gardener/machine-controller-manager/pkg/client/clientset/versioned/scheme"
@@ -27,7 +29,9 @@ import (
 
 var (
 	// DefaultAddOptions are the default AddOptions for AddToManager.
-	DefaultAddOptions = AddOptions{}
+	DefaultAddOptions = AddOptions{
+		RolloutSettings: worker.DefaultRolloutSettings(),
+	}
 )
 
 // AddOptions are options to apply when adding the Azure worker controller to the manager.
@@ -36,6 +40,12 @@ type AddOptions struct {
 	Controller controller.Options
 	// MachineImages is the default list of machine images.
 	MachineImages []config.MachineImage
+	// MachineSettings are the default settings of the machine-controller-manager for the machines of all worker pools.
+	MachineSettings config.MachineSettings
+	// IgnoreOperationAnnotation specifies whether to ignore the operation annotation or not.
+	IgnoreOperationAnnotation bool
+	// RolloutSettings are the settings used when rolling out or deleting the machines of a worker.
+	RolloutSettings worker.RolloutSettings
 }
 
 // AddToManagerWithOptions adds a controller with the given Options to the given manager.
@@ -50,9 +60,10 @@ func AddToManagerWithOptions(mgr manager.Manager, opts AddOptions) error {
 	}
 
 	return worker.Add(mgr, worker.AddArgs{
-		Actuator:          NewActuator(opts.MachineImages),
+		Actuator:          worker.OperationAnnotationWrapper(NewActuator(opts.MachineImages, opts.MachineSettings, opts.RolloutSettings)),
 		ControllerOptions: opts.Controller,
-		Predicates:        worker.DefaultPredicates(mgr.GetClient(), azure.Type),
+		Predicates:        worker.DefaultPredicates(mgr.GetClient(), azure.Type, opts.IgnoreOperationAnnotation),
+		ErrorClassifier:   controllererror.NewPatternClassifier(internal.ErrorPatterns...),
 	})
 }
 
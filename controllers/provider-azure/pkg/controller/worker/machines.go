@@ -24,9 +24,11 @@ import (
 	confighelper "github.com/gardener/gardener-extensions/controllers/provider-azure/pkg/apis/config/helper"
 	"github.com/gardener/gardener-extensions/controllers/provider-azure/pkg/azure"
 	"github.com/gardener/gardener-extensions/controllers/provider-azure/pkg/internal"
+	workerhelper "github.com/gardener/gardener-extensions/pkg/apis/worker/helper"
 	"github.com/gardener/gardener-extensions/pkg/controller/worker"
 	"github.com/gardener/gardener-extensions/pkg/util"
 
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
 	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
 	"k8s.io/apimachinery/pkg/runtime"
 )
@@ -41,6 +43,11 @@ func (w *workerDelegate) MachineClassList() runtime.Object {
 	return &machinev1alpha1.AzureMachineClassList{}
 }
 
+// MachineClassReplacementFields yields the fields of the Azure machine class specs whose changes require new machines.
+func (w *workerDelegate) MachineClassReplacementFields() []string {
+	return []string{"region", "resourceGroup", "vnetName", "subnetName", "availabilitySetID", "machineType", "image", "volumeSize", "sshPublicKey", "secret"}
+}
+
 // DeployMachineClasses generates and creates the AWS specific machine classes.
 func (w *workerDelegate) DeployMachineClasses(ctx context.Context) error {
 	if w.machineClasses == nil {
@@ -86,6 +93,11 @@ func (w *workerDelegate) generateMachineConfig(ctx context.Context) error {
 		return err
 	}
 
+	classNamesInUse, err := worker.MachineClassNamesInUse(ctx, w.client, w.worker.Namespace)
+	if err != nil {
+		return err
+	}
+
 	shootVersionMajorMinor, err := util.VersionMajorMinor(w.cluster.Shoot.Spec.Kubernetes.Version)
 	if err != nil {
 		return err
@@ -106,10 +118,38 @@ func (w *workerDelegate) generateMachineConfig(ctx context.Context) error {
 	}
 
 	for _, pool := range w.worker.Spec.Pools {
+		workerConfig, err := workerhelper.WorkerConfigFromPool(pool)
+		if err != nil {
+			return err
+		}
+
+		spotSettings := worker.SpotSettingsFromConfig(workerConfig)
+		if spotSettings != nil {
+			return fmt.Errorf("pool %q: low-priority instances are not supported on Azure by the used machine-controller-manager", pool.Name)
+		}
+
+		dataVolumes := worker.DataVolumesFromConfig(workerConfig)
+		if len(dataVolumes) > 0 {
+			return fmt.Errorf("pool %q: data volumes are not supported on Azure by the used machine-controller-manager", pool.Name)
+		}
+
+		autoscalerOptions := worker.AutoscalerOptionsFromConfig(workerConfig)
+		nodeTemplate := worker.NodeTemplateForMachineType(w.machineTypes(), pool.MachineType, pool.Labels, pool.Taints)
+
+		poolMachineSettings := worker.MachineSettingsFromConfig(workerConfig)
+		machineSettings := poolMachineSettings.WithDefaults(w.defaultMachineSettings())
+
 		machineImage, err := confighelper.FindImage(w.machineImages, pool.MachineImage.Name, pool.MachineImage.Version)
 		if err != nil {
 			return err
 		}
+		usedMachineImage := &worker.MachineImage{
+			Name:            machineImage.Name,
+			Version:         machineImage.Version,
+			ID:              fmt.Sprintf("%s:%s:%s:%s", machineImage.Publisher, machineImage.Offer, machineImage.SKU, machineImage.Version),
+			DeprecationDate: machineImage.DeprecationDate,
+			ExpirationDate:  machineImage.ExpirationDate,
+		}
 
 		volumeSize, err := worker.DiskSize(pool.Volume.Size)
 		if err != nil {
@@ -142,22 +182,26 @@ func (w *workerDelegate) generateMachineConfig(ctx context.Context) error {
 		}
 
 		var (
-			machineClassSpecHash = worker.MachineClassHash(machineClassSpec, shootVersionMajorMinor)
-			deploymentName       = fmt.Sprintf("%s-%s", w.worker.Namespace, pool.Name)
-			className            = fmt.Sprintf("%s-%s", deploymentName, machineClassSpecHash)
+			deploymentName = fmt.Sprintf("%s-%s", w.worker.Namespace, pool.Name)
+			className      = worker.MachineClassName(deploymentName, machineClassSpec, shootVersionMajorMinor, classNamesInUse, w.MachineClassReplacementFields()...)
 		)
 
 		machineDeployments = append(machineDeployments, worker.MachineDeployment{
-			Name:           deploymentName,
-			ClassName:      className,
-			SecretName:     className,
-			Minimum:        pool.Minimum,
-			Maximum:        pool.Maximum,
-			MaxSurge:       pool.MaxSurge,
-			MaxUnavailable: pool.MaxUnavailable,
-			Labels:         pool.Labels,
-			Annotations:    pool.Annotations,
-			Taints:         pool.Taints,
+			Name:            deploymentName,
+			PoolName:        pool.Name,
+			ClassName:       className,
+			SecretName:      className,
+			Minimum:         pool.Minimum,
+			Maximum:         pool.Maximum,
+			MaxSurge:        pool.MaxSurge,
+			MaxUnavailable:  pool.MaxUnavailable,
+			Labels:          pool.Labels,
+			Annotations:     pool.Annotations,
+			Taints:          pool.Taints,
+			Autoscaler:      autoscalerOptions,
+			NodeTemplate:    nodeTemplate,
+			MachineSettings: machineSettings,
+			MachineImage:    usedMachineImage,
 		})
 
 		machineClassSpec["name"] = className
@@ -174,3 +218,22 @@ func (w *workerDelegate) generateMachineConfig(ctx context.Context) error {
 
 	return nil
 }
+
+// machineTypes returns the machine types of the Azure section of the CloudProfile, if any.
+func (w *workerDelegate) machineTypes() []gardenv1beta1.MachineType {
+	if w.cluster.CloudProfile == nil || w.cluster.CloudProfile.Spec.Azure == nil {
+		return nil
+	}
+	return w.cluster.CloudProfile.Spec.Azure.Constraints.MachineTypes
+}
+
+// defaultMachineSettings returns the machine settings of the controller configuration, which are used for all
+// worker pools that do not overwrite them.
+func (w *workerDelegate) defaultMachineSettings() worker.MachineSettings {
+	return worker.MachineSettings{
+		DrainTimeout:    w.machineSettings.DrainTimeout,
+		HealthTimeout:   w.machineSettings.HealthTimeout,
+		CreationTimeout: w.machineSettings.CreationTimeout,
+		MaxEvictRetries: w.machineSettings.MaxEvictRetries,
+	}
+}
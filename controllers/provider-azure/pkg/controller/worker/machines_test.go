@@ -62,7 +62,7 @@ var _ = Describe("Machines", func() {
 	})
 
 	Context("workerDelegate", func() {
-		workerDelegate := NewWorkerDelegate(nil, nil, nil, nil, "", nil, nil)
+		workerDelegate := NewWorkerDelegate(nil, nil, nil, config.MachineSettings{}, nil, "", nil, nil)
 
 		Describe("#MachineClassKind", func() {
 			It("should return the correct kind of the machine class", func() {
@@ -76,6 +76,12 @@ var _ = Describe("Machines", func() {
 			})
 		})
 
+		Describe("#MachineClassReplacementFields", func() {
+			It("should return the fields whose changes require new machines", func() {
+				Expect(workerDelegate.MachineClassReplacementFields()).To(ConsistOf("region", "resourceGroup", "vnetName", "subnetName", "availabilitySetID", "machineType", "image", "volumeSize", "sshPublicKey", "secret"))
+			})
+		})
+
 		Describe("#GenerateMachineDeployments, #DeployMachineClasses", func() {
 			var (
 				namespace string
@@ -257,11 +263,12 @@ var _ = Describe("Machines", func() {
 				_ = apisazure.AddToScheme(scheme)
 				decoder = serializer.NewCodecFactory(scheme).UniversalDecoder()
 
-				workerDelegate = NewWorkerDelegate(c, decoder, machineImages, chartApplier, "", w, cluster)
+				workerDelegate = NewWorkerDelegate(c, decoder, machineImages, config.MachineSettings{}, chartApplier, "", w, cluster)
 			})
 
 			It("should return the expected machine deployments", func() {
 				expectGetSecretCallToWork(c, azureClientID, azureClientSecret, azureSubscriptionID, azureTenantID)
+				expectListMachineDeploymentsCallToWork(c)
 
 				// Test workerDelegate.DeployMachineClasses()
 				var (
@@ -296,8 +303,8 @@ var _ = Describe("Machines", func() {
 					machineClassNamePool1 = fmt.Sprintf("%s-%s", namespace, namePool1)
 					machineClassNamePool2 = fmt.Sprintf("%s-%s", namespace, namePool2)
 
-					machineClassHashPool1 = worker.MachineClassHash(machineClassPool1, shootVersionMajorMinor)
-					machineClassHashPool2 = worker.MachineClassHash(machineClassPool2, shootVersionMajorMinor)
+					machineClassHashPool1 = worker.MachineClassHash(machineClassPool1, shootVersionMajorMinor, workerDelegate.MachineClassReplacementFields()...)
+					machineClassHashPool2 = worker.MachineClassHash(machineClassPool2, shootVersionMajorMinor, workerDelegate.MachineClassReplacementFields()...)
 
 					machineClassWithHashPool1 = fmt.Sprintf("%s-%s", machineClassNamePool1, machineClassHashPool1)
 					machineClassWithHashPool2 = fmt.Sprintf("%s-%s", machineClassNamePool2, machineClassHashPool2)
@@ -325,24 +332,34 @@ var _ = Describe("Machines", func() {
 				Expect(err).NotTo(HaveOccurred())
 
 				// Test workerDelegate.GenerateMachineDeployments()
+				usedMachineImage := &worker.MachineImage{
+					Name:    machineImageName,
+					Version: machineImageVersion,
+					ID:      fmt.Sprintf("%s:%s:%s:%s", machineImagePublisher, machineImageOffer, machineImageSKU, machineImageVersion),
+				}
+
 				machineDeployments := worker.MachineDeployments{
 					{
 						Name:           machineClassNamePool1,
+						PoolName:       namePool1,
 						ClassName:      machineClassWithHashPool1,
 						SecretName:     machineClassWithHashPool1,
 						Minimum:        minPool1,
 						Maximum:        maxPool1,
 						MaxSurge:       maxSurgePool1,
 						MaxUnavailable: maxUnavailablePool1,
+						MachineImage:   usedMachineImage,
 					},
 					{
 						Name:           machineClassNamePool2,
+						PoolName:       namePool2,
 						ClassName:      machineClassWithHashPool2,
 						SecretName:     machineClassWithHashPool2,
 						Minimum:        minPool2,
 						Maximum:        maxPool2,
 						MaxSurge:       maxSurgePool2,
 						MaxUnavailable: maxUnavailablePool2,
+						MachineImage:   usedMachineImage,
 					},
 				}
 
@@ -363,9 +380,10 @@ var _ = Describe("Machines", func() {
 
 			It("should fail because the version is invalid", func() {
 				expectGetSecretCallToWork(c, azureClientID, azureClientSecret, azureSubscriptionID, azureTenantID)
+				expectListMachineDeploymentsCallToWork(c)
 
 				cluster.Shoot.Spec.Kubernetes.Version = "invalid"
-				workerDelegate = NewWorkerDelegate(c, decoder, machineImages, chartApplier, "", w, cluster)
+				workerDelegate = NewWorkerDelegate(c, decoder, machineImages, config.MachineSettings{}, chartApplier, "", w, cluster)
 
 				result, err := workerDelegate.GenerateMachineDeployments(context.TODO())
 				Expect(err).To(HaveOccurred())
@@ -374,10 +392,11 @@ var _ = Describe("Machines", func() {
 
 			It("should fail because the infrastructure status cannot be decoded", func() {
 				expectGetSecretCallToWork(c, azureClientID, azureClientSecret, azureSubscriptionID, azureTenantID)
+				expectListMachineDeploymentsCallToWork(c)
 
 				w.Spec.InfrastructureProviderStatus = &runtime.RawExtension{}
 
-				workerDelegate = NewWorkerDelegate(c, decoder, machineImages, chartApplier, "", w, cluster)
+				workerDelegate = NewWorkerDelegate(c, decoder, machineImages, config.MachineSettings{}, chartApplier, "", w, cluster)
 
 				result, err := workerDelegate.GenerateMachineDeployments(context.TODO())
 				Expect(err).To(HaveOccurred())
@@ -386,12 +405,13 @@ var _ = Describe("Machines", func() {
 
 			It("should fail because the nodes subnet cannot be found", func() {
 				expectGetSecretCallToWork(c, azureClientID, azureClientSecret, azureSubscriptionID, azureTenantID)
+				expectListMachineDeploymentsCallToWork(c)
 
 				w.Spec.InfrastructureProviderStatus = &runtime.RawExtension{
 					Raw: encode(&apisazure.InfrastructureStatus{}),
 				}
 
-				workerDelegate = NewWorkerDelegate(c, decoder, machineImages, chartApplier, "", w, cluster)
+				workerDelegate = NewWorkerDelegate(c, decoder, machineImages, config.MachineSettings{}, chartApplier, "", w, cluster)
 
 				result, err := workerDelegate.GenerateMachineDeployments(context.TODO())
 				Expect(err).To(HaveOccurred())
@@ -400,6 +420,7 @@ var _ = Describe("Machines", func() {
 
 			It("should fail because the nodes availability set cannot be found", func() {
 				expectGetSecretCallToWork(c, azureClientID, azureClientSecret, azureSubscriptionID, azureTenantID)
+				expectListMachineDeploymentsCallToWork(c)
 
 				w.Spec.InfrastructureProviderStatus = &runtime.RawExtension{
 					Raw: encode(&apisazure.InfrastructureStatus{
@@ -414,7 +435,7 @@ var _ = Describe("Machines", func() {
 					}),
 				}
 
-				workerDelegate = NewWorkerDelegate(c, decoder, machineImages, chartApplier, "", w, cluster)
+				workerDelegate = NewWorkerDelegate(c, decoder, machineImages, config.MachineSettings{}, chartApplier, "", w, cluster)
 
 				result, err := workerDelegate.GenerateMachineDeployments(context.TODO())
 				Expect(err).To(HaveOccurred())
@@ -423,8 +444,9 @@ var _ = Describe("Machines", func() {
 
 			It("should fail because the machine image information cannot be found", func() {
 				expectGetSecretCallToWork(c, azureClientID, azureClientSecret, azureSubscriptionID, azureTenantID)
+				expectListMachineDeploymentsCallToWork(c)
 
-				workerDelegate = NewWorkerDelegate(c, decoder, nil, chartApplier, "", w, cluster)
+				workerDelegate = NewWorkerDelegate(c, decoder, nil, config.MachineSettings{}, chartApplier, "", w, cluster)
 
 				result, err := workerDelegate.GenerateMachineDeployments(context.TODO())
 				Expect(err).To(HaveOccurred())
@@ -433,10 +455,11 @@ var _ = Describe("Machines", func() {
 
 			It("should fail because the volume size cannot be decoded", func() {
 				expectGetSecretCallToWork(c, azureClientID, azureClientSecret, azureSubscriptionID, azureTenantID)
+				expectListMachineDeploymentsCallToWork(c)
 
 				w.Spec.Pools[0].Volume.Size = "not-decodeable"
 
-				workerDelegate = NewWorkerDelegate(c, decoder, machineImages, chartApplier, "", w, cluster)
+				workerDelegate = NewWorkerDelegate(c, decoder, machineImages, config.MachineSettings{}, chartApplier, "", w, cluster)
 
 				result, err := workerDelegate.GenerateMachineDeployments(context.TODO())
 				Expect(err).To(HaveOccurred())
@@ -482,3 +505,17 @@ func addNameAndSecretsToMachineClass(class map[string]interface{}, azureClientID
 	class["secret"].(map[string]interface{})[azure.SubscriptionIDKey] = azureSubscriptionID
 	class["secret"].(map[string]interface{})[azure.TenantIDKey] = azureTenantID
 }
+
+func expectListMachineDeploymentsCallToWork(c *mockclient.MockClient, classNames ...string) {
+	c.EXPECT().
+		List(context.TODO(), gomock.Any(), gomock.AssignableToTypeOf(&machinev1alpha1.MachineDeploymentList{})).
+		DoAndReturn(func(_ context.Context, _ *client.ListOptions, list *machinev1alpha1.MachineDeploymentList) error {
+			for _, className := range classNames {
+				machineDeployment := machinev1alpha1.MachineDeployment{}
+				machineDeployment.Spec.Template.Spec.Class.Name = className
+				list.Items = append(list.Items, machineDeployment)
+			}
+			return nil
+		}).
+		AnyTimes()
+}
@@ -0,0 +1,29 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal
+
+import (
+	controllererror "github.com/gardener/gardener-extensions/pkg/controller/error"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+)
+
+// ErrorPatterns map the error codes of the Azure API to Gardener error codes.
+var ErrorPatterns = []controllererror.Pattern{
+	controllererror.NewPattern(`\b(InvalidAuthenticationToken(Tenant)?|AuthenticationFailed|invalid_client|unauthorized_client|AADSTS\d+|SubscriptionNotFound)\b`, gardencorev1alpha1.ErrorInfraUnauthorized),
+	controllererror.NewPattern(`\b(AuthorizationFailed|LinkedAuthorizationFailed|RequestDisallowedByPolicy)\b`, gardencorev1alpha1.ErrorInfraInsufficientPrivileges),
+	controllererror.NewPattern(`\b(QuotaExceeded|\w+QuotaExceeded|SubscriptionQuotaExceeded|PublicIPCountLimitReached|ResourceQuotaExceeded)\b|exceeding approved .+ quota`, gardencorev1alpha1.ErrorInfraQuotaExceeded),
+	controllererror.NewPattern(`\b(InUse\w+CannotBeDeleted|NicInUse|AnotherOperationInProgress|MissingSubscriptionRegistration|SubscriptionNotRegistered)\b`, gardencorev1alpha1.ErrorInfraDependencies),
+}
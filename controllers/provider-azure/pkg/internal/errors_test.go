@@ -0,0 +1,59 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal
+
+import (
+	"errors"
+
+	controllererror "github.com/gardener/gardener-extensions/pkg/controller/error"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Errors", func() {
+	Describe("#ErrorPatterns", func() {
+		classifier := controllererror.NewPatternClassifier(ErrorPatterns...)
+
+		It("should classify unauthorized errors", func() {
+			err := errors.New("azure.BearerAuthorizer#WithAuthorization: Failed to refresh the Token: StatusCode=401 -- Original Error: adal: Refresh request failed. invalid_client AADSTS7000215: Invalid client secret is provided.")
+
+			Expect(classifier.Classify(err)).To(Equal([]gardencorev1alpha1.ErrorCode{gardencorev1alpha1.ErrorInfraUnauthorized}))
+		})
+
+		It("should classify insufficient privileges", func() {
+			err := errors.New("Code=\"AuthorizationFailed\" Message=\"The client does not have authorization to perform action 'Microsoft.Network/virtualNetworks/write'\"")
+
+			Expect(classifier.Classify(err)).To(Equal([]gardencorev1alpha1.ErrorCode{gardencorev1alpha1.ErrorInfraInsufficientPrivileges}))
+		})
+
+		It("should classify exceeded quotas", func() {
+			err := errors.New("Code=\"PublicIPCountLimitReached\" Message=\"Cannot create more than 10 public IP addresses for this subscription in this region.\"")
+
+			Expect(classifier.Classify(err)).To(Equal([]gardencorev1alpha1.ErrorCode{gardencorev1alpha1.ErrorInfraQuotaExceeded}))
+		})
+
+		It("should classify dependency errors", func() {
+			err := errors.New("Code=\"InUseSubnetCannotBeDeleted\" Message=\"Subnet is in use and cannot be deleted.\"")
+
+			Expect(classifier.Classify(err)).To(Equal([]gardencorev1alpha1.ErrorCode{gardencorev1alpha1.ErrorInfraDependencies}))
+		})
+
+		It("should return nil if no code can be determined", func() {
+			Expect(classifier.Classify(errors.New("foo"))).To(BeNil())
+		})
+	})
+})
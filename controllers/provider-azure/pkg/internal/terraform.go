@@ -16,6 +16,7 @@ package internal
 
 import (
 	"github.com/gardener/gardener-extensions/controllers/provider-azure/pkg/internal/imagevector"
+	extensionsterraformer "github.com/gardener/gardener-extensions/pkg/gardener/terraformer"
 	"github.com/gardener/gardener/pkg/logger"
 	"github.com/gardener/gardener/pkg/operation/terraformer"
 	"k8s.io/client-go/rest"
@@ -57,3 +58,8 @@ func NewTerraformer(
 
 	return tf.SetVariablesEnvironment(variables), nil
 }
+
+// NewPlanner initializes a new Planner for the Terraform configuration of the given purpose, namespace and name.
+func NewPlanner(restConfig *rest.Config, purpose, namespace, name string) (extensionsterraformer.Planner, error) {
+	return extensionsterraformer.NewPlannerForConfig(logger.NewLogger("info"), restConfig, purpose, namespace, name, imagevector.TerraformerImage())
+}
@@ -44,6 +44,24 @@ var _ = Describe("Helper", func() {
 		Entry("entry not found (version does not exist)", makeMachineImages("ubuntu", "2"), "ubuntu", "1", ""),
 		Entry("entry", makeMachineImages("ubuntu", "1"), "ubuntu", "1", image),
 	)
+
+	DescribeTable("#FindMachineImage",
+		func(machineImages []config.MachineImage, imageName, version string, expectedMachineImage *config.MachineImage) {
+			machineImage, err := FindMachineImage(machineImages, imageName, version)
+
+			Expect(machineImage).To(Equal(expectedMachineImage))
+			if expectedMachineImage != nil {
+				Expect(err).NotTo(HaveOccurred())
+			} else {
+				Expect(err).To(HaveOccurred())
+			}
+		},
+
+		Entry("list is nil", nil, "ubuntu", "1", nil),
+		Entry("entry not found (image does not exist)", makeMachineImages("debian", "1"), "ubuntu", "1", nil),
+		Entry("entry not found (version does not exist)", makeMachineImages("ubuntu", "2"), "ubuntu", "1", nil),
+		Entry("entry", makeMachineImages("ubuntu", "1"), "ubuntu", "1", &makeMachineImages("ubuntu", "1")[0]),
+	)
 })
 
 func makeMachineImages(name, version string) []config.MachineImage {
@@ -31,3 +31,8 @@ func FindSubnetByPurpose(subnets []gcp.Subnet, purpose gcp.SubnetPurpose) (*gcp.
 	}
 	return nil, fmt.Errorf("cannot find subnet with purpose %q", purpose)
 }
+
+// IsCSIEnabled returns true if the deployment of the CSI driver is enabled in the given ControlPlaneConfig.
+func IsCSIEnabled(config *gcp.ControlPlaneConfig) bool {
+	return config != nil && config.CSI != nil && config.CSI.Enabled
+}
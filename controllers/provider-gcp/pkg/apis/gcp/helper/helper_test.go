@@ -40,6 +40,17 @@ var _ = Describe("Helper", func() {
 		Entry("entry not found", []gcp.Subnet{{Name: "bar", Purpose: purposeWrong}}, purpose, nil, true),
 		Entry("entry exists", []gcp.Subnet{{Name: "bar", Purpose: purpose}}, purpose, &gcp.Subnet{Name: "bar", Purpose: purpose}, false),
 	)
+
+	DescribeTable("#IsCSIEnabled",
+		func(config *gcp.ControlPlaneConfig, expected bool) {
+			Expect(IsCSIEnabled(config)).To(Equal(expected))
+		},
+
+		Entry("config is nil", nil, false),
+		Entry("csi is nil", &gcp.ControlPlaneConfig{}, false),
+		Entry("csi is disabled", &gcp.ControlPlaneConfig{CSI: &gcp.CSIConfig{Enabled: false}}, false),
+		Entry("csi is enabled", &gcp.ControlPlaneConfig{CSI: &gcp.CSIConfig{Enabled: true}}, true),
+	)
 })
 
 func expectResults(result, expected interface{}, err error, expectErr bool) {
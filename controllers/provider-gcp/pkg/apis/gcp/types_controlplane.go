@@ -16,6 +16,7 @@ package gcp
 
 import (
 	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -31,9 +32,46 @@ type ControlPlaneConfig struct {
 	// CloudControllerManager contains configuration settings for the cloud-controller-manager.
 	// +optional
 	CloudControllerManager *CloudControllerManagerConfig
+
+	// CloudProviderConfig contains settings for the cloud provider configuration used by the Kubernetes components.
+	// +optional
+	CloudProviderConfig *CloudProviderConfig
+
+	// CSI contains configuration settings for the Container Storage Interface (CSI) driver.
+	// +optional
+	CSI *CSIConfig
 }
 
 // CloudControllerManagerConfig contains configuration settings for the cloud-controller-manager.
 type CloudControllerManagerConfig struct {
 	gardenv1beta1.KubernetesConfig
+
+	// RouteReconciliationPeriod is the period for reconciling the routes created for nodes by the cloud provider.
+	RouteReconciliationPeriod *metav1.Duration
+	// NodeMonitorPeriod is the period for syncing the status of the nodes.
+	NodeMonitorPeriod *metav1.Duration
+	// NodeStatusUpdateFrequency is the frequency in which the node status is updated from the cloud provider.
+	NodeStatusUpdateFrequency *metav1.Duration
+	// ConcurrentServiceSyncs is the number of services that are allowed to sync concurrently.
+	ConcurrentServiceSyncs *int32
+	// Resources are the compute resource requirements of the cloud-controller-manager. Requests and limits that are
+	// not given keep their default values.
+	Resources *corev1.ResourceRequirements
+	// Verbosity is the log level verbosity of the cloud-controller-manager.
+	Verbosity *int32
+}
+
+// CSIConfig contains configuration settings for the Container Storage Interface (CSI) driver.
+type CSIConfig struct {
+	// Enabled specifies whether the CSI driver is deployed and the matching Kubernetes feature gates are enabled.
+	// It can only be enabled for shoots with Kubernetes version 1.13 or higher. Disabling it again deletes the CSI driver.
+	// Volumes provisioned by the in-tree volume plugin are not migrated and keep being handled by it.
+	Enabled bool
+}
+
+// CloudProviderConfig contains settings for the cloud provider configuration used by the Kubernetes components.
+type CloudProviderConfig struct {
+	// AlphaFeatures are the GCE alpha API features the cloud provider is allowed to use, e.g. NetworkTiers.
+	// +optional
+	AlphaFeatures []string
 }
@@ -25,6 +25,8 @@ import (
 
 	gcp "github.com/gardener/gardener-extensions/controllers/provider-gcp/pkg/apis/gcp"
 	corev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	conversion "k8s.io/apimachinery/pkg/conversion"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
@@ -36,6 +38,16 @@ func init() {
 // RegisterConversions adds conversion functions to the given scheme.
 // Public to allow building arbitrary schemes.
 func RegisterConversions(s *runtime.Scheme) error {
+	if err := s.AddGeneratedConversionFunc((*CSIConfig)(nil), (*gcp.CSIConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_CSIConfig_To_gcp_CSIConfig(a.(*CSIConfig), b.(*gcp.CSIConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*gcp.CSIConfig)(nil), (*CSIConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_gcp_CSIConfig_To_v1alpha1_CSIConfig(a.(*gcp.CSIConfig), b.(*CSIConfig), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*CloudControllerManagerConfig)(nil), (*gcp.CloudControllerManagerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_CloudControllerManagerConfig_To_gcp_CloudControllerManagerConfig(a.(*CloudControllerManagerConfig), b.(*gcp.CloudControllerManagerConfig), scope)
 	}); err != nil {
@@ -46,6 +58,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*CloudProviderConfig)(nil), (*gcp.CloudProviderConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_CloudProviderConfig_To_gcp_CloudProviderConfig(a.(*CloudProviderConfig), b.(*gcp.CloudProviderConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*gcp.CloudProviderConfig)(nil), (*CloudProviderConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_gcp_CloudProviderConfig_To_v1alpha1_CloudProviderConfig(a.(*gcp.CloudProviderConfig), b.(*CloudProviderConfig), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ControlPlaneConfig)(nil), (*gcp.ControlPlaneConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_ControlPlaneConfig_To_gcp_ControlPlaneConfig(a.(*ControlPlaneConfig), b.(*gcp.ControlPlaneConfig), scope)
 	}); err != nil {
@@ -119,8 +141,34 @@ func RegisterConversions(s *runtime.Scheme) error {
 	return nil
 }
 
+func autoConvert_v1alpha1_CSIConfig_To_gcp_CSIConfig(in *CSIConfig, out *gcp.CSIConfig, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	return nil
+}
+
+// Convert_v1alpha1_CSIConfig_To_gcp_CSIConfig is an autogenerated conversion function.
+func Convert_v1alpha1_CSIConfig_To_gcp_CSIConfig(in *CSIConfig, out *gcp.CSIConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_CSIConfig_To_gcp_CSIConfig(in, out, s)
+}
+
+func autoConvert_gcp_CSIConfig_To_v1alpha1_CSIConfig(in *gcp.CSIConfig, out *CSIConfig, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	return nil
+}
+
+// Convert_gcp_CSIConfig_To_v1alpha1_CSIConfig is an autogenerated conversion function.
+func Convert_gcp_CSIConfig_To_v1alpha1_CSIConfig(in *gcp.CSIConfig, out *CSIConfig, s conversion.Scope) error {
+	return autoConvert_gcp_CSIConfig_To_v1alpha1_CSIConfig(in, out, s)
+}
+
 func autoConvert_v1alpha1_CloudControllerManagerConfig_To_gcp_CloudControllerManagerConfig(in *CloudControllerManagerConfig, out *gcp.CloudControllerManagerConfig, s conversion.Scope) error {
 	out.KubernetesConfig = in.KubernetesConfig
+	out.RouteReconciliationPeriod = (*v1.Duration)(unsafe.Pointer(in.RouteReconciliationPeriod))
+	out.NodeMonitorPeriod = (*v1.Duration)(unsafe.Pointer(in.NodeMonitorPeriod))
+	out.NodeStatusUpdateFrequency = (*v1.Duration)(unsafe.Pointer(in.NodeStatusUpdateFrequency))
+	out.ConcurrentServiceSyncs = (*int32)(unsafe.Pointer(in.ConcurrentServiceSyncs))
+	out.Resources = (*corev1.ResourceRequirements)(unsafe.Pointer(in.Resources))
+	out.Verbosity = (*int32)(unsafe.Pointer(in.Verbosity))
 	return nil
 }
 
@@ -131,6 +179,12 @@ func Convert_v1alpha1_CloudControllerManagerConfig_To_gcp_CloudControllerManager
 
 func autoConvert_gcp_CloudControllerManagerConfig_To_v1alpha1_CloudControllerManagerConfig(in *gcp.CloudControllerManagerConfig, out *CloudControllerManagerConfig, s conversion.Scope) error {
 	out.KubernetesConfig = in.KubernetesConfig
+	out.RouteReconciliationPeriod = (*v1.Duration)(unsafe.Pointer(in.RouteReconciliationPeriod))
+	out.NodeMonitorPeriod = (*v1.Duration)(unsafe.Pointer(in.NodeMonitorPeriod))
+	out.NodeStatusUpdateFrequency = (*v1.Duration)(unsafe.Pointer(in.NodeStatusUpdateFrequency))
+	out.ConcurrentServiceSyncs = (*int32)(unsafe.Pointer(in.ConcurrentServiceSyncs))
+	out.Resources = (*corev1.ResourceRequirements)(unsafe.Pointer(in.Resources))
+	out.Verbosity = (*int32)(unsafe.Pointer(in.Verbosity))
 	return nil
 }
 
@@ -139,9 +193,31 @@ func Convert_gcp_CloudControllerManagerConfig_To_v1alpha1_CloudControllerManager
 	return autoConvert_gcp_CloudControllerManagerConfig_To_v1alpha1_CloudControllerManagerConfig(in, out, s)
 }
 
+func autoConvert_v1alpha1_CloudProviderConfig_To_gcp_CloudProviderConfig(in *CloudProviderConfig, out *gcp.CloudProviderConfig, s conversion.Scope) error {
+	out.AlphaFeatures = *(*[]string)(unsafe.Pointer(&in.AlphaFeatures))
+	return nil
+}
+
+// Convert_v1alpha1_CloudProviderConfig_To_gcp_CloudProviderConfig is an autogenerated conversion function.
+func Convert_v1alpha1_CloudProviderConfig_To_gcp_CloudProviderConfig(in *CloudProviderConfig, out *gcp.CloudProviderConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_CloudProviderConfig_To_gcp_CloudProviderConfig(in, out, s)
+}
+
+func autoConvert_gcp_CloudProviderConfig_To_v1alpha1_CloudProviderConfig(in *gcp.CloudProviderConfig, out *CloudProviderConfig, s conversion.Scope) error {
+	out.AlphaFeatures = *(*[]string)(unsafe.Pointer(&in.AlphaFeatures))
+	return nil
+}
+
+// Convert_gcp_CloudProviderConfig_To_v1alpha1_CloudProviderConfig is an autogenerated conversion function.
+func Convert_gcp_CloudProviderConfig_To_v1alpha1_CloudProviderConfig(in *gcp.CloudProviderConfig, out *CloudProviderConfig, s conversion.Scope) error {
+	return autoConvert_gcp_CloudProviderConfig_To_v1alpha1_CloudProviderConfig(in, out, s)
+}
+
 func autoConvert_v1alpha1_ControlPlaneConfig_To_gcp_ControlPlaneConfig(in *ControlPlaneConfig, out *gcp.ControlPlaneConfig, s conversion.Scope) error {
 	out.Zone = in.Zone
 	out.CloudControllerManager = (*gcp.CloudControllerManagerConfig)(unsafe.Pointer(in.CloudControllerManager))
+	out.CloudProviderConfig = (*gcp.CloudProviderConfig)(unsafe.Pointer(in.CloudProviderConfig))
+	out.CSI = (*gcp.CSIConfig)(unsafe.Pointer(in.CSI))
 	return nil
 }
 
@@ -153,6 +229,8 @@ func Convert_v1alpha1_ControlPlaneConfig_To_gcp_ControlPlaneConfig(in *ControlPl
 func autoConvert_gcp_ControlPlaneConfig_To_v1alpha1_ControlPlaneConfig(in *gcp.ControlPlaneConfig, out *ControlPlaneConfig, s conversion.Scope) error {
 	out.Zone = in.Zone
 	out.CloudControllerManager = (*CloudControllerManagerConfig)(unsafe.Pointer(in.CloudControllerManager))
+	out.CloudProviderConfig = (*CloudProviderConfig)(unsafe.Pointer(in.CloudProviderConfig))
+	out.CSI = (*CSIConfig)(unsafe.Pointer(in.CSI))
 	return nil
 }
 
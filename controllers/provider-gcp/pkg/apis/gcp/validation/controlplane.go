@@ -0,0 +1,65 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	"regexp"
+
+	apisgcp "github.com/gardener/gardener-extensions/controllers/provider-gcp/pkg/apis/gcp"
+	utilvalidation "github.com/gardener/gardener-extensions/pkg/util/validation"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// alphaFeatureRegex matches the names of GCE alpha API features.
+var alphaFeatureRegex = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9]*$`)
+
+// ValidateControlPlaneConfig validates the given ControlPlaneConfig.
+func ValidateControlPlaneConfig(cp *apisgcp.ControlPlaneConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if len(cp.Zone) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("zone"), "field is required"))
+	}
+
+	if ccm := cp.CloudControllerManager; ccm != nil {
+		allErrs = append(allErrs, utilvalidation.ValidateCloudControllerManagerSettings(utilvalidation.CloudControllerManagerSettings{
+			RouteReconciliationPeriod: ccm.RouteReconciliationPeriod,
+			NodeMonitorPeriod:         ccm.NodeMonitorPeriod,
+			NodeStatusUpdateFrequency: ccm.NodeStatusUpdateFrequency,
+			ConcurrentServiceSyncs:    ccm.ConcurrentServiceSyncs,
+			Verbosity:                 ccm.Verbosity,
+			Resources:                 ccm.Resources,
+		}, fldPath.Child("cloudControllerManager"))...)
+	}
+
+	if cp.CloudProviderConfig != nil {
+		allErrs = append(allErrs, validateCloudProviderConfig(cp.CloudProviderConfig, fldPath.Child("cloudProviderConfig"))...)
+	}
+
+	return allErrs
+}
+
+func validateCloudProviderConfig(config *apisgcp.CloudProviderConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	for i, feature := range config.AlphaFeatures {
+		if !alphaFeatureRegex.MatchString(feature) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("alphaFeatures").Index(i), feature, "must be a valid alpha feature name"))
+		}
+	}
+
+	return allErrs
+}
@@ -0,0 +1,43 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	apisgcp "github.com/gardener/gardener-extensions/controllers/provider-gcp/pkg/apis/gcp"
+	utilvalidation "github.com/gardener/gardener-extensions/pkg/util/validation"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// ValidateInfrastructureConfig validates the given InfrastructureConfig.
+func ValidateInfrastructureConfig(infra *apisgcp.InfrastructureConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	networksPath := fldPath.Child("networks")
+
+	if infra.Networks.VPC != nil && len(infra.Networks.VPC.Name) == 0 {
+		allErrs = append(allErrs, field.Required(networksPath.Child("vpc", "name"), "field is required"))
+	}
+
+	cidrs := []utilvalidation.CIDR{utilvalidation.NewCIDR(string(infra.Networks.Worker), networksPath.Child("worker"))}
+	if infra.Networks.Internal != nil {
+		cidrs = append(cidrs, utilvalidation.NewCIDR(string(*infra.Networks.Internal), networksPath.Child("internal")))
+	}
+
+	allErrs = append(allErrs, utilvalidation.ValidateCIDRParse(cidrs...)...)
+	allErrs = append(allErrs, utilvalidation.ValidateCIDROverlap(cidrs...)...)
+
+	return allErrs
+}
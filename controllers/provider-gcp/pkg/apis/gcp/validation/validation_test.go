@@ -0,0 +1,106 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation_test
+
+import (
+	apisgcp "github.com/gardener/gardener-extensions/controllers/provider-gcp/pkg/apis/gcp"
+	. "github.com/gardener/gardener-extensions/controllers/provider-gcp/pkg/apis/gcp/validation"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+var _ = Describe("InfrastructureConfig validation", func() {
+	var (
+		fldPath = field.NewPath("spec", "providerConfig")
+		infra   *apisgcp.InfrastructureConfig
+	)
+
+	BeforeEach(func() {
+		internal := gardencorev1alpha1.CIDR("10.250.112.0/22")
+		infra = &apisgcp.InfrastructureConfig{
+			Networks: apisgcp.NetworkConfig{
+				Internal: &internal,
+				Worker:   "10.250.0.0/19",
+			},
+		}
+	})
+
+	It("should accept a valid config", func() {
+		Expect(ValidateInfrastructureConfig(infra, fldPath)).To(BeEmpty())
+	})
+
+	It("should require the vpc name if a vpc is given", func() {
+		infra.Networks.VPC = &apisgcp.VPC{}
+
+		Expect(ValidateInfrastructureConfig(infra, fldPath)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+			"Type":  Equal(field.ErrorTypeRequired),
+			"Field": Equal("spec.providerConfig.networks.vpc.name"),
+		}))))
+	})
+
+	It("should reject overlapping cidrs", func() {
+		internal := gardencorev1alpha1.CIDR("10.250.16.0/22")
+		infra.Networks.Internal = &internal
+
+		Expect(ValidateInfrastructureConfig(infra, fldPath)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+			"Type":  Equal(field.ErrorTypeInvalid),
+			"Field": Equal("spec.providerConfig.networks.internal"),
+		}))))
+	})
+})
+
+var _ = Describe("ControlPlaneConfig validation", func() {
+	fldPath := field.NewPath("spec", "providerConfig")
+
+	It("should accept a valid config", func() {
+		Expect(ValidateControlPlaneConfig(&apisgcp.ControlPlaneConfig{Zone: "europe-west1-b"}, fldPath)).To(BeEmpty())
+	})
+
+	It("should require the zone", func() {
+		Expect(ValidateControlPlaneConfig(&apisgcp.ControlPlaneConfig{}, fldPath)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+			"Type":  Equal(field.ErrorTypeRequired),
+			"Field": Equal("spec.providerConfig.zone"),
+		}))))
+	})
+
+	It("should accept a valid cloud provider config", func() {
+		cpConfig := &apisgcp.ControlPlaneConfig{
+			Zone: "europe-west1-b",
+			CloudProviderConfig: &apisgcp.CloudProviderConfig{
+				AlphaFeatures: []string{"NetworkTiers", "NetworkEndpointGroup"},
+			},
+		}
+
+		Expect(ValidateControlPlaneConfig(cpConfig, fldPath)).To(BeEmpty())
+	})
+
+	It("should reject invalid alpha features", func() {
+		cpConfig := &apisgcp.ControlPlaneConfig{
+			Zone: "europe-west1-b",
+			CloudProviderConfig: &apisgcp.CloudProviderConfig{
+				AlphaFeatures: []string{"NetworkTiers", "foo\"\nproject-id=\"bar"},
+			},
+		}
+
+		Expect(ValidateControlPlaneConfig(cpConfig, fldPath)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+			"Type":  Equal(field.ErrorTypeInvalid),
+			"Field": Equal("spec.providerConfig.cloudProviderConfig.alphaFeatures[1]"),
+		}))))
+	})
+})
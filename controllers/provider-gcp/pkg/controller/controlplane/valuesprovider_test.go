@@ -17,12 +17,14 @@ package controlplane
 import (
 	"context"
 	"encoding/json"
+	"time"
 
 	apisgcp "github.com/gardener/gardener-extensions/controllers/provider-gcp/pkg/apis/gcp"
 	"github.com/gardener/gardener-extensions/controllers/provider-gcp/pkg/gcp"
 	"github.com/gardener/gardener-extensions/controllers/provider-gcp/pkg/internal"
 	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
 	mockclient "github.com/gardener/gardener-extensions/pkg/mock/controller-runtime/client"
+	"github.com/gardener/gardener-extensions/pkg/util"
 
 	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
@@ -35,6 +37,7 @@ import (
 	. "github.com/onsi/gomega"
 
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 
@@ -74,6 +77,15 @@ var _ = Describe("ValuesProvider", func() {
 									"CustomResourceValidation": true,
 								},
 							},
+							RouteReconciliationPeriod: &metav1.Duration{Duration: 30 * time.Second},
+							ConcurrentServiceSyncs:    util.Int32Ptr(5),
+							Resources: &corev1.ResourceRequirements{
+								Limits: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("1Gi")},
+							},
+							Verbosity: util.Int32Ptr(4),
+						},
+						CloudProviderConfig: &apisgcp.CloudProviderConfig{
+							AlphaFeatures: []string{"NetworkTiers"},
 						},
 					}),
 				},
@@ -95,6 +107,22 @@ var _ = Describe("ValuesProvider", func() {
 			},
 		}
 
+		cpCSI = &extensionsv1alpha1.ControlPlane{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "control-plane",
+				Namespace: namespace,
+			},
+			Spec: extensionsv1alpha1.ControlPlaneSpec{
+				ProviderConfig: &runtime.RawExtension{
+					Raw: encode(&apisgcp.ControlPlaneConfig{
+						CSI: &apisgcp.CSIConfig{
+							Enabled: true,
+						},
+					}),
+				},
+			},
+		}
+
 		cidr    = gardencorev1alpha1.CIDR("10.250.0.0/19")
 		cluster = &extensionscontroller.Cluster{
 			Shoot: &gardenv1beta1.Shoot{
@@ -132,6 +160,8 @@ var _ = Describe("ValuesProvider", func() {
 			internal.CloudProviderConfigName:  "08a7bc7fe8f59b055f173145e211760a83f02cf89635cef26ebb351378635606",
 			"cloud-controller-manager":        "3d791b164a808638da9a8df03924be2a41e34cd664e42231c00fe369e3588272",
 			"cloud-controller-manager-server": "6dff2a2e6f14444b66d8e4a351c049f7e89ee24ba3eaab95dbec40ba6bdebb52",
+			"csi-attacher":                    "2da58ad61c401a2af779a909d22fb42eed93a1524cbfdab974ceedb413fcb914",
+			"csi-provisioner":                 "f75b42d40ab501428c383dfb2336cb1fc892bbee1fc1d739675171e4acc4d911",
 		}
 
 		configChartValues = map[string]interface{}{
@@ -140,6 +170,7 @@ var _ = Describe("ValuesProvider", func() {
 			"subNetworkName": "subnet-acbd1234",
 			"zone":           "europe-west1a",
 			"nodeTags":       namespace,
+			"alphaFeatures":  []string{"NetworkTiers"},
 		}
 
 		ccmChartValues = map[string]interface{}{
@@ -156,6 +187,31 @@ var _ = Describe("ValuesProvider", func() {
 			"featureGates": map[string]bool{
 				"CustomResourceValidation": true,
 			},
+			"routeReconciliationPeriod": "30s",
+			"concurrentServiceSyncs":    int32(5),
+			"resources": &corev1.ResourceRequirements{
+				Limits: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("1Gi")},
+			},
+			"verbosity": int32(4),
+		}
+
+		controlPlaneChartValues = map[string]interface{}{
+			"cloud-controller-manager": ccmChartValues,
+			"csi-gcp": map[string]interface{}{
+				"enabled": false,
+			},
+		}
+
+		csiControllerChartValues = map[string]interface{}{
+			"enabled":           true,
+			"replicas":          1,
+			"kubernetesVersion": "1.13.4",
+			"podAnnotations": map[string]interface{}{
+				"checksum/secret-csi-attacher":             "2da58ad61c401a2af779a909d22fb42eed93a1524cbfdab974ceedb413fcb914",
+				"checksum/secret-csi-provisioner":          "f75b42d40ab501428c383dfb2336cb1fc892bbee1fc1d739675171e4acc4d911",
+				"checksum/secret-cloudprovider":            "8bafb35ff1ac60275d62e1cbd495aceb511fb354f74a20f7d06ecb48b3a68432",
+				"checksum/configmap-cloud-provider-config": "08a7bc7fe8f59b055f173145e211760a83f02cf89635cef26ebb351378635606",
+			},
 		}
 
 		logger = log.Log.WithName("test")
@@ -199,7 +255,38 @@ var _ = Describe("ValuesProvider", func() {
 			// Call GetControlPlaneChartValues method and check the result
 			values, err := vp.GetControlPlaneChartValues(context.TODO(), cp, cluster, checksums)
 			Expect(err).NotTo(HaveOccurred())
-			Expect(values).To(Equal(ccmChartValues))
+			Expect(values).To(Equal(controlPlaneChartValues))
+		})
+
+		It("should return correct control plane chart values if the CSI driver is enabled", func() {
+			// Create valuesProvider
+			vp := NewValuesProvider(logger)
+			err := vp.(inject.Scheme).InjectScheme(scheme)
+			Expect(err).NotTo(HaveOccurred())
+
+			// Call GetControlPlaneChartValues method and check the result
+			values, err := vp.GetControlPlaneChartValues(context.TODO(), cpCSI, cluster, checksums)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values).To(HaveKeyWithValue("csi-gcp", csiControllerChartValues))
+		})
+	})
+
+	Describe("#GetControlPlaneShootChartValues", func() {
+		It("should return correct control plane shoot chart values", func() {
+			// Create valuesProvider
+			vp := NewValuesProvider(logger)
+			err := vp.(inject.Scheme).InjectScheme(scheme)
+			Expect(err).NotTo(HaveOccurred())
+
+			// Call GetControlPlaneShootChartValues method and check the result
+			values, err := vp.GetControlPlaneShootChartValues(context.TODO(), cpCSI, cluster)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values).To(Equal(map[string]interface{}{
+				"csi-gcp": map[string]interface{}{
+					"enabled":           true,
+					"kubernetesVersion": "1.13.4",
+				},
+			}))
 		})
 	})
 })
@@ -15,8 +15,13 @@
 package infrastructure
 
 import (
+	"time"
+
 	"github.com/gardener/gardener-extensions/controllers/provider-gcp/pkg/gcp"
+	gcpclient "github.com/gardener/gardener-extensions/controllers/provider-gcp/pkg/internal/client"
+	controllererror "github.com/gardener/gardener-extensions/pkg/controller/error"
 	"github.com/gardener/gardener-extensions/pkg/controller/infrastructure"
+	"github.com/gardener/gardener-extensions/pkg/gardener/terraformer"
 
 	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
@@ -33,6 +38,12 @@ type AddOptions struct {
 	Controller controller.Options
 	// IgnoreOperationAnnotation specifies whether to ignore the operation annotation or not.
 	IgnoreOperationAnnotation bool
+	// DryRun specifies whether the infrastructure changes are only planned instead of being applied.
+	DryRun bool
+	// ResyncPeriod is the period after which reconciled infrastructures are checked for drift.
+	ResyncPeriod time.Duration
+	// AutoRepairDrift specifies whether drifted infrastructures are reconciled again.
+	AutoRepairDrift bool
 }
 
 // AddToManagerWithOptions adds a controller with the given AddOptions to the given manager.
@@ -42,6 +53,10 @@ func AddToManagerWithOptions(mgr manager.Manager, options AddOptions) error {
 		Actuator:          infrastructure.OperationAnnotationWrapper(NewActuator()),
 		ControllerOptions: options.Controller,
 		Predicates:        infrastructure.DefaultPredicates(mgr.GetClient(), gcp.Type, options.IgnoreOperationAnnotation),
+		ErrorClassifier:   controllererror.NewClassifier(terraformer.NewErrorClassifier(gcpclient.ErrorPatterns...), gcpclient.ErrorClassifier),
+		DryRun:            options.DryRun,
+		ResyncPeriod:      options.ResyncPeriod,
+		AutoRepairDrift:   options.AutoRepairDrift,
 	})
 }
 
@@ -24,9 +24,12 @@ import (
 	gcpapihelper "github.com/gardener/gardener-extensions/controllers/provider-gcp/pkg/apis/gcp/helper"
 	"github.com/gardener/gardener-extensions/controllers/provider-gcp/pkg/gcp"
 	"github.com/gardener/gardener-extensions/controllers/provider-gcp/pkg/internal"
+	workerhelper "github.com/gardener/gardener-extensions/pkg/apis/worker/helper"
 	"github.com/gardener/gardener-extensions/pkg/controller/worker"
 	"github.com/gardener/gardener-extensions/pkg/util"
 
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
 	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
 	"k8s.io/apimachinery/pkg/runtime"
 )
@@ -41,6 +44,11 @@ func (w *workerDelegate) MachineClassList() runtime.Object {
 	return &machinev1alpha1.GCPMachineClassList{}
 }
 
+// MachineClassReplacementFields yields the fields of the GCP machine class specs whose changes require new machines.
+func (w *workerDelegate) MachineClassReplacementFields() []string {
+	return []string{"region", "zone", "machineType", "canIpForward", "disks", "networkInterfaces", "tags", "scheduling", "serviceAccounts", "secret"}
+}
+
 // DeployMachineClasses generates and creates the GCP specific machine classes.
 func (w *workerDelegate) DeployMachineClasses(ctx context.Context) error {
 	if w.machineClasses == nil {
@@ -83,6 +91,11 @@ func (w *workerDelegate) generateMachineConfig(ctx context.Context) error {
 		return err
 	}
 
+	classNamesInUse, err := worker.MachineClassNamesInUse(ctx, w.client, w.worker.Namespace)
+	if err != nil {
+		return err
+	}
+
 	shootVersionMajorMinor, err := util.VersionMajorMinor(w.cluster.Shoot.Spec.Kubernetes.Version)
 	if err != nil {
 		return err
@@ -99,18 +112,50 @@ func (w *workerDelegate) generateMachineConfig(ctx context.Context) error {
 	}
 
 	for _, pool := range w.worker.Spec.Pools {
-		zoneLen := len(pool.Zones)
+		workerConfig, err := workerhelper.WorkerConfigFromPool(pool)
+		if err != nil {
+			return err
+		}
+
+		autoscalerOptions := worker.AutoscalerOptionsFromConfig(workerConfig)
+
+		spotSettings := worker.SpotSettingsFromConfig(workerConfig)
+		if spotSettings != nil && spotSettings.MaxPrice != nil {
+			return fmt.Errorf("pool %q: a maximum price is not supported for preemptible instances on GCP", pool.Name)
+		}
+		labels, taints := spotSettings.SpotLabels(pool.Labels), spotSettings.SpotTaints(pool.Taints)
+		nodeTemplate := worker.NodeTemplateForMachineType(w.machineTypes(), pool.MachineType, labels, taints)
 
-		machineImage, err := confighelper.FindImage(w.machineImages, pool.MachineImage.Name, pool.MachineImage.Version)
+		poolMachineSettings := worker.MachineSettingsFromConfig(workerConfig)
+		machineSettings := poolMachineSettings.WithDefaults(w.defaultMachineSettings())
+
+		zoneDistribution, err := worker.ZoneDistributionFromPool(pool, workerConfig)
+		if err != nil {
+			return err
+		}
+
+		machineImage, err := confighelper.FindMachineImage(w.machineImages, pool.MachineImage.Name, pool.MachineImage.Version)
 		if err != nil {
 			return err
 		}
+		usedMachineImage := &worker.MachineImage{
+			Name:            machineImage.Name,
+			Version:         machineImage.Version,
+			ID:              machineImage.Image,
+			DeprecationDate: machineImage.DeprecationDate,
+			ExpirationDate:  machineImage.ExpirationDate,
+		}
 
 		volumeSize, err := worker.DiskSize(pool.Volume.Size)
 		if err != nil {
 			return err
 		}
 
+		dataDisks, err := w.computeDataDisks(pool, worker.DataVolumesFromConfig(workerConfig))
+		if err != nil {
+			return err
+		}
+
 		for zoneIndex, zone := range pool.Zones {
 			machineClassSpec := map[string]interface{}{
 				"region":             w.worker.Spec.Region,
@@ -118,18 +163,18 @@ func (w *workerDelegate) generateMachineConfig(ctx context.Context) error {
 				"canIpForward":       true,
 				"deletionProtection": false,
 				"description":        fmt.Sprintf("Machine of Shoot %s created by machine-controller-manager.", w.worker.Name),
-				"disks": []map[string]interface{}{
+				"disks": append([]map[string]interface{}{
 					{
 						"autoDelete": true,
 						"boot":       true,
 						"sizeGb":     volumeSize,
 						"type":       pool.Volume.Type,
-						"image":      machineImage,
+						"image":      machineImage.Image,
 						"labels": map[string]interface{}{
 							"name": w.worker.Name,
 						},
 					},
-				},
+				}, dataDisks...),
 				"labels": map[string]interface{}{
 					"name": w.worker.Name,
 				},
@@ -139,11 +184,7 @@ func (w *workerDelegate) generateMachineConfig(ctx context.Context) error {
 						"subnetwork": nodesSubnet.Name,
 					},
 				},
-				"scheduling": map[string]interface{}{
-					"automaticRestart":  true,
-					"onHostMaintenance": "MIGRATE",
-					"preemptible":       false,
-				},
+				"scheduling": scheduling(spotSettings),
 				"secret": map[string]interface{}{
 					"cloudConfig": string(pool.UserData),
 				},
@@ -163,22 +204,27 @@ func (w *workerDelegate) generateMachineConfig(ctx context.Context) error {
 			}
 
 			var (
-				machineClassSpecHash = worker.MachineClassHash(machineClassSpec, shootVersionMajorMinor)
-				deploymentName       = fmt.Sprintf("%s-%s-z%d", w.worker.Namespace, pool.Name, zoneIndex+1)
-				className            = fmt.Sprintf("%s-%s", deploymentName, machineClassSpecHash)
+				deploymentName = fmt.Sprintf("%s-%s-z%d", w.worker.Namespace, pool.Name, zoneIndex+1)
+				className      = worker.MachineClassName(deploymentName, machineClassSpec, shootVersionMajorMinor, classNamesInUse, w.MachineClassReplacementFields()...)
 			)
 
 			machineDeployments = append(machineDeployments, worker.MachineDeployment{
-				Name:           deploymentName,
-				ClassName:      className,
-				SecretName:     className,
-				Minimum:        worker.DistributeOverZones(zoneIndex, pool.Minimum, zoneLen),
-				Maximum:        worker.DistributeOverZones(zoneIndex, pool.Maximum, zoneLen),
-				MaxSurge:       worker.DistributePositiveIntOrPercent(zoneIndex, pool.MaxSurge, zoneLen, pool.Maximum),
-				MaxUnavailable: worker.DistributePositiveIntOrPercent(zoneIndex, pool.MaxUnavailable, zoneLen, pool.Minimum),
-				Labels:         pool.Labels,
-				Annotations:    pool.Annotations,
-				Taints:         pool.Taints,
+				Name:            deploymentName,
+				PoolName:        pool.Name,
+				Zone:            zone,
+				ClassName:       className,
+				SecretName:      className,
+				Minimum:         zoneDistribution.Minimum(zoneIndex),
+				Maximum:         zoneDistribution.Maximum(zoneIndex),
+				MaxSurge:        zoneDistribution.MaxSurge(zoneIndex),
+				MaxUnavailable:  zoneDistribution.MaxUnavailable(zoneIndex),
+				Labels:          labels,
+				Annotations:     pool.Annotations,
+				Taints:          taints,
+				Autoscaler:      autoscalerOptions,
+				NodeTemplate:    nodeTemplate,
+				MachineSettings: machineSettings,
+				MachineImage:    usedMachineImage,
 			})
 
 			machineClassSpec["name"] = className
@@ -193,3 +239,72 @@ func (w *workerDelegate) generateMachineConfig(ctx context.Context) error {
 
 	return nil
 }
+
+// computeDataDisks returns the non-boot disks of the machine class for the data volumes of the given pool. GCP
+// always encrypts disks, hence the encryption of data volumes cannot be disabled.
+func (w *workerDelegate) computeDataDisks(pool extensionsv1alpha1.WorkerPool, dataVolumes []worker.DataVolume) ([]map[string]interface{}, error) {
+	var disks []map[string]interface{}
+	for _, volume := range dataVolumes {
+		if volume.Encrypted != nil && !*volume.Encrypted {
+			return nil, fmt.Errorf("encryption of data volume %q in pool %q cannot be disabled on GCP", volume.Name, pool.Name)
+		}
+
+		size, err := worker.DiskSize(volume.Size)
+		if err != nil {
+			return nil, err
+		}
+
+		volumeType := pool.Volume.Type
+		if volume.Type != nil {
+			volumeType = *volume.Type
+		}
+
+		disks = append(disks, map[string]interface{}{
+			"autoDelete": true,
+			"boot":       false,
+			"sizeGb":     size,
+			"type":       volumeType,
+			"image":      "",
+			"labels": map[string]interface{}{
+				"name": w.worker.Name,
+			},
+		})
+	}
+	return disks, nil
+}
+
+// scheduling returns the scheduling options of the machine class. Preemptible instances can neither be restarted
+// automatically nor be migrated live, they are terminated on host maintenance instead.
+func scheduling(spotSettings *worker.SpotSettings) map[string]interface{} {
+	if spotSettings != nil {
+		return map[string]interface{}{
+			"automaticRestart":  false,
+			"onHostMaintenance": "TERMINATE",
+			"preemptible":       true,
+		}
+	}
+	return map[string]interface{}{
+		"automaticRestart":  true,
+		"onHostMaintenance": "MIGRATE",
+		"preemptible":       false,
+	}
+}
+
+// machineTypes returns the machine types of the GCP section of the CloudProfile, if any.
+func (w *workerDelegate) machineTypes() []gardenv1beta1.MachineType {
+	if w.cluster.CloudProfile == nil || w.cluster.CloudProfile.Spec.GCP == nil {
+		return nil
+	}
+	return w.cluster.CloudProfile.Spec.GCP.Constraints.MachineTypes
+}
+
+// defaultMachineSettings returns the machine settings of the controller configuration, which are used for all
+// worker pools that do not overwrite them.
+func (w *workerDelegate) defaultMachineSettings() worker.MachineSettings {
+	return worker.MachineSettings{
+		DrainTimeout:    w.machineSettings.DrainTimeout,
+		HealthTimeout:   w.machineSettings.HealthTimeout,
+		CreationTimeout: w.machineSettings.CreationTimeout,
+		MaxEvictRetries: w.machineSettings.MaxEvictRetries,
+	}
+}
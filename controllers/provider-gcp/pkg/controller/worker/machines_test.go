@@ -62,7 +62,7 @@ var _ = Describe("Machines", func() {
 	})
 
 	Context("workerDelegate", func() {
-		workerDelegate := NewWorkerDelegate(nil, nil, nil, nil, "", nil, nil)
+		workerDelegate := NewWorkerDelegate(nil, nil, nil, config.MachineSettings{}, nil, "", nil, nil)
 
 		Describe("#MachineClassKind", func() {
 			It("should return the correct kind of the machine class", func() {
@@ -76,6 +76,12 @@ var _ = Describe("Machines", func() {
 			})
 		})
 
+		Describe("#MachineClassReplacementFields", func() {
+			It("should return the fields whose changes require new machines", func() {
+				Expect(workerDelegate.MachineClassReplacementFields()).To(ConsistOf("region", "zone", "machineType", "canIpForward", "disks", "networkInterfaces", "tags", "scheduling", "serviceAccounts", "secret"))
+			})
+		})
+
 		Describe("#GenerateMachineDeployments, #DeployMachineClasses", func() {
 			var (
 				name      string
@@ -241,6 +247,9 @@ var _ = Describe("Machines", func() {
 									zone1,
 									zone2,
 								},
+								ProviderConfig: &runtime.RawExtension{
+									Raw: []byte(`{"apiVersion":"worker.extensions.gardener.cloud/v1alpha1","kind":"WorkerConfig","spot":{},"dataVolumes":[{"name":"cache","size":"50Gi","type":"pd-ssd","mountPath":"/var/lib/cache"}]}`),
+								},
 							},
 						},
 					},
@@ -250,11 +259,12 @@ var _ = Describe("Machines", func() {
 				_ = apisgcp.AddToScheme(scheme)
 				decoder = serializer.NewCodecFactory(scheme).UniversalDecoder()
 
-				workerDelegate = NewWorkerDelegate(c, decoder, machineImages, chartApplier, "", w, cluster)
+				workerDelegate = NewWorkerDelegate(c, decoder, machineImages, config.MachineSettings{}, chartApplier, "", w, cluster)
 			})
 
 			It("should return the expected machine deployments", func() {
 				expectGetSecretCallToWork(c, serviceAccountJSON)
+				expectListMachineDeploymentsCallToWork(c)
 
 				// Test workerDelegate.DeployMachineClasses()
 				var (
@@ -307,20 +317,36 @@ var _ = Describe("Machines", func() {
 						},
 					}
 
+					disksWithDataVolume = append(defaultMachineClass["disks"].([]map[string]interface{}), map[string]interface{}{
+						"autoDelete": true,
+						"boot":       false,
+						"sizeGb":     50,
+						"type":       "pd-ssd",
+						"image":      "",
+						"labels": map[string]interface{}{
+							"name": name,
+						},
+					})
+					preemptibleScheduling = map[string]interface{}{
+						"automaticRestart":  false,
+						"onHostMaintenance": "TERMINATE",
+						"preemptible":       true,
+					}
+
 					machineClassPool1Zone1 = useDefaultMachineClass(defaultMachineClass, "zone", zone1)
 					machineClassPool1Zone2 = useDefaultMachineClass(defaultMachineClass, "zone", zone2)
-					machineClassPool2Zone1 = useDefaultMachineClass(defaultMachineClass, "zone", zone1)
-					machineClassPool2Zone2 = useDefaultMachineClass(defaultMachineClass, "zone", zone2)
+					machineClassPool2Zone1 = useDefaultMachineClass(useDefaultMachineClass(useDefaultMachineClass(defaultMachineClass, "zone", zone1), "scheduling", preemptibleScheduling), "disks", disksWithDataVolume)
+					machineClassPool2Zone2 = useDefaultMachineClass(useDefaultMachineClass(useDefaultMachineClass(defaultMachineClass, "zone", zone2), "scheduling", preemptibleScheduling), "disks", disksWithDataVolume)
 
 					machineClassNamePool1Zone1 = fmt.Sprintf("%s-%s-z1", namespace, namePool1)
 					machineClassNamePool1Zone2 = fmt.Sprintf("%s-%s-z2", namespace, namePool1)
 					machineClassNamePool2Zone1 = fmt.Sprintf("%s-%s-z1", namespace, namePool2)
 					machineClassNamePool2Zone2 = fmt.Sprintf("%s-%s-z2", namespace, namePool2)
 
-					machineClassHashPool1Zone1 = worker.MachineClassHash(machineClassPool1Zone1, shootVersionMajorMinor)
-					machineClassHashPool1Zone2 = worker.MachineClassHash(machineClassPool1Zone2, shootVersionMajorMinor)
-					machineClassHashPool2Zone1 = worker.MachineClassHash(machineClassPool2Zone1, shootVersionMajorMinor)
-					machineClassHashPool2Zone2 = worker.MachineClassHash(machineClassPool2Zone2, shootVersionMajorMinor)
+					machineClassHashPool1Zone1 = worker.MachineClassHash(machineClassPool1Zone1, shootVersionMajorMinor, workerDelegate.MachineClassReplacementFields()...)
+					machineClassHashPool1Zone2 = worker.MachineClassHash(machineClassPool1Zone2, shootVersionMajorMinor, workerDelegate.MachineClassReplacementFields()...)
+					machineClassHashPool2Zone1 = worker.MachineClassHash(machineClassPool2Zone1, shootVersionMajorMinor, workerDelegate.MachineClassReplacementFields()...)
+					machineClassHashPool2Zone2 = worker.MachineClassHash(machineClassPool2Zone2, shootVersionMajorMinor, workerDelegate.MachineClassReplacementFields()...)
 
 					machineClassWithHashPool1Zone1 = fmt.Sprintf("%s-%s", machineClassNamePool1Zone1, machineClassHashPool1Zone1)
 					machineClassWithHashPool1Zone2 = fmt.Sprintf("%s-%s", machineClassNamePool1Zone2, machineClassHashPool1Zone2)
@@ -354,42 +380,69 @@ var _ = Describe("Machines", func() {
 				Expect(err).NotTo(HaveOccurred())
 
 				// Test workerDelegate.GenerateMachineDeployments()
+				var (
+					spotLabels = map[string]string{worker.LabelSpot: "true"}
+					spotTaints = []corev1.Taint{{Key: worker.TaintSpot, Value: "true", Effect: corev1.TaintEffectNoSchedule}}
+				)
+
+				usedMachineImage := &worker.MachineImage{
+					Name:    machineImageName,
+					Version: machineImageVersion,
+					ID:      machineImage,
+				}
+
 				machineDeployments := worker.MachineDeployments{
 					{
 						Name:           machineClassNamePool1Zone1,
+						PoolName:       namePool1,
+						Zone:           zone1,
 						ClassName:      machineClassWithHashPool1Zone1,
 						SecretName:     machineClassWithHashPool1Zone1,
 						Minimum:        worker.DistributeOverZones(0, minPool1, 2),
 						Maximum:        worker.DistributeOverZones(0, maxPool1, 2),
 						MaxSurge:       worker.DistributePositiveIntOrPercent(0, maxSurgePool1, 2, maxPool1),
 						MaxUnavailable: worker.DistributePositiveIntOrPercent(0, maxUnavailablePool1, 2, minPool1),
+						MachineImage:   usedMachineImage,
 					},
 					{
 						Name:           machineClassNamePool1Zone2,
+						PoolName:       namePool1,
+						Zone:           zone2,
 						ClassName:      machineClassWithHashPool1Zone2,
 						SecretName:     machineClassWithHashPool1Zone2,
 						Minimum:        worker.DistributeOverZones(1, minPool1, 2),
 						Maximum:        worker.DistributeOverZones(1, maxPool1, 2),
 						MaxSurge:       worker.DistributePositiveIntOrPercent(1, maxSurgePool1, 2, maxPool1),
 						MaxUnavailable: worker.DistributePositiveIntOrPercent(1, maxUnavailablePool1, 2, minPool1),
+						MachineImage:   usedMachineImage,
 					},
 					{
 						Name:           machineClassNamePool2Zone1,
+						PoolName:       namePool2,
+						Zone:           zone1,
 						ClassName:      machineClassWithHashPool2Zone1,
 						SecretName:     machineClassWithHashPool2Zone1,
 						Minimum:        worker.DistributeOverZones(0, minPool2, 2),
 						Maximum:        worker.DistributeOverZones(0, maxPool2, 2),
 						MaxSurge:       worker.DistributePositiveIntOrPercent(0, maxSurgePool2, 2, maxPool2),
 						MaxUnavailable: worker.DistributePositiveIntOrPercent(0, maxUnavailablePool2, 2, minPool2),
+						Labels:         spotLabels,
+						Taints:         spotTaints,
+						MachineImage:   usedMachineImage,
 					},
 					{
 						Name:           machineClassNamePool2Zone2,
+						PoolName:       namePool2,
+						Zone:           zone2,
 						ClassName:      machineClassWithHashPool2Zone2,
 						SecretName:     machineClassWithHashPool2Zone2,
 						Minimum:        worker.DistributeOverZones(1, minPool2, 2),
 						Maximum:        worker.DistributeOverZones(1, maxPool2, 2),
 						MaxSurge:       worker.DistributePositiveIntOrPercent(1, maxSurgePool2, 2, maxPool2),
 						MaxUnavailable: worker.DistributePositiveIntOrPercent(1, maxUnavailablePool2, 2, minPool2),
+						Labels:         spotLabels,
+						Taints:         spotTaints,
+						MachineImage:   usedMachineImage,
 					},
 				}
 
@@ -410,9 +463,10 @@ var _ = Describe("Machines", func() {
 
 			It("should fail because the version is invalid", func() {
 				expectGetSecretCallToWork(c, serviceAccountJSON)
+				expectListMachineDeploymentsCallToWork(c)
 
 				cluster.Shoot.Spec.Kubernetes.Version = "invalid"
-				workerDelegate = NewWorkerDelegate(c, decoder, machineImages, chartApplier, "", w, cluster)
+				workerDelegate = NewWorkerDelegate(c, decoder, machineImages, config.MachineSettings{}, chartApplier, "", w, cluster)
 
 				result, err := workerDelegate.GenerateMachineDeployments(context.TODO())
 				Expect(err).To(HaveOccurred())
@@ -421,10 +475,11 @@ var _ = Describe("Machines", func() {
 
 			It("should fail because the infrastructure status cannot be decoded", func() {
 				expectGetSecretCallToWork(c, serviceAccountJSON)
+				expectListMachineDeploymentsCallToWork(c)
 
 				w.Spec.InfrastructureProviderStatus = &runtime.RawExtension{}
 
-				workerDelegate = NewWorkerDelegate(c, decoder, machineImages, chartApplier, "", w, cluster)
+				workerDelegate = NewWorkerDelegate(c, decoder, machineImages, config.MachineSettings{}, chartApplier, "", w, cluster)
 
 				result, err := workerDelegate.GenerateMachineDeployments(context.TODO())
 				Expect(err).To(HaveOccurred())
@@ -433,12 +488,13 @@ var _ = Describe("Machines", func() {
 
 			It("should fail because the nodes subnet cannot be found", func() {
 				expectGetSecretCallToWork(c, serviceAccountJSON)
+				expectListMachineDeploymentsCallToWork(c)
 
 				w.Spec.InfrastructureProviderStatus = &runtime.RawExtension{
 					Raw: encode(&apisgcp.InfrastructureStatus{}),
 				}
 
-				workerDelegate = NewWorkerDelegate(c, decoder, machineImages, chartApplier, "", w, cluster)
+				workerDelegate = NewWorkerDelegate(c, decoder, machineImages, config.MachineSettings{}, chartApplier, "", w, cluster)
 
 				result, err := workerDelegate.GenerateMachineDeployments(context.TODO())
 				Expect(err).To(HaveOccurred())
@@ -447,8 +503,35 @@ var _ = Describe("Machines", func() {
 
 			It("should fail because the machine image cannot be found", func() {
 				expectGetSecretCallToWork(c, serviceAccountJSON)
+				expectListMachineDeploymentsCallToWork(c)
 
-				workerDelegate = NewWorkerDelegate(c, decoder, nil, chartApplier, "", w, cluster)
+				workerDelegate = NewWorkerDelegate(c, decoder, nil, config.MachineSettings{}, chartApplier, "", w, cluster)
+
+				result, err := workerDelegate.GenerateMachineDeployments(context.TODO())
+				Expect(err).To(HaveOccurred())
+				Expect(result).To(BeNil())
+			})
+
+			It("should fail because a maximum price is set for a preemptible pool", func() {
+				expectGetSecretCallToWork(c, serviceAccountJSON)
+				expectListMachineDeploymentsCallToWork(c)
+
+				w.Spec.Pools[1].ProviderConfig.Raw = []byte(`{"spot":{"maxPrice":"0.5"}}`)
+
+				workerDelegate = NewWorkerDelegate(c, decoder, machineImages, config.MachineSettings{}, chartApplier, "", w, cluster)
+
+				result, err := workerDelegate.GenerateMachineDeployments(context.TODO())
+				Expect(err).To(HaveOccurred())
+				Expect(result).To(BeNil())
+			})
+
+			It("should fail because the encryption of a data volume is disabled", func() {
+				expectGetSecretCallToWork(c, serviceAccountJSON)
+				expectListMachineDeploymentsCallToWork(c)
+
+				w.Spec.Pools[1].ProviderConfig.Raw = []byte(`{"dataVolumes":[{"name":"cache","size":"50Gi","encrypted":false}]}`)
+
+				workerDelegate = NewWorkerDelegate(c, decoder, machineImages, config.MachineSettings{}, chartApplier, "", w, cluster)
 
 				result, err := workerDelegate.GenerateMachineDeployments(context.TODO())
 				Expect(err).To(HaveOccurred())
@@ -457,10 +540,11 @@ var _ = Describe("Machines", func() {
 
 			It("should fail because the volume size cannot be decoded", func() {
 				expectGetSecretCallToWork(c, serviceAccountJSON)
+				expectListMachineDeploymentsCallToWork(c)
 
 				w.Spec.Pools[0].Volume.Size = "not-decodeable"
 
-				workerDelegate = NewWorkerDelegate(c, decoder, machineImages, chartApplier, "", w, cluster)
+				workerDelegate = NewWorkerDelegate(c, decoder, machineImages, config.MachineSettings{}, chartApplier, "", w, cluster)
 
 				result, err := workerDelegate.GenerateMachineDeployments(context.TODO())
 				Expect(err).To(HaveOccurred())
@@ -501,3 +585,17 @@ func addNameAndSecretToMachineClass(class map[string]interface{}, serviceAccount
 	class["name"] = name
 	class["secret"].(map[string]interface{})[gcp.ServiceAccountJSONMCM] = serviceAccountJSON
 }
+
+func expectListMachineDeploymentsCallToWork(c *mockclient.MockClient, classNames ...string) {
+	c.EXPECT().
+		List(context.TODO(), gomock.Any(), gomock.AssignableToTypeOf(&machinev1alpha1.MachineDeploymentList{})).
+		DoAndReturn(func(_ context.Context, _ *client.ListOptions, list *machinev1alpha1.MachineDeploymentList) error {
+			for _, className := range classNames {
+				machineDeployment := machinev1alpha1.MachineDeployment{}
+				machineDeployment.Spec.Template.Spec.Class.Name = className
+				list.Items = append(list.Items, machineDeployment)
+			}
+			return nil
+		}).
+		AnyTimes()
+}
@@ -14,7 +14,10 @@
 
 package gcp
 
-import "path/filepath"
+import (
+	"fmt"
+	"path/filepath"
+)
 
 const (
 	// Name is the name of the GCP provider.
@@ -28,6 +31,14 @@ const (
 	MachineControllerManagerImageName = "machine-controller-manager"
 	// ETCDBackupRestoreImageName is the name of the etcd backup and restore image.
 	ETCDBackupRestoreImageName = "etcd-backup-restore"
+	// CSIAttacherImageName is the name of the CSI attacher image.
+	CSIAttacherImageName = "csi-attacher"
+	// CSINodeDriverRegistrarImageName is the name of the CSI driver registrar image.
+	CSINodeDriverRegistrarImageName = "csi-node-driver-registrar"
+	// CSIProvisionerImageName is the name of the CSI provisioner image.
+	CSIProvisionerImageName = "csi-provisioner"
+	// CSIPluginImageName is the name of the CSI plugin image.
+	CSIPluginImageName = "csi-plugin-gcp"
 
 	// ServiceAccountJSONField is the field in a secret where the service account JSON is stored at.
 	ServiceAccountJSONField = "serviceaccount.json"
@@ -52,3 +63,9 @@ var (
 	// InternalChartsPath is the path to the internal charts
 	InternalChartsPath = filepath.Join(ChartsPath, "internal")
 )
+
+// DataVolumeDevicePath returns the device path of the data volume with the given index. Disks that are attached
+// without a device name are named `persistent-disk-<n>` by GCP, where the boot disk is `persistent-disk-0`.
+func DataVolumeDevicePath(index int) string {
+	return fmt.Sprintf("/dev/disk/by-id/google-persistent-disk-%d", index+1)
+}
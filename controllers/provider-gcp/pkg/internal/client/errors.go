@@ -0,0 +1,69 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gcp
+
+import (
+	"net/http"
+	"strings"
+
+	controllererror "github.com/gardener/gardener-extensions/pkg/controller/error"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	"google.golang.org/api/googleapi"
+)
+
+// ErrorPatterns map the error reasons and messages of the GCP API to Gardener error codes.
+var ErrorPatterns = []controllererror.Pattern{
+	controllererror.NewPattern(`\b(Error 401|authError|invalid_grant|invalid_client|unauthorized_client)\b`, gardencorev1alpha1.ErrorInfraUnauthorized),
+	controllererror.NewPattern(`\b(forbidden|insufficientPermissions|IAM_PERMISSION_DENIED)\b|Required '[^']+' permission`, gardencorev1alpha1.ErrorInfraInsufficientPrivileges),
+	controllererror.NewPattern(`\b(quotaExceeded|QUOTA_EXCEEDED)\b|Quota '[^']+' exceeded`, gardencorev1alpha1.ErrorInfraQuotaExceeded),
+	controllererror.NewPattern(`\b(resourceInUseByAnotherResource|accessNotConfigured|SERVICE_DISABLED)\b|Access Not Configured|inactive billing state`, gardencorev1alpha1.ErrorInfraDependencies),
+}
+
+// ErrorClassifier determines the Gardener error codes of errors returned by the GCP API. Errors that lost
+// their type, e.g. because they have been formatted into another error, are classified by their message.
+var ErrorClassifier controllererror.Classifier = controllererror.ClassifierFunc(classifyError)
+
+func classifyError(err error) []gardencorev1alpha1.ErrorCode {
+	if err == nil {
+		return nil
+	}
+
+	var (
+		codes   []gardencorev1alpha1.ErrorCode
+		reasons []string
+	)
+	for _, err := range controllererror.Errors(err) {
+		apiErr, ok := err.(*googleapi.Error)
+		if !ok {
+			continue
+		}
+
+		if apiErr.Code == http.StatusUnauthorized {
+			codes = append(codes, gardencorev1alpha1.ErrorInfraUnauthorized)
+		}
+		for _, item := range apiErr.Errors {
+			reasons = append(reasons, item.Reason)
+		}
+	}
+	if len(reasons) > 0 {
+		codes = append(codes, controllererror.MatchPatterns(strings.Join(reasons, " "), ErrorPatterns...)...)
+	}
+	if len(codes) > 0 {
+		return controllererror.UniqueCodes(codes)
+	}
+
+	return controllererror.MatchPatterns(err.Error(), ErrorPatterns...)
+}
@@ -0,0 +1,58 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gcp_test
+
+import (
+	"errors"
+	"net/http"
+
+	. "github.com/gardener/gardener-extensions/controllers/provider-gcp/pkg/internal/client"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	pkgerrors "github.com/pkg/errors"
+	"google.golang.org/api/googleapi"
+)
+
+var _ = Describe("Errors", func() {
+	Describe("#ErrorClassifier", func() {
+		It("should return nil for nil errors", func() {
+			Expect(ErrorClassifier.Classify(nil)).To(BeNil())
+		})
+
+		It("should classify the status code and reasons of wrapped API errors", func() {
+			err := pkgerrors.Wrap(&googleapi.Error{
+				Code:   http.StatusUnauthorized,
+				Errors: []googleapi.ErrorItem{{Reason: "quotaExceeded"}},
+			}, "could not create network")
+
+			Expect(ErrorClassifier.Classify(err)).To(Equal([]gardencorev1alpha1.ErrorCode{
+				gardencorev1alpha1.ErrorInfraUnauthorized,
+				gardencorev1alpha1.ErrorInfraQuotaExceeded,
+			}))
+		})
+
+		It("should fall back to the error message", func() {
+			err := errors.New("Error creating Network: googleapi: Error 403: Required 'compute.networks.create' permission for 'projects/foo', forbidden")
+
+			Expect(ErrorClassifier.Classify(err)).To(Equal([]gardencorev1alpha1.ErrorCode{gardencorev1alpha1.ErrorInfraInsufficientPrivileges}))
+		})
+
+		It("should return nil if no code can be determined", func() {
+			Expect(ErrorClassifier.Classify(&googleapi.Error{Code: http.StatusInternalServerError})).To(BeNil())
+		})
+	})
+})
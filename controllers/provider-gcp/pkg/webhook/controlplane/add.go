@@ -37,7 +37,7 @@ func AddToManager(mgr manager.Manager) (webhook.Webhook, error) {
 		Kind:     extensionswebhook.ShootKind,
 		Provider: gcp.Type,
 		Types:    []runtime.Object{&appsv1.Deployment{}, &extensionsv1alpha1.OperatingSystemConfig{}},
-		Mutator: genericmutator.NewMutator(NewEnsurer(logger), controlplane.NewUnitSerializer(),
+		Mutator: genericmutator.NewMutator(NewEnsurer(mgr.GetScheme(), logger), controlplane.NewUnitSerializer(),
 			controlplane.NewKubeletConfigCodec(controlplane.NewFileContentInlineCodec()), logger),
 	})
 }
@@ -19,31 +19,41 @@ import (
 	"context"
 	"fmt"
 
+	apisgcp "github.com/gardener/gardener-extensions/controllers/provider-gcp/pkg/apis/gcp"
+	"github.com/gardener/gardener-extensions/controllers/provider-gcp/pkg/apis/gcp/helper"
 	"github.com/gardener/gardener-extensions/controllers/provider-gcp/pkg/gcp"
 	"github.com/gardener/gardener-extensions/controllers/provider-gcp/pkg/internal"
+	"github.com/gardener/gardener-extensions/pkg/controller/worker"
+	"github.com/gardener/gardener-extensions/pkg/util"
 	"github.com/gardener/gardener-extensions/pkg/webhook/controlplane"
 	"github.com/gardener/gardener-extensions/pkg/webhook/controlplane/genericmutator"
 
 	"github.com/coreos/go-systemd/unit"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/gardener/gardener/pkg/operation/common"
 	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
 	kubeletconfigv1beta1 "k8s.io/kubelet/config/v1beta1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 // NewEnsurer creates a new controlplane ensurer.
-func NewEnsurer(logger logr.Logger) genericmutator.Ensurer {
+func NewEnsurer(scheme *runtime.Scheme, logger logr.Logger) genericmutator.Ensurer {
 	return &ensurer{
-		logger: logger.WithName("gcp-controlplane-ensurer"),
+		decoder: serializer.NewCodecFactory(scheme).UniversalDecoder(),
+		logger:  logger.WithName("gcp-controlplane-ensurer"),
 	}
 }
 
 type ensurer struct {
 	genericmutator.NoopEnsurer
-	client client.Client
-	logger logr.Logger
+	decoder runtime.Decoder
+	client  client.Client
+	logger  logr.Logger
 }
 
 // InjectClient injects the given client into the ensurer.
@@ -54,10 +64,15 @@ func (e *ensurer) InjectClient(client client.Client) error {
 
 // EnsureKubeAPIServerDeployment ensures that the kube-apiserver deployment conforms to the provider requirements.
 func (e *ensurer) EnsureKubeAPIServerDeployment(ctx context.Context, dep *appsv1.Deployment) error {
+	csiEnabled, err := e.isCSIEnabledInNamespace(ctx, dep.Namespace)
+	if err != nil {
+		return err
+	}
+
 	template := &dep.Spec.Template
 	ps := &template.Spec
 	if c := controlplane.ContainerWithName(ps.Containers, "kube-apiserver"); c != nil {
-		ensureKubeAPIServerCommandLineArgs(c)
+		ensureKubeAPIServerCommandLineArgs(c, csiEnabled)
 		ensureEnvVars(c)
 		ensureVolumeMounts(c)
 	}
@@ -67,10 +82,15 @@ func (e *ensurer) EnsureKubeAPIServerDeployment(ctx context.Context, dep *appsv1
 
 // EnsureKubeControllerManagerDeployment ensures that the kube-controller-manager deployment conforms to the provider requirements.
 func (e *ensurer) EnsureKubeControllerManagerDeployment(ctx context.Context, dep *appsv1.Deployment) error {
+	csiEnabled, err := e.isCSIEnabledInNamespace(ctx, dep.Namespace)
+	if err != nil {
+		return err
+	}
+
 	template := &dep.Spec.Template
 	ps := &template.Spec
 	if c := controlplane.ContainerWithName(ps.Containers, "kube-controller-manager"); c != nil {
-		ensureKubeControllerManagerCommandLineArgs(c)
+		ensureKubeControllerManagerCommandLineArgs(c, csiEnabled)
 		ensureEnvVars(c)
 		ensureVolumeMounts(c)
 	}
@@ -78,7 +98,7 @@ func (e *ensurer) EnsureKubeControllerManagerDeployment(ctx context.Context, dep
 	return e.ensureChecksumAnnotations(ctx, &dep.Spec.Template, dep.Namespace)
 }
 
-func ensureKubeAPIServerCommandLineArgs(c *corev1.Container) {
+func ensureKubeAPIServerCommandLineArgs(c *corev1.Container, csiEnabled bool) {
 	c.Command = controlplane.EnsureStringWithPrefix(c.Command, "--cloud-provider=", "gce")
 	c.Command = controlplane.EnsureStringWithPrefix(c.Command, "--cloud-config=",
 		"/etc/kubernetes/cloudprovider/cloudprovider.conf")
@@ -86,13 +106,25 @@ func ensureKubeAPIServerCommandLineArgs(c *corev1.Container) {
 		"PersistentVolumeLabel", ",")
 	c.Command = controlplane.EnsureNoStringWithPrefixContains(c.Command, "--disable-admission-plugins=",
 		"PersistentVolumeLabel", ",")
+	if csiEnabled {
+		c.Command = ensureCSIFeatureGates(c.Command)
+	}
 }
 
-func ensureKubeControllerManagerCommandLineArgs(c *corev1.Container) {
+func ensureKubeControllerManagerCommandLineArgs(c *corev1.Container, csiEnabled bool) {
 	c.Command = controlplane.EnsureStringWithPrefix(c.Command, "--cloud-provider=", "external")
 	c.Command = controlplane.EnsureStringWithPrefix(c.Command, "--cloud-config=",
 		"/etc/kubernetes/cloudprovider/cloudprovider.conf")
 	c.Command = controlplane.EnsureStringWithPrefix(c.Command, "--external-cloud-volume-plugin=", "gce")
+	if csiEnabled {
+		c.Command = ensureCSIFeatureGates(c.Command)
+	}
+}
+
+func ensureCSIFeatureGates(command []string) []string {
+	command = controlplane.EnsureStringWithPrefixContains(command, "--feature-gates=", "CSINodeInfo=true", ",")
+	command = controlplane.EnsureStringWithPrefixContains(command, "--feature-gates=", "CSIDriverRegistry=true", ",")
+	return command
 }
 
 var (
@@ -154,7 +186,7 @@ func (e *ensurer) ensureChecksumAnnotations(ctx context.Context, template *corev
 }
 
 // EnsureKubeletServiceUnitOptions ensures that the kubelet.service unit options conform to the provider requirements.
-func (e *ensurer) EnsureKubeletServiceUnitOptions(ctx context.Context, opts []*unit.UnitOption) ([]*unit.UnitOption, error) {
+func (e *ensurer) EnsureKubeletServiceUnitOptions(ctx context.Context, opts []*unit.UnitOption, cp *extensionsv1alpha1.ControlPlane) ([]*unit.UnitOption, error) {
 	if opt := controlplane.UnitOptionWithSectionAndName(opts, "Service", "ExecStart"); opt != nil {
 		command := controlplane.DeserializeCommandLine(opt.Value)
 		command = ensureKubeletCommandLineArgs(command)
@@ -174,7 +206,22 @@ func ensureKubeletCommandLineArgs(command []string) []string {
 }
 
 // EnsureKubeletConfiguration ensures that the kubelet configuration conforms to the provider requirements.
-func (e *ensurer) EnsureKubeletConfiguration(ctx context.Context, kubeletConfig *kubeletconfigv1beta1.KubeletConfiguration) error {
+func (e *ensurer) EnsureKubeletConfiguration(ctx context.Context, kubeletConfig *kubeletconfigv1beta1.KubeletConfiguration, cp *extensionsv1alpha1.ControlPlane) error {
+	csiEnabled, err := e.isCSIEnabled(cp)
+	if err != nil {
+		return err
+	}
+
+	if csiEnabled {
+		// Ensure CSI-related feature gates
+		if kubeletConfig.FeatureGates == nil {
+			kubeletConfig.FeatureGates = make(map[string]bool)
+		}
+		kubeletConfig.FeatureGates["CSINodeInfo"] = true
+		kubeletConfig.FeatureGates["CSIDriverRegistry"] = true
+		return nil
+	}
+
 	// Make sure CSI-related feature gates are not enabled
 	// TODO Leaving these enabled shouldn't do any harm, perhaps remove this code when properly tested?
 	delete(kubeletConfig.FeatureGates, "VolumeSnapshotDataSource")
@@ -194,3 +241,35 @@ func (e *ensurer) EnsureKubernetesGeneralConfiguration(ctx context.Context, data
 	*data = buf.String()
 	return nil
 }
+
+// EnsureDataVolumeUnits ensures that the given units format and mount the given data volumes of a worker pool.
+func (e *ensurer) EnsureDataVolumeUnits(ctx context.Context, units *[]extensionsv1alpha1.Unit, volumes []worker.DataVolume) error {
+	result, err := controlplane.EnsureDataVolumeUnits(*units, volumes, gcp.DataVolumeDevicePath)
+	if err != nil {
+		return err
+	}
+	*units = result
+	return nil
+}
+
+// isCSIEnabledInNamespace returns true if the CSI driver is enabled for the shoot in the given namespace.
+func (e *ensurer) isCSIEnabledInNamespace(ctx context.Context, namespace string) (bool, error) {
+	cp, err := controlplane.GetControlPlane(ctx, e.client, namespace)
+	if err != nil {
+		return false, err
+	}
+	return e.isCSIEnabled(cp)
+}
+
+// isCSIEnabled returns true if the CSI driver is enabled in the providerConfig of the given controlplane.
+func (e *ensurer) isCSIEnabled(cp *extensionsv1alpha1.ControlPlane) (bool, error) {
+	if cp == nil || cp.Spec.ProviderConfig == nil {
+		return false, nil
+	}
+
+	cpConfig := &apisgcp.ControlPlaneConfig{}
+	if _, _, err := e.decoder.Decode(cp.Spec.ProviderConfig.Raw, nil, cpConfig); err != nil {
+		return false, errors.Wrapf(err, "could not decode providerConfig of controlplane '%s'", util.ObjectName(cp))
+	}
+	return helper.IsCSIEnabled(cpConfig), nil
+}
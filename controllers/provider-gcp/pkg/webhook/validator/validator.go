@@ -0,0 +1,138 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validator
+
+import (
+	"context"
+	"fmt"
+
+	apisgcp "github.com/gardener/gardener-extensions/controllers/provider-gcp/pkg/apis/gcp"
+	"github.com/gardener/gardener-extensions/controllers/provider-gcp/pkg/apis/gcp/helper"
+	gcpvalidation "github.com/gardener/gardener-extensions/controllers/provider-gcp/pkg/apis/gcp/validation"
+	"github.com/gardener/gardener-extensions/controllers/provider-gcp/pkg/gcp"
+	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
+	"github.com/gardener/gardener-extensions/pkg/util"
+	utilvalidation "github.com/gardener/gardener-extensions/pkg/util/validation"
+	"github.com/gardener/gardener-extensions/pkg/webhook/validator"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/pkg/errors"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// NewValidator creates a new validator that validates GCP infrastructures, controlplanes and workers.
+func NewValidator(scheme *runtime.Scheme) validator.Validator {
+	return &gcpValidator{
+		decoder: serializer.NewCodecFactory(scheme).UniversalDecoder(),
+	}
+}
+
+type gcpValidator struct {
+	client  client.Client
+	decoder runtime.Decoder
+}
+
+// InjectClient injects the given client into the validator.
+func (v *gcpValidator) InjectClient(client client.Client) error {
+	v.client = client
+	return nil
+}
+
+// Validate validates the given object.
+func (v *gcpValidator) Validate(ctx context.Context, obj runtime.Object) error {
+	switch x := obj.(type) {
+	case *extensionsv1alpha1.Infrastructure:
+		if x.Spec.Type != gcp.Type {
+			return nil
+		}
+		return v.validateInfrastructure(x)
+	case *extensionsv1alpha1.ControlPlane:
+		if x.Spec.Type != gcp.Type {
+			return nil
+		}
+		return v.validateControlPlane(ctx, x)
+	case *extensionsv1alpha1.Worker:
+		if x.Spec.Type != gcp.Type {
+			return nil
+		}
+		return v.validateWorker(x)
+	}
+	return nil
+}
+
+func (v *gcpValidator) validateInfrastructure(infra *extensionsv1alpha1.Infrastructure) error {
+	var (
+		allErrs     = field.ErrorList{}
+		configPath  = field.NewPath("spec", "providerConfig")
+		infraConfig = &apisgcp.InfrastructureConfig{}
+	)
+
+	if infra.Spec.ProviderConfig == nil {
+		allErrs = append(allErrs, field.Required(configPath, "field is required"))
+	} else if _, _, err := v.decoder.Decode(infra.Spec.ProviderConfig.Raw, nil, infraConfig); err != nil {
+		allErrs = append(allErrs, field.Invalid(configPath, string(infra.Spec.ProviderConfig.Raw), fmt.Sprintf("could not decode provider config: %+v", err)))
+	} else {
+		allErrs = append(allErrs, gcpvalidation.ValidateInfrastructureConfig(infraConfig, configPath)...)
+	}
+
+	if len(allErrs) > 0 {
+		return apierrors.NewInvalid(extensionsv1alpha1.Kind("Infrastructure"), infra.Name, allErrs)
+	}
+	return nil
+}
+
+func (v *gcpValidator) validateControlPlane(ctx context.Context, cp *extensionsv1alpha1.ControlPlane) error {
+	if cp.Spec.ProviderConfig == nil {
+		return nil
+	}
+
+	var (
+		allErrs    = field.ErrorList{}
+		configPath = field.NewPath("spec", "providerConfig")
+		cpConfig   = &apisgcp.ControlPlaneConfig{}
+	)
+
+	if _, _, err := v.decoder.Decode(cp.Spec.ProviderConfig.Raw, nil, cpConfig); err != nil {
+		allErrs = append(allErrs, field.Invalid(configPath, string(cp.Spec.ProviderConfig.Raw), fmt.Sprintf("could not decode provider config: %+v", err)))
+	} else {
+		allErrs = append(allErrs, gcpvalidation.ValidateControlPlaneConfig(cpConfig, configPath)...)
+
+		if helper.IsCSIEnabled(cpConfig) {
+			cluster, err := extensionscontroller.GetCluster(ctx, v.client, cp.Namespace)
+			if err != nil {
+				return errors.Wrapf(err, "could not get cluster for controlplane '%s'", util.ObjectName(cp))
+			}
+			allErrs = append(allErrs, utilvalidation.ValidateCSIKubernetesVersion(cluster.Shoot.Spec.Kubernetes.Version, configPath.Child("csi", "enabled"))...)
+		}
+	}
+
+	if len(allErrs) > 0 {
+		return apierrors.NewInvalid(extensionsv1alpha1.Kind(extensionsv1alpha1.ControlPlaneResource), cp.Name, allErrs)
+	}
+	return nil
+}
+
+func (v *gcpValidator) validateWorker(worker *extensionsv1alpha1.Worker) error {
+	allErrs := utilvalidation.ValidateWorkerPools(worker.Spec.Pools, true, field.NewPath("spec", "pools"))
+
+	if len(allErrs) > 0 {
+		return apierrors.NewInvalid(extensionsv1alpha1.Kind("Worker"), worker.Name, allErrs)
+	}
+	return nil
+}
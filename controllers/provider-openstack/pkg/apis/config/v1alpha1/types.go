@@ -31,6 +31,11 @@ type ControllerConfiguration struct {
 
 	// ETCD is the etcd configuration.
 	ETCD ETCD `json:"etcd"`
+
+	// MachineSettings are the default settings of the machine-controller-manager for the machines of all worker
+	// pools. They can be overwritten in the providerConfig of each worker pool.
+	// +optional
+	MachineSettings MachineSettings `json:"machineSettings,omitempty"`
 }
 
 // MachineImage is a mapping from logical names and versions to OpenStack-specific identifiers.
@@ -41,6 +46,13 @@ type MachineImage struct {
 	Version string `json:"version"`
 	// CloudProfiles is a mapping to the correct image for the given cloudprofile.
 	CloudProfiles []CloudProfileMapping `json:"cloudProfiles"`
+	// DeprecationDate is the date from which on the machine image is considered deprecated. If it is not set,
+	// machine images with an expiration date are considered deprecated.
+	// +optional
+	DeprecationDate *metav1.Time `json:"deprecationDate,omitempty"`
+	// ExpirationDate is the date after which the machine image must no longer be used.
+	// +optional
+	ExpirationDate *metav1.Time `json:"expirationDate,omitempty"`
 }
 
 // CloudProfileMapping is a mapping to the correct image for the given cloudprofile.
@@ -75,3 +87,19 @@ type ETCDBackup struct {
 	// +optional
 	Schedule *string `json:"schedule,omitempty"`
 }
+
+// MachineSettings contains settings of the machine-controller-manager for machines.
+type MachineSettings struct {
+	// DrainTimeout is the maximum time to drain a machine before it is deleted.
+	// +optional
+	DrainTimeout *metav1.Duration `json:"drainTimeout,omitempty"`
+	// HealthTimeout is the maximum time a machine may be unhealthy before it is replaced.
+	// +optional
+	HealthTimeout *metav1.Duration `json:"healthTimeout,omitempty"`
+	// CreationTimeout is the maximum time to wait until a new machine has joined the cluster.
+	// +optional
+	CreationTimeout *metav1.Duration `json:"creationTimeout,omitempty"`
+	// MaxEvictRetries is the maximum number of times the eviction of a pod is retried while draining a machine.
+	// +optional
+	MaxEvictRetries *int32 `json:"maxEvictRetries,omitempty"`
+}
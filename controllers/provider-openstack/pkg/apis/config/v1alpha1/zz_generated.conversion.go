@@ -25,6 +25,7 @@ import (
 
 	config "github.com/gardener/gardener-extensions/controllers/provider-openstack/pkg/apis/config"
 	resource "k8s.io/apimachinery/pkg/api/resource"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	conversion "k8s.io/apimachinery/pkg/conversion"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
@@ -96,6 +97,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*MachineSettings)(nil), (*config.MachineSettings)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_MachineSettings_To_config_MachineSettings(a.(*MachineSettings), b.(*config.MachineSettings), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.MachineSettings)(nil), (*MachineSettings)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_MachineSettings_To_v1alpha1_MachineSettings(a.(*config.MachineSettings), b.(*MachineSettings), scope)
+	}); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -126,6 +137,9 @@ func autoConvert_v1alpha1_ControllerConfiguration_To_config_ControllerConfigurat
 	if err := Convert_v1alpha1_ETCD_To_config_ETCD(&in.ETCD, &out.ETCD, s); err != nil {
 		return err
 	}
+	if err := Convert_v1alpha1_MachineSettings_To_config_MachineSettings(&in.MachineSettings, &out.MachineSettings, s); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -139,6 +153,9 @@ func autoConvert_config_ControllerConfiguration_To_v1alpha1_ControllerConfigurat
 	if err := Convert_config_ETCD_To_v1alpha1_ETCD(&in.ETCD, &out.ETCD, s); err != nil {
 		return err
 	}
+	if err := Convert_config_MachineSettings_To_v1alpha1_MachineSettings(&in.MachineSettings, &out.MachineSettings, s); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -223,6 +240,8 @@ func autoConvert_v1alpha1_MachineImage_To_config_MachineImage(in *MachineImage,
 	out.Name = in.Name
 	out.Version = in.Version
 	out.CloudProfiles = *(*[]config.CloudProfileMapping)(unsafe.Pointer(&in.CloudProfiles))
+	out.DeprecationDate = (*v1.Time)(unsafe.Pointer(in.DeprecationDate))
+	out.ExpirationDate = (*v1.Time)(unsafe.Pointer(in.ExpirationDate))
 	return nil
 }
 
@@ -235,6 +254,8 @@ func autoConvert_config_MachineImage_To_v1alpha1_MachineImage(in *config.Machine
 	out.Name = in.Name
 	out.Version = in.Version
 	out.CloudProfiles = *(*[]CloudProfileMapping)(unsafe.Pointer(&in.CloudProfiles))
+	out.DeprecationDate = (*v1.Time)(unsafe.Pointer(in.DeprecationDate))
+	out.ExpirationDate = (*v1.Time)(unsafe.Pointer(in.ExpirationDate))
 	return nil
 }
 
@@ -242,3 +263,29 @@ func autoConvert_config_MachineImage_To_v1alpha1_MachineImage(in *config.Machine
 func Convert_config_MachineImage_To_v1alpha1_MachineImage(in *config.MachineImage, out *MachineImage, s conversion.Scope) error {
 	return autoConvert_config_MachineImage_To_v1alpha1_MachineImage(in, out, s)
 }
+
+func autoConvert_v1alpha1_MachineSettings_To_config_MachineSettings(in *MachineSettings, out *config.MachineSettings, s conversion.Scope) error {
+	out.DrainTimeout = (*v1.Duration)(unsafe.Pointer(in.DrainTimeout))
+	out.HealthTimeout = (*v1.Duration)(unsafe.Pointer(in.HealthTimeout))
+	out.CreationTimeout = (*v1.Duration)(unsafe.Pointer(in.CreationTimeout))
+	out.MaxEvictRetries = (*int32)(unsafe.Pointer(in.MaxEvictRetries))
+	return nil
+}
+
+// Convert_v1alpha1_MachineSettings_To_config_MachineSettings is an autogenerated conversion function.
+func Convert_v1alpha1_MachineSettings_To_config_MachineSettings(in *MachineSettings, out *config.MachineSettings, s conversion.Scope) error {
+	return autoConvert_v1alpha1_MachineSettings_To_config_MachineSettings(in, out, s)
+}
+
+func autoConvert_config_MachineSettings_To_v1alpha1_MachineSettings(in *config.MachineSettings, out *MachineSettings, s conversion.Scope) error {
+	out.DrainTimeout = (*v1.Duration)(unsafe.Pointer(in.DrainTimeout))
+	out.HealthTimeout = (*v1.Duration)(unsafe.Pointer(in.HealthTimeout))
+	out.CreationTimeout = (*v1.Duration)(unsafe.Pointer(in.CreationTimeout))
+	out.MaxEvictRetries = (*int32)(unsafe.Pointer(in.MaxEvictRetries))
+	return nil
+}
+
+// Convert_config_MachineSettings_To_v1alpha1_MachineSettings is an autogenerated conversion function.
+func Convert_config_MachineSettings_To_v1alpha1_MachineSettings(in *config.MachineSettings, out *MachineSettings, s conversion.Scope) error {
+	return autoConvert_config_MachineSettings_To_v1alpha1_MachineSettings(in, out, s)
+}
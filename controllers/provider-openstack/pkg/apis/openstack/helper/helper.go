@@ -43,3 +43,8 @@ func FindSecurityGroupByPurpose(securityGroups []openstack.SecurityGroup, purpos
 	}
 	return nil, fmt.Errorf("cannot find security group with purpose %q", purpose)
 }
+
+// IsCSIEnabled returns true if the deployment of the CSI driver is enabled in the given ControlPlaneConfig.
+func IsCSIEnabled(config *openstack.ControlPlaneConfig) bool {
+	return config != nil && config.CSI != nil && config.CSI.Enabled
+}
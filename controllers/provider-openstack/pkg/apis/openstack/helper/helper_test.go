@@ -52,6 +52,17 @@ var _ = Describe("Helper", func() {
 		Entry("entry not found", []openstack.SecurityGroup{{Name: "bar", Purpose: purposeWrong}}, purpose, nil, true),
 		Entry("entry exists", []openstack.SecurityGroup{{Name: "bar", Purpose: purpose}}, purpose, &openstack.SecurityGroup{Name: "bar", Purpose: purpose}, false),
 	)
+
+	DescribeTable("#IsCSIEnabled",
+		func(config *openstack.ControlPlaneConfig, expected bool) {
+			Expect(IsCSIEnabled(config)).To(Equal(expected))
+		},
+
+		Entry("config is nil", nil, false),
+		Entry("csi is nil", &openstack.ControlPlaneConfig{}, false),
+		Entry("csi is disabled", &openstack.ControlPlaneConfig{CSI: &openstack.CSIConfig{Enabled: false}}, false),
+		Entry("csi is enabled", &openstack.ControlPlaneConfig{CSI: &openstack.CSIConfig{Enabled: true}}, true),
+	)
 })
 
 func expectResults(result, expected interface{}, err error, expectErr bool) {
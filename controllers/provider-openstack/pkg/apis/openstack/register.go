@@ -47,6 +47,7 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 	scheme.AddKnownTypes(SchemeGroupVersion,
 		&InfrastructureConfig{},
 		&InfrastructureStatus{},
+		&ControlPlaneConfig{},
 	)
 	return nil
 }
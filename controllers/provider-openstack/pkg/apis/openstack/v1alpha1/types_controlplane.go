@@ -16,6 +16,7 @@ package v1alpha1
 
 import (
 	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -31,9 +32,90 @@ type ControlPlaneConfig struct {
 	// CloudControllerManager contains configuration settings for the cloud-controller-manager.
 	// +optional
 	CloudControllerManager *CloudControllerManagerConfig `json:"cloudControllerManager,omitempty"`
+
+	// CloudProviderConfig contains settings for the cloud provider configuration used by the Kubernetes components.
+	// +optional
+	CloudProviderConfig *CloudProviderConfig `json:"cloudProviderConfig,omitempty"`
+
+	// CSI contains configuration settings for the Container Storage Interface (CSI) driver.
+	// +optional
+	CSI *CSIConfig `json:"csi,omitempty"`
 }
 
 // CloudControllerManagerConfig contains configuration settings for the cloud-controller-manager.
 type CloudControllerManagerConfig struct {
 	gardenv1beta1.KubernetesConfig `json:",inline"`
+
+	// RouteReconciliationPeriod is the period for reconciling the routes created for nodes by the cloud provider.
+	// +optional
+	RouteReconciliationPeriod *metav1.Duration `json:"routeReconciliationPeriod,omitempty"`
+	// NodeMonitorPeriod is the period for syncing the status of the nodes.
+	// +optional
+	NodeMonitorPeriod *metav1.Duration `json:"nodeMonitorPeriod,omitempty"`
+	// NodeStatusUpdateFrequency is the frequency in which the node status is updated from the cloud provider.
+	// +optional
+	NodeStatusUpdateFrequency *metav1.Duration `json:"nodeStatusUpdateFrequency,omitempty"`
+	// ConcurrentServiceSyncs is the number of services that are allowed to sync concurrently.
+	// +optional
+	ConcurrentServiceSyncs *int32 `json:"concurrentServiceSyncs,omitempty"`
+	// Resources are the compute resource requirements of the cloud-controller-manager. Requests and limits that are
+	// not given keep their default values.
+	// +optional
+	Resources *corev1.ResourceRequirements `json:"resources,omitempty"`
+	// Verbosity is the log level verbosity of the cloud-controller-manager.
+	// +optional
+	Verbosity *int32 `json:"verbosity,omitempty"`
+}
+
+// CSIConfig contains configuration settings for the Container Storage Interface (CSI) driver.
+type CSIConfig struct {
+	// Enabled specifies whether the CSI driver is deployed and the matching Kubernetes feature gates are enabled.
+	// It can only be enabled for shoots with Kubernetes version 1.13 or higher. Disabling it again deletes the CSI driver.
+	// Volumes provisioned by the in-tree volume plugin are not migrated and keep being handled by it.
+	Enabled bool `json:"enabled"`
+}
+
+// CloudProviderConfig contains settings for the cloud provider configuration used by the Kubernetes components.
+type CloudProviderConfig struct {
+	// LoadBalancer contains settings for the load balancers created for services of type LoadBalancer.
+	// +optional
+	LoadBalancer *LoadBalancerConfig `json:"loadBalancer,omitempty"`
+}
+
+// LoadBalancerConfig contains settings for the load balancers created for services of type LoadBalancer.
+type LoadBalancerConfig struct {
+	// UseOctavia specifies whether the Octavia API is used instead of the Neutron LBaaS API.
+	// +optional
+	UseOctavia *bool `json:"useOctavia,omitempty"`
+	// CreateMonitor specifies whether health monitors are created for the load balancer members. Defaults to true.
+	// +optional
+	CreateMonitor *bool `json:"createMonitor,omitempty"`
+	// MonitorDelay is the time between sending probes to the members. Defaults to 60s.
+	// +optional
+	MonitorDelay *metav1.Duration `json:"monitorDelay,omitempty"`
+	// MonitorTimeout is the maximum time to wait for a probe to time out. Defaults to 30s.
+	// +optional
+	MonitorTimeout *metav1.Duration `json:"monitorTimeout,omitempty"`
+	// MonitorMaxRetries is the number of failed probes before a member is marked as down. Defaults to 5.
+	// +optional
+	MonitorMaxRetries *int32 `json:"monitorMaxRetries,omitempty"`
+	// Classes are additional load balancer classes that can be selected by services via the
+	// `loadbalancer.openstack.org/class` annotation, e.g. to use a different floating network.
+	// +optional
+	Classes []LoadBalancerClass `json:"classes,omitempty"`
+}
+
+// LoadBalancerClass contains the network settings of a load balancer class.
+type LoadBalancerClass struct {
+	// Name is the name of the load balancer class.
+	Name string `json:"name"`
+	// FloatingNetworkID is the ID of the network the floating IPs of the load balancers are allocated from.
+	// +optional
+	FloatingNetworkID *string `json:"floatingNetworkID,omitempty"`
+	// FloatingSubnetID is the ID of the subnet the floating IPs of the load balancers are allocated from.
+	// +optional
+	FloatingSubnetID *string `json:"floatingSubnetID,omitempty"`
+	// SubnetID is the ID of the subnet the load balancers are created in.
+	// +optional
+	SubnetID *string `json:"subnetID,omitempty"`
 }
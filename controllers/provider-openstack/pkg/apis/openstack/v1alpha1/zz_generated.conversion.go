@@ -25,6 +25,8 @@ import (
 
 	openstack "github.com/gardener/gardener-extensions/controllers/provider-openstack/pkg/apis/openstack"
 	corev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	conversion "k8s.io/apimachinery/pkg/conversion"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
@@ -36,6 +38,16 @@ func init() {
 // RegisterConversions adds conversion functions to the given scheme.
 // Public to allow building arbitrary schemes.
 func RegisterConversions(s *runtime.Scheme) error {
+	if err := s.AddGeneratedConversionFunc((*CSIConfig)(nil), (*openstack.CSIConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_CSIConfig_To_openstack_CSIConfig(a.(*CSIConfig), b.(*openstack.CSIConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*openstack.CSIConfig)(nil), (*CSIConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_openstack_CSIConfig_To_v1alpha1_CSIConfig(a.(*openstack.CSIConfig), b.(*CSIConfig), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*CloudControllerManagerConfig)(nil), (*openstack.CloudControllerManagerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_CloudControllerManagerConfig_To_openstack_CloudControllerManagerConfig(a.(*CloudControllerManagerConfig), b.(*openstack.CloudControllerManagerConfig), scope)
 	}); err != nil {
@@ -46,6 +58,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*CloudProviderConfig)(nil), (*openstack.CloudProviderConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_CloudProviderConfig_To_openstack_CloudProviderConfig(a.(*CloudProviderConfig), b.(*openstack.CloudProviderConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*openstack.CloudProviderConfig)(nil), (*CloudProviderConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_openstack_CloudProviderConfig_To_v1alpha1_CloudProviderConfig(a.(*openstack.CloudProviderConfig), b.(*CloudProviderConfig), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ControlPlaneConfig)(nil), (*openstack.ControlPlaneConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_ControlPlaneConfig_To_openstack_ControlPlaneConfig(a.(*ControlPlaneConfig), b.(*openstack.ControlPlaneConfig), scope)
 	}); err != nil {
@@ -86,6 +108,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*LoadBalancerClass)(nil), (*openstack.LoadBalancerClass)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_LoadBalancerClass_To_openstack_LoadBalancerClass(a.(*LoadBalancerClass), b.(*openstack.LoadBalancerClass), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*openstack.LoadBalancerClass)(nil), (*LoadBalancerClass)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_openstack_LoadBalancerClass_To_v1alpha1_LoadBalancerClass(a.(*openstack.LoadBalancerClass), b.(*LoadBalancerClass), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*LoadBalancerConfig)(nil), (*openstack.LoadBalancerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_LoadBalancerConfig_To_openstack_LoadBalancerConfig(a.(*LoadBalancerConfig), b.(*openstack.LoadBalancerConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*openstack.LoadBalancerConfig)(nil), (*LoadBalancerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_openstack_LoadBalancerConfig_To_v1alpha1_LoadBalancerConfig(a.(*openstack.LoadBalancerConfig), b.(*LoadBalancerConfig), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*NetworkStatus)(nil), (*openstack.NetworkStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_NetworkStatus_To_openstack_NetworkStatus(a.(*NetworkStatus), b.(*openstack.NetworkStatus), scope)
 	}); err != nil {
@@ -159,8 +201,34 @@ func RegisterConversions(s *runtime.Scheme) error {
 	return nil
 }
 
+func autoConvert_v1alpha1_CSIConfig_To_openstack_CSIConfig(in *CSIConfig, out *openstack.CSIConfig, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	return nil
+}
+
+// Convert_v1alpha1_CSIConfig_To_openstack_CSIConfig is an autogenerated conversion function.
+func Convert_v1alpha1_CSIConfig_To_openstack_CSIConfig(in *CSIConfig, out *openstack.CSIConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_CSIConfig_To_openstack_CSIConfig(in, out, s)
+}
+
+func autoConvert_openstack_CSIConfig_To_v1alpha1_CSIConfig(in *openstack.CSIConfig, out *CSIConfig, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	return nil
+}
+
+// Convert_openstack_CSIConfig_To_v1alpha1_CSIConfig is an autogenerated conversion function.
+func Convert_openstack_CSIConfig_To_v1alpha1_CSIConfig(in *openstack.CSIConfig, out *CSIConfig, s conversion.Scope) error {
+	return autoConvert_openstack_CSIConfig_To_v1alpha1_CSIConfig(in, out, s)
+}
+
 func autoConvert_v1alpha1_CloudControllerManagerConfig_To_openstack_CloudControllerManagerConfig(in *CloudControllerManagerConfig, out *openstack.CloudControllerManagerConfig, s conversion.Scope) error {
 	out.KubernetesConfig = in.KubernetesConfig
+	out.RouteReconciliationPeriod = (*v1.Duration)(unsafe.Pointer(in.RouteReconciliationPeriod))
+	out.NodeMonitorPeriod = (*v1.Duration)(unsafe.Pointer(in.NodeMonitorPeriod))
+	out.NodeStatusUpdateFrequency = (*v1.Duration)(unsafe.Pointer(in.NodeStatusUpdateFrequency))
+	out.ConcurrentServiceSyncs = (*int32)(unsafe.Pointer(in.ConcurrentServiceSyncs))
+	out.Resources = (*corev1.ResourceRequirements)(unsafe.Pointer(in.Resources))
+	out.Verbosity = (*int32)(unsafe.Pointer(in.Verbosity))
 	return nil
 }
 
@@ -171,6 +239,12 @@ func Convert_v1alpha1_CloudControllerManagerConfig_To_openstack_CloudControllerM
 
 func autoConvert_openstack_CloudControllerManagerConfig_To_v1alpha1_CloudControllerManagerConfig(in *openstack.CloudControllerManagerConfig, out *CloudControllerManagerConfig, s conversion.Scope) error {
 	out.KubernetesConfig = in.KubernetesConfig
+	out.RouteReconciliationPeriod = (*v1.Duration)(unsafe.Pointer(in.RouteReconciliationPeriod))
+	out.NodeMonitorPeriod = (*v1.Duration)(unsafe.Pointer(in.NodeMonitorPeriod))
+	out.NodeStatusUpdateFrequency = (*v1.Duration)(unsafe.Pointer(in.NodeStatusUpdateFrequency))
+	out.ConcurrentServiceSyncs = (*int32)(unsafe.Pointer(in.ConcurrentServiceSyncs))
+	out.Resources = (*corev1.ResourceRequirements)(unsafe.Pointer(in.Resources))
+	out.Verbosity = (*int32)(unsafe.Pointer(in.Verbosity))
 	return nil
 }
 
@@ -179,9 +253,31 @@ func Convert_openstack_CloudControllerManagerConfig_To_v1alpha1_CloudControllerM
 	return autoConvert_openstack_CloudControllerManagerConfig_To_v1alpha1_CloudControllerManagerConfig(in, out, s)
 }
 
+func autoConvert_v1alpha1_CloudProviderConfig_To_openstack_CloudProviderConfig(in *CloudProviderConfig, out *openstack.CloudProviderConfig, s conversion.Scope) error {
+	out.LoadBalancer = (*openstack.LoadBalancerConfig)(unsafe.Pointer(in.LoadBalancer))
+	return nil
+}
+
+// Convert_v1alpha1_CloudProviderConfig_To_openstack_CloudProviderConfig is an autogenerated conversion function.
+func Convert_v1alpha1_CloudProviderConfig_To_openstack_CloudProviderConfig(in *CloudProviderConfig, out *openstack.CloudProviderConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_CloudProviderConfig_To_openstack_CloudProviderConfig(in, out, s)
+}
+
+func autoConvert_openstack_CloudProviderConfig_To_v1alpha1_CloudProviderConfig(in *openstack.CloudProviderConfig, out *CloudProviderConfig, s conversion.Scope) error {
+	out.LoadBalancer = (*LoadBalancerConfig)(unsafe.Pointer(in.LoadBalancer))
+	return nil
+}
+
+// Convert_openstack_CloudProviderConfig_To_v1alpha1_CloudProviderConfig is an autogenerated conversion function.
+func Convert_openstack_CloudProviderConfig_To_v1alpha1_CloudProviderConfig(in *openstack.CloudProviderConfig, out *CloudProviderConfig, s conversion.Scope) error {
+	return autoConvert_openstack_CloudProviderConfig_To_v1alpha1_CloudProviderConfig(in, out, s)
+}
+
 func autoConvert_v1alpha1_ControlPlaneConfig_To_openstack_ControlPlaneConfig(in *ControlPlaneConfig, out *openstack.ControlPlaneConfig, s conversion.Scope) error {
 	out.LoadBalancerProvider = in.LoadBalancerProvider
 	out.CloudControllerManager = (*openstack.CloudControllerManagerConfig)(unsafe.Pointer(in.CloudControllerManager))
+	out.CloudProviderConfig = (*openstack.CloudProviderConfig)(unsafe.Pointer(in.CloudProviderConfig))
+	out.CSI = (*openstack.CSIConfig)(unsafe.Pointer(in.CSI))
 	return nil
 }
 
@@ -193,6 +289,8 @@ func Convert_v1alpha1_ControlPlaneConfig_To_openstack_ControlPlaneConfig(in *Con
 func autoConvert_openstack_ControlPlaneConfig_To_v1alpha1_ControlPlaneConfig(in *openstack.ControlPlaneConfig, out *ControlPlaneConfig, s conversion.Scope) error {
 	out.LoadBalancerProvider = in.LoadBalancerProvider
 	out.CloudControllerManager = (*CloudControllerManagerConfig)(unsafe.Pointer(in.CloudControllerManager))
+	out.CloudProviderConfig = (*CloudProviderConfig)(unsafe.Pointer(in.CloudProviderConfig))
+	out.CSI = (*CSIConfig)(unsafe.Pointer(in.CSI))
 	return nil
 }
 
@@ -279,6 +377,62 @@ func Convert_openstack_InfrastructureStatus_To_v1alpha1_InfrastructureStatus(in
 	return autoConvert_openstack_InfrastructureStatus_To_v1alpha1_InfrastructureStatus(in, out, s)
 }
 
+func autoConvert_v1alpha1_LoadBalancerClass_To_openstack_LoadBalancerClass(in *LoadBalancerClass, out *openstack.LoadBalancerClass, s conversion.Scope) error {
+	out.Name = in.Name
+	out.FloatingNetworkID = (*string)(unsafe.Pointer(in.FloatingNetworkID))
+	out.FloatingSubnetID = (*string)(unsafe.Pointer(in.FloatingSubnetID))
+	out.SubnetID = (*string)(unsafe.Pointer(in.SubnetID))
+	return nil
+}
+
+// Convert_v1alpha1_LoadBalancerClass_To_openstack_LoadBalancerClass is an autogenerated conversion function.
+func Convert_v1alpha1_LoadBalancerClass_To_openstack_LoadBalancerClass(in *LoadBalancerClass, out *openstack.LoadBalancerClass, s conversion.Scope) error {
+	return autoConvert_v1alpha1_LoadBalancerClass_To_openstack_LoadBalancerClass(in, out, s)
+}
+
+func autoConvert_openstack_LoadBalancerClass_To_v1alpha1_LoadBalancerClass(in *openstack.LoadBalancerClass, out *LoadBalancerClass, s conversion.Scope) error {
+	out.Name = in.Name
+	out.FloatingNetworkID = (*string)(unsafe.Pointer(in.FloatingNetworkID))
+	out.FloatingSubnetID = (*string)(unsafe.Pointer(in.FloatingSubnetID))
+	out.SubnetID = (*string)(unsafe.Pointer(in.SubnetID))
+	return nil
+}
+
+// Convert_openstack_LoadBalancerClass_To_v1alpha1_LoadBalancerClass is an autogenerated conversion function.
+func Convert_openstack_LoadBalancerClass_To_v1alpha1_LoadBalancerClass(in *openstack.LoadBalancerClass, out *LoadBalancerClass, s conversion.Scope) error {
+	return autoConvert_openstack_LoadBalancerClass_To_v1alpha1_LoadBalancerClass(in, out, s)
+}
+
+func autoConvert_v1alpha1_LoadBalancerConfig_To_openstack_LoadBalancerConfig(in *LoadBalancerConfig, out *openstack.LoadBalancerConfig, s conversion.Scope) error {
+	out.UseOctavia = (*bool)(unsafe.Pointer(in.UseOctavia))
+	out.CreateMonitor = (*bool)(unsafe.Pointer(in.CreateMonitor))
+	out.MonitorDelay = (*v1.Duration)(unsafe.Pointer(in.MonitorDelay))
+	out.MonitorTimeout = (*v1.Duration)(unsafe.Pointer(in.MonitorTimeout))
+	out.MonitorMaxRetries = (*int32)(unsafe.Pointer(in.MonitorMaxRetries))
+	out.Classes = *(*[]openstack.LoadBalancerClass)(unsafe.Pointer(&in.Classes))
+	return nil
+}
+
+// Convert_v1alpha1_LoadBalancerConfig_To_openstack_LoadBalancerConfig is an autogenerated conversion function.
+func Convert_v1alpha1_LoadBalancerConfig_To_openstack_LoadBalancerConfig(in *LoadBalancerConfig, out *openstack.LoadBalancerConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_LoadBalancerConfig_To_openstack_LoadBalancerConfig(in, out, s)
+}
+
+func autoConvert_openstack_LoadBalancerConfig_To_v1alpha1_LoadBalancerConfig(in *openstack.LoadBalancerConfig, out *LoadBalancerConfig, s conversion.Scope) error {
+	out.UseOctavia = (*bool)(unsafe.Pointer(in.UseOctavia))
+	out.CreateMonitor = (*bool)(unsafe.Pointer(in.CreateMonitor))
+	out.MonitorDelay = (*v1.Duration)(unsafe.Pointer(in.MonitorDelay))
+	out.MonitorTimeout = (*v1.Duration)(unsafe.Pointer(in.MonitorTimeout))
+	out.MonitorMaxRetries = (*int32)(unsafe.Pointer(in.MonitorMaxRetries))
+	out.Classes = *(*[]LoadBalancerClass)(unsafe.Pointer(&in.Classes))
+	return nil
+}
+
+// Convert_openstack_LoadBalancerConfig_To_v1alpha1_LoadBalancerConfig is an autogenerated conversion function.
+func Convert_openstack_LoadBalancerConfig_To_v1alpha1_LoadBalancerConfig(in *openstack.LoadBalancerConfig, out *LoadBalancerConfig, s conversion.Scope) error {
+	return autoConvert_openstack_LoadBalancerConfig_To_v1alpha1_LoadBalancerConfig(in, out, s)
+}
+
 func autoConvert_v1alpha1_NetworkStatus_To_openstack_NetworkStatus(in *NetworkStatus, out *openstack.NetworkStatus, s conversion.Scope) error {
 	out.ID = in.ID
 	if err := Convert_v1alpha1_FloatingPoolStatus_To_openstack_FloatingPoolStatus(&in.FloatingPool, &out.FloatingPool, s); err != nil {
@@ -0,0 +1,96 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	"regexp"
+
+	apisopenstack "github.com/gardener/gardener-extensions/controllers/provider-openstack/pkg/apis/openstack"
+	utilvalidation "github.com/gardener/gardener-extensions/pkg/util/validation"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// cloudProviderConfigValueRegex matches the names and IDs that can be safely written into the cloud provider config.
+var cloudProviderConfigValueRegex = regexp.MustCompile(`^[a-zA-Z0-9._-]+$`)
+
+// ValidateControlPlaneConfig validates the given ControlPlaneConfig.
+func ValidateControlPlaneConfig(cp *apisopenstack.ControlPlaneConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if len(cp.LoadBalancerProvider) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("loadBalancerProvider"), "field is required"))
+	}
+
+	if ccm := cp.CloudControllerManager; ccm != nil {
+		allErrs = append(allErrs, utilvalidation.ValidateCloudControllerManagerSettings(utilvalidation.CloudControllerManagerSettings{
+			RouteReconciliationPeriod: ccm.RouteReconciliationPeriod,
+			NodeMonitorPeriod:         ccm.NodeMonitorPeriod,
+			NodeStatusUpdateFrequency: ccm.NodeStatusUpdateFrequency,
+			ConcurrentServiceSyncs:    ccm.ConcurrentServiceSyncs,
+			Verbosity:                 ccm.Verbosity,
+			Resources:                 ccm.Resources,
+		}, fldPath.Child("cloudControllerManager"))...)
+	}
+
+	if cp.CloudProviderConfig != nil && cp.CloudProviderConfig.LoadBalancer != nil {
+		allErrs = append(allErrs, validateLoadBalancerConfig(cp.CloudProviderConfig.LoadBalancer, fldPath.Child("cloudProviderConfig", "loadBalancer"))...)
+	}
+
+	return allErrs
+}
+
+func validateLoadBalancerConfig(lb *apisopenstack.LoadBalancerConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs, utilvalidation.ValidatePositiveDuration(lb.MonitorDelay, fldPath.Child("monitorDelay"))...)
+	allErrs = append(allErrs, utilvalidation.ValidatePositiveDuration(lb.MonitorTimeout, fldPath.Child("monitorTimeout"))...)
+
+	if lb.MonitorMaxRetries != nil && *lb.MonitorMaxRetries <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("monitorMaxRetries"), *lb.MonitorMaxRetries, "must be greater than 0"))
+	}
+
+	names := sets.NewString()
+	for i, class := range lb.Classes {
+		idxPath := fldPath.Child("classes").Index(i)
+
+		switch {
+		case len(class.Name) == 0:
+			allErrs = append(allErrs, field.Required(idxPath.Child("name"), "field is required"))
+		case !cloudProviderConfigValueRegex.MatchString(class.Name):
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("name"), class.Name, "must only contain alphanumeric characters, '.', '_' or '-'"))
+		case names.Has(class.Name):
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), class.Name))
+		}
+		names.Insert(class.Name)
+
+		allErrs = append(allErrs, validateCloudProviderConfigID(class.FloatingNetworkID, idxPath.Child("floatingNetworkID"))...)
+		allErrs = append(allErrs, validateCloudProviderConfigID(class.FloatingSubnetID, idxPath.Child("floatingSubnetID"))...)
+		allErrs = append(allErrs, validateCloudProviderConfigID(class.SubnetID, idxPath.Child("subnetID"))...)
+	}
+
+	return allErrs
+}
+
+func validateCloudProviderConfigID(id *string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if id != nil && !cloudProviderConfigValueRegex.MatchString(*id) {
+		allErrs = append(allErrs, field.Invalid(fldPath, *id, "must only contain alphanumeric characters, '.', '_' or '-'"))
+	}
+
+	return allErrs
+}
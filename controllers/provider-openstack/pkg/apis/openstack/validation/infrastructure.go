@@ -0,0 +1,41 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	apisopenstack "github.com/gardener/gardener-extensions/controllers/provider-openstack/pkg/apis/openstack"
+	utilvalidation "github.com/gardener/gardener-extensions/pkg/util/validation"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// ValidateInfrastructureConfig validates the given InfrastructureConfig.
+func ValidateInfrastructureConfig(infra *apisopenstack.InfrastructureConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if len(infra.FloatingPoolName) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("floatingPoolName"), "field is required"))
+	}
+
+	networksPath := fldPath.Child("networks")
+
+	if infra.Networks.Router != nil && len(infra.Networks.Router.ID) == 0 {
+		allErrs = append(allErrs, field.Required(networksPath.Child("router", "id"), "field is required"))
+	}
+
+	allErrs = append(allErrs, utilvalidation.ValidateCIDRParse(utilvalidation.NewCIDR(string(infra.Networks.Worker), networksPath.Child("worker")))...)
+
+	return allErrs
+}
@@ -0,0 +1,153 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation_test
+
+import (
+	"time"
+
+	apisopenstack "github.com/gardener/gardener-extensions/controllers/provider-openstack/pkg/apis/openstack"
+	. "github.com/gardener/gardener-extensions/controllers/provider-openstack/pkg/apis/openstack/validation"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+var _ = Describe("InfrastructureConfig validation", func() {
+	var (
+		fldPath = field.NewPath("spec", "providerConfig")
+		infra   *apisopenstack.InfrastructureConfig
+	)
+
+	BeforeEach(func() {
+		infra = &apisopenstack.InfrastructureConfig{
+			FloatingPoolName: "fip",
+			Networks: apisopenstack.Networks{
+				Worker: "10.250.0.0/19",
+			},
+		}
+	})
+
+	It("should accept a valid config", func() {
+		Expect(ValidateInfrastructureConfig(infra, fldPath)).To(BeEmpty())
+	})
+
+	It("should require the floating pool name and a router id", func() {
+		infra.FloatingPoolName = ""
+		infra.Networks.Router = &apisopenstack.Router{}
+
+		Expect(ValidateInfrastructureConfig(infra, fldPath)).To(ConsistOf(
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("spec.providerConfig.floatingPoolName"),
+			})),
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("spec.providerConfig.networks.router.id"),
+			})),
+		))
+	})
+
+	It("should reject an invalid worker cidr", func() {
+		infra.Networks.Worker = "10.250.0.0"
+
+		Expect(ValidateInfrastructureConfig(infra, fldPath)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+			"Type":  Equal(field.ErrorTypeInvalid),
+			"Field": Equal("spec.providerConfig.networks.worker"),
+		}))))
+	})
+})
+
+var _ = Describe("ControlPlaneConfig validation", func() {
+	var (
+		fldPath = field.NewPath("spec", "providerConfig")
+
+		monitorMaxRetries        int32 = 3
+		invalidMonitorMaxRetries int32
+
+		floatingNetworkID = "4a5b6c7d-1234-5678-9abc-def012345678"
+		invalidID         = "foo\"\n[Global]"
+	)
+
+	It("should accept a valid config", func() {
+		Expect(ValidateControlPlaneConfig(&apisopenstack.ControlPlaneConfig{LoadBalancerProvider: "haproxy"}, fldPath)).To(BeEmpty())
+	})
+
+	It("should require the load balancer provider", func() {
+		Expect(ValidateControlPlaneConfig(&apisopenstack.ControlPlaneConfig{}, fldPath)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+			"Type":  Equal(field.ErrorTypeRequired),
+			"Field": Equal("spec.providerConfig.loadBalancerProvider"),
+		}))))
+	})
+	It("should accept a valid load balancer config", func() {
+		cpConfig := &apisopenstack.ControlPlaneConfig{
+			LoadBalancerProvider: "haproxy",
+			CloudProviderConfig: &apisopenstack.CloudProviderConfig{
+				LoadBalancer: &apisopenstack.LoadBalancerConfig{
+					MonitorDelay:      &metav1.Duration{Duration: time.Minute},
+					MonitorMaxRetries: &monitorMaxRetries,
+					Classes: []apisopenstack.LoadBalancerClass{
+						{Name: "internet", FloatingNetworkID: &floatingNetworkID},
+						{Name: "intranet", SubnetID: &floatingNetworkID},
+					},
+				},
+			},
+		}
+
+		Expect(ValidateControlPlaneConfig(cpConfig, fldPath)).To(BeEmpty())
+	})
+
+	It("should reject an invalid load balancer config", func() {
+		cpConfig := &apisopenstack.ControlPlaneConfig{
+			LoadBalancerProvider: "haproxy",
+			CloudProviderConfig: &apisopenstack.CloudProviderConfig{
+				LoadBalancer: &apisopenstack.LoadBalancerConfig{
+					MonitorTimeout:    &metav1.Duration{},
+					MonitorMaxRetries: &invalidMonitorMaxRetries,
+					Classes: []apisopenstack.LoadBalancerClass{
+						{Name: "internet", FloatingNetworkID: &invalidID},
+						{Name: "internet"},
+						{},
+					},
+				},
+			},
+		}
+
+		Expect(ValidateControlPlaneConfig(cpConfig, fldPath)).To(ConsistOf(
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.providerConfig.cloudProviderConfig.loadBalancer.monitorTimeout"),
+			})),
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.providerConfig.cloudProviderConfig.loadBalancer.monitorMaxRetries"),
+			})),
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.providerConfig.cloudProviderConfig.loadBalancer.classes[0].floatingNetworkID"),
+			})),
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeDuplicate),
+				"Field": Equal("spec.providerConfig.cloudProviderConfig.loadBalancer.classes[1].name"),
+			})),
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("spec.providerConfig.cloudProviderConfig.loadBalancer.classes[2].name"),
+			})),
+		))
+	})
+})
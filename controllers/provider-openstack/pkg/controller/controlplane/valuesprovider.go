@@ -22,12 +22,15 @@ import (
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/gardener/gardener/pkg/operation/common"
 	"github.com/gardener/gardener/pkg/utils/chart"
+	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
 	"github.com/gardener/gardener/pkg/utils/secrets"
 	"github.com/go-logr/logr"
 	"github.com/pkg/errors"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	policyv1beta1 "k8s.io/api/policy/v1beta1"
 	rbacv1 "k8s.io/api/rbac/v1"
+	storagev1 "k8s.io/api/storage/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/serializer"
 	"k8s.io/apiserver/pkg/authentication/user"
@@ -47,6 +50,8 @@ import (
 const (
 	cloudControllerManagerDeploymentName = "cloud-controller-manager"
 	cloudControllerManagerServerName     = "cloud-controller-manager-server"
+	csiAttacherName                      = "csi-attacher"
+	csiProvisionerName                   = "csi-provisioner"
 )
 
 var controlPlaneSecrets = &secrets.Secrets{
@@ -81,6 +86,32 @@ var controlPlaneSecrets = &secrets.Secrets{
 					SigningCA:  cas[gardencorev1alpha1.SecretNameCACluster],
 				},
 			},
+			&secrets.ControlPlaneSecretConfig{
+				CertificateSecretConfig: &secrets.CertificateSecretConfig{
+					Name:         csiAttacherName,
+					CommonName:   "system:csi-attacher",
+					Organization: []string{user.SystemPrivilegedGroup},
+					CertType:     secrets.ClientCert,
+					SigningCA:    cas[gardencorev1alpha1.SecretNameCACluster],
+				},
+				KubeConfigRequest: &secrets.KubeConfigRequest{
+					ClusterName:  clusterName,
+					APIServerURL: common.KubeAPIServerDeploymentName,
+				},
+			},
+			&secrets.ControlPlaneSecretConfig{
+				CertificateSecretConfig: &secrets.CertificateSecretConfig{
+					Name:         csiProvisionerName,
+					CommonName:   "system:csi-provisioner",
+					Organization: []string{user.SystemPrivilegedGroup},
+					CertType:     secrets.ClientCert,
+					SigningCA:    cas[gardencorev1alpha1.SecretNameCACluster],
+				},
+				KubeConfigRequest: &secrets.KubeConfigRequest{
+					ClusterName:  clusterName,
+					APIServerURL: common.KubeAPIServerDeploymentName,
+				},
+			},
 		}
 	},
 }
@@ -96,22 +127,60 @@ var configChart = &chart.Chart{
 	},
 }
 
-var ccmChart = &chart.Chart{
-	Name:   "cloud-controller-manager",
-	Path:   filepath.Join(openstacktypes.InternalChartsPath, "cloud-controller-manager"),
-	Images: []string{openstacktypes.HyperkubeImageName},
-	Objects: []*chart.Object{
-		{Type: &corev1.Service{}, Name: "cloud-controller-manager"},
-		{Type: &appsv1.Deployment{}, Name: "cloud-controller-manager"},
+var controlPlaneChart = &chart.Chart{
+	Name: "seed-controlplane",
+	Path: filepath.Join(openstacktypes.InternalChartsPath, "seed-controlplane"),
+	SubCharts: []*chart.Chart{
+		{
+			Name:   "cloud-controller-manager",
+			Images: []string{openstacktypes.HyperkubeImageName},
+			Objects: []*chart.Object{
+				{Type: &corev1.Service{}, Name: "cloud-controller-manager"},
+				{Type: &appsv1.Deployment{}, Name: "cloud-controller-manager"},
+			},
+		},
+		{
+			Name:   "csi-openstack",
+			Images: []string{openstacktypes.CSIAttacherImageName, openstacktypes.CSIProvisionerImageName, openstacktypes.CSIPluginImageName},
+			Objects: []*chart.Object{
+				{Type: &appsv1.Deployment{}, Name: "csi-driver-controller"},
+			},
+		},
 	},
 }
 
-var ccmShootChart = &chart.Chart{
-	Name: "cloud-controller-manager-shoot",
-	Path: filepath.Join(openstacktypes.InternalChartsPath, "cloud-controller-manager-shoot"),
-	Objects: []*chart.Object{
-		{Type: &rbacv1.ClusterRole{}, Name: "system:controller:cloud-node-controller"},
-		{Type: &rbacv1.ClusterRoleBinding{}, Name: "system:controller:cloud-node-controller"},
+var controlPlaneShootChart = &chart.Chart{
+	Name: "shoot-system-components",
+	Path: filepath.Join(openstacktypes.InternalChartsPath, "shoot-system-components"),
+	SubCharts: []*chart.Chart{
+		{
+			Name: "cloud-controller-manager-shoot",
+			Objects: []*chart.Object{
+				{Type: &rbacv1.ClusterRole{}, Name: "system:controller:cloud-node-controller"},
+				{Type: &rbacv1.ClusterRoleBinding{}, Name: "system:controller:cloud-node-controller"},
+			},
+		},
+		{
+			Name:   "csi-openstack",
+			Images: []string{openstacktypes.CSINodeDriverRegistrarImageName, openstacktypes.CSIPluginImageName},
+			Objects: []*chart.Object{
+				{Type: &appsv1.DaemonSet{}, Name: "csi-driver-node"},
+				{Type: &corev1.Secret{}, Name: "csi-driver-node-cloud-provider-config"},
+				{Type: &corev1.ServiceAccount{}, Name: "csi-driver-node"},
+				{Type: &policyv1beta1.PodSecurityPolicy{}, Name: "gardener.kube-system.csi-driver-node"},
+				{Type: &rbacv1.ClusterRole{}, Name: "garden.sapcloud.io:psp:kube-system:csi-driver-node"},
+				{Type: &rbacv1.ClusterRoleBinding{}, Name: "garden.sapcloud.io:psp:csi-driver-node"},
+				{Type: &rbacv1.ClusterRole{}, Name: "garden.sapcloud.io:kube-system:csi-attacher"},
+				{Type: &rbacv1.ClusterRoleBinding{}, Name: "garden.sapcloud.io:csi-attacher"},
+				{Type: &rbacv1.Role{}, Name: "csi-attacher"},
+				{Type: &rbacv1.RoleBinding{}, Name: "csi-attacher"},
+				{Type: &rbacv1.ClusterRole{}, Name: "garden.sapcloud.io:kube-system:csi-provisioner"},
+				{Type: &rbacv1.ClusterRoleBinding{}, Name: "garden.sapcloud.io:csi-provisioner"},
+				{Type: &rbacv1.Role{}, Name: "csi-provisioner"},
+				{Type: &rbacv1.RoleBinding{}, Name: "csi-provisioner"},
+				{Type: &storagev1.StorageClass{}, Name: "default-csi"},
+			},
+		},
 	},
 }
 
@@ -182,17 +251,34 @@ func (vp *valuesProvider) GetControlPlaneChartValues(
 		return nil, errors.Wrapf(err, "could not decode providerConfig of controlplane '%s'", util.ObjectName(cp))
 	}
 
-	// Get CCM chart values
-	return getCCMChartValues(cpConfig, cp, cluster, checksums)
+	// Get control plane chart values
+	return getControlPlaneChartValues(cpConfig, cp, cluster, checksums)
 }
 
 // GetControlPlaneShootChartValues returns the values for the control plane shoot chart applied by this actuator.
 func (vp *valuesProvider) GetControlPlaneShootChartValues(
-	context.Context,
-	*extensionsv1alpha1.ControlPlane,
-	*extensionscontroller.Cluster,
+	ctx context.Context,
+	cp *extensionsv1alpha1.ControlPlane,
+	cluster *extensionscontroller.Cluster,
 ) (map[string]interface{}, error) {
-	return nil, nil
+	// Decode providerConfig
+	cpConfig := &openstack.ControlPlaneConfig{}
+	if _, _, err := vp.decoder.Decode(cp.Spec.ProviderConfig.Raw, nil, cpConfig); err != nil {
+		return nil, errors.Wrapf(err, "could not decode providerConfig of controlplane '%s'", util.ObjectName(cp))
+	}
+
+	// Get cloud provider config if CSI is enabled
+	var cloudProviderConfig string
+	if helper.IsCSIEnabled(cpConfig) {
+		cm := &corev1.ConfigMap{}
+		if err := vp.client.Get(ctx, kutil.Key(cp.Namespace, openstacktypes.CloudProviderConfigName), cm); err != nil {
+			return nil, errors.Wrapf(err, "could not get configmap '%s/%s'", cp.Namespace, openstacktypes.CloudProviderConfigName)
+		}
+		cloudProviderConfig = cm.Data[common.CloudProviderConfigMapKey]
+	}
+
+	// Get control plane shoot chart values
+	return getControlPlaneShootChartValues(cpConfig, cluster, cloudProviderConfig)
 }
 
 // getConfigChartValues collects and returns the configuration chart values.
@@ -210,7 +296,7 @@ func getConfigChartValues(
 	}
 
 	// Collect config chart values
-	return map[string]interface{}{
+	values := map[string]interface{}{
 		"kubernetesVersion": cluster.Shoot.Spec.Kubernetes.Version,
 		"domainName":        c.DomainName,
 		"tenantName":        c.TenantName,
@@ -222,6 +308,69 @@ func getConfigChartValues(
 		"authUrl":           cluster.CloudProfile.Spec.OpenStack.KeyStoneURL,
 		"dhcpDomain":        cluster.CloudProfile.Spec.OpenStack.DHCPDomain,
 		"requestTimeout":    cluster.CloudProfile.Spec.OpenStack.RequestTimeout,
+	}
+
+	if cpConfig.CloudProviderConfig != nil && cpConfig.CloudProviderConfig.LoadBalancer != nil {
+		setLoadBalancerConfigValues(values, cpConfig.CloudProviderConfig.LoadBalancer)
+	}
+
+	return values, nil
+}
+
+// setLoadBalancerConfigValues sets the config chart values of the given load balancer settings.
+func setLoadBalancerConfigValues(values map[string]interface{}, lb *openstack.LoadBalancerConfig) {
+	if lb.UseOctavia != nil {
+		values["useOctavia"] = *lb.UseOctavia
+	}
+	if lb.CreateMonitor != nil {
+		values["createMonitor"] = *lb.CreateMonitor
+	}
+	if lb.MonitorDelay != nil {
+		values["monitorDelay"] = lb.MonitorDelay.Duration.String()
+	}
+	if lb.MonitorTimeout != nil {
+		values["monitorTimeout"] = lb.MonitorTimeout.Duration.String()
+	}
+	if lb.MonitorMaxRetries != nil {
+		values["monitorMaxRetries"] = *lb.MonitorMaxRetries
+	}
+
+	if len(lb.Classes) > 0 {
+		var classes []map[string]interface{}
+		for _, class := range lb.Classes {
+			classValues := map[string]interface{}{
+				"name": class.Name,
+			}
+			if class.FloatingNetworkID != nil {
+				classValues["floatingNetworkID"] = *class.FloatingNetworkID
+			}
+			if class.FloatingSubnetID != nil {
+				classValues["floatingSubnetID"] = *class.FloatingSubnetID
+			}
+			if class.SubnetID != nil {
+				classValues["subnetID"] = *class.SubnetID
+			}
+			classes = append(classes, classValues)
+		}
+		values["loadBalancerClasses"] = classes
+	}
+}
+
+// getControlPlaneChartValues collects and returns the control plane chart values.
+func getControlPlaneChartValues(
+	cpConfig *openstack.ControlPlaneConfig,
+	cp *extensionsv1alpha1.ControlPlane,
+	cluster *extensionscontroller.Cluster,
+	checksums map[string]string,
+) (map[string]interface{}, error) {
+	ccm, err := getCCMChartValues(cpConfig, cp, cluster, checksums)
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]interface{}{
+		"cloud-controller-manager": ccm,
+		"csi-openstack":            getCSIControllerChartValues(cpConfig, cluster, checksums),
 	}, nil
 }
 
@@ -247,9 +396,64 @@ func getCCMChartValues(
 		},
 	}
 
-	if cpConfig.CloudControllerManager != nil {
-		values["featureGates"] = cpConfig.CloudControllerManager.FeatureGates
+	if ccm := cpConfig.CloudControllerManager; ccm != nil {
+		values["featureGates"] = ccm.FeatureGates
+		if ccm.RouteReconciliationPeriod != nil {
+			values["routeReconciliationPeriod"] = ccm.RouteReconciliationPeriod.Duration.String()
+		}
+		if ccm.NodeMonitorPeriod != nil {
+			values["nodeMonitorPeriod"] = ccm.NodeMonitorPeriod.Duration.String()
+		}
+		if ccm.NodeStatusUpdateFrequency != nil {
+			values["nodeStatusUpdateFrequency"] = ccm.NodeStatusUpdateFrequency.Duration.String()
+		}
+		if ccm.ConcurrentServiceSyncs != nil {
+			values["concurrentServiceSyncs"] = *ccm.ConcurrentServiceSyncs
+		}
+		if ccm.Verbosity != nil {
+			values["verbosity"] = *ccm.Verbosity
+		}
+		if ccm.Resources != nil {
+			values["resources"] = ccm.Resources
+		}
 	}
 
 	return values, nil
 }
+
+// getCSIControllerChartValues collects and returns the CSI controller chart values.
+func getCSIControllerChartValues(
+	cpConfig *openstack.ControlPlaneConfig,
+	cluster *extensionscontroller.Cluster,
+	checksums map[string]string,
+) map[string]interface{} {
+	if !helper.IsCSIEnabled(cpConfig) {
+		return map[string]interface{}{"enabled": false}
+	}
+
+	return map[string]interface{}{
+		"enabled":           true,
+		"replicas":          extensionscontroller.GetReplicas(cluster.Shoot, 1),
+		"kubernetesVersion": cluster.Shoot.Spec.Kubernetes.Version,
+		"podAnnotations": map[string]interface{}{
+			"checksum/secret-" + csiAttacherName:       checksums[csiAttacherName],
+			"checksum/secret-" + csiProvisionerName:    checksums[csiProvisionerName],
+			"checksum/configmap-cloud-provider-config": checksums[openstacktypes.CloudProviderConfigName],
+		},
+	}
+}
+
+// getControlPlaneShootChartValues collects and returns the control plane shoot chart values.
+func getControlPlaneShootChartValues(
+	cpConfig *openstack.ControlPlaneConfig,
+	cluster *extensionscontroller.Cluster,
+	cloudProviderConfig string,
+) (map[string]interface{}, error) {
+	return map[string]interface{}{
+		"csi-openstack": map[string]interface{}{
+			"enabled":             helper.IsCSIEnabled(cpConfig),
+			"kubernetesVersion":   cluster.Shoot.Spec.Kubernetes.Version,
+			"cloudProviderConfig": cloudProviderConfig,
+		},
+	}, nil
+}
@@ -38,6 +38,8 @@ import (
 )
 
 type actuator struct {
+	infrastructure.TerraformerMigrator
+
 	logger logr.Logger
 
 	restConfig *rest.Config
@@ -52,7 +54,8 @@ type actuator struct {
 // NewActuator creates a new Actuator that updates the status of the handled Infrastructure resources.
 func NewActuator() infrastructure.Actuator {
 	return &actuator{
-		logger: log.Log.WithName("infrastructure-actuator"),
+		TerraformerMigrator: infrastructure.NewTerraformerMigrator(infrainternal.TerraformerPurpose),
+		logger:              log.Log.WithName("infrastructure-actuator"),
 	}
 }
 
@@ -64,7 +67,7 @@ func (a *actuator) InjectScheme(scheme *runtime.Scheme) error {
 
 func (a *actuator) InjectClient(client client.Client) error {
 	a.client = client
-	return nil
+	return a.TerraformerMigrator.InjectClient(client)
 }
 
 func (a *actuator) InjectConfig(config *rest.Config) error {
@@ -23,6 +23,7 @@ import (
 	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
 	controllererrors "github.com/gardener/gardener-extensions/pkg/controller/error"
 
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/gardener/gardener/pkg/operation/terraformer"
 )
@@ -48,16 +49,24 @@ func (a *actuator) reconcile(ctx context.Context, infra *extensionsv1alpha1.Infr
 		return err
 	}
 
-	if err := tf.
-		InitializeWith(terraformer.DefaultInitializer(a.client, terraformFiles.Main, terraformFiles.Variables, terraformFiles.TFVars)).
-		Apply(); err != nil {
+	extensionscontroller.ReportProgress(ctx, 10, "Initializing the Terraform configuration and state")
+	tf = tf.InitializeWith(terraformer.DefaultInitializer(a.client, terraformFiles.Main, terraformFiles.Variables, terraformFiles.TFVars))
 
+	extensionscontroller.ReportProgress(ctx, 20, "Applying the Terraform configuration")
+	if err := tf.Apply(); err != nil {
 		a.logger.Error(err, "failed to apply the terraform config", "infrastructure", infra.Name)
+		extensionscontroller.SetCondition(ctx, extensionscontroller.ConditionTypeInfrastructureReady, gardencorev1alpha1.ConditionFalse, "TerraformApplyFailed", err.Error())
 		return &controllererrors.RequeueAfterError{
 			Cause:        err,
 			RequeueAfter: 30 * time.Second,
 		}
 	}
 
-	return a.updateProviderStatus(ctx, tf, infra, config)
+	extensionscontroller.ReportProgress(ctx, 90, "Extracting the infrastructure provider status from the Terraform state")
+	if err := a.updateProviderStatus(ctx, tf, infra, config); err != nil {
+		return err
+	}
+
+	extensionscontroller.SetCondition(ctx, extensionscontroller.ConditionTypeInfrastructureReady, gardencorev1alpha1.ConditionTrue, "InfrastructureReconciled", "The infrastructure has been reconciled.")
+	return nil
 }
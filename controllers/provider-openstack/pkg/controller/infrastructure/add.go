@@ -15,6 +15,8 @@
 package infrastructure
 
 import (
+	"time"
+
 	"github.com/gardener/gardener-extensions/controllers/provider-openstack/pkg/openstack"
 	"github.com/gardener/gardener-extensions/pkg/controller/infrastructure"
 
@@ -33,6 +35,12 @@ type AddOptions struct {
 	Controller controller.Options
 	// IgnoreOperationAnnotation specifies whether to ignore the operation annotation or not.
 	IgnoreOperationAnnotation bool
+	// DryRun specifies whether the infrastructure changes are only planned instead of being applied.
+	DryRun bool
+	// ResyncPeriod is the period after which reconciled infrastructures are checked for drift.
+	ResyncPeriod time.Duration
+	// AutoRepairDrift specifies whether drifted infrastructures are reconciled again.
+	AutoRepairDrift bool
 }
 
 // AddToManagerWithOptions adds a controller with the given AddOptions to the given manager.
@@ -42,6 +50,9 @@ func AddToManagerWithOptions(mgr manager.Manager, options AddOptions) error {
 		Actuator:          infrastructure.OperationAnnotationWrapper(NewActuator()),
 		ControllerOptions: options.Controller,
 		Predicates:        infrastructure.DefaultPredicates(mgr.GetClient(), openstack.Type, options.IgnoreOperationAnnotation),
+		DryRun:            options.DryRun,
+		ResyncPeriod:      options.ResyncPeriod,
+		AutoRepairDrift:   options.AutoRepairDrift,
 	})
 }
 
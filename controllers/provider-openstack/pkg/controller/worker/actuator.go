@@ -45,13 +45,15 @@ type delegateFactory struct {
 	decoder runtime.Decoder
 
 	machineImageToCloudProfilesMapping []config.MachineImage
+	machineSettings                    config.MachineSettings
 }
 
 // NewActuator creates a new Actuator that updates the status of the handled WorkerPoolConfigs.
-func NewActuator(machineImageToCloudProfilesMapping []config.MachineImage) worker.Actuator {
+func NewActuator(machineImageToCloudProfilesMapping []config.MachineImage, machineSettings config.MachineSettings, rolloutSettings worker.RolloutSettings) worker.Actuator {
 	delegateFactory := &delegateFactory{
 		logger:                             log.Log.WithName("worker-actuator"),
 		machineImageToCloudProfilesMapping: machineImageToCloudProfilesMapping,
+		machineSettings:                    machineSettings,
 	}
 	return genericactuator.NewActuator(
 		log.Log.WithName("openstack-worker-actuator"),
@@ -60,6 +62,7 @@ func NewActuator(machineImageToCloudProfilesMapping []config.MachineImage) worke
 		mcmChart,
 		mcmShootChart,
 		imagevector.ImageVector(),
+		rolloutSettings,
 	)
 }
 
@@ -100,6 +103,7 @@ func (d *delegateFactory) WorkerDelegate(ctx context.Context, worker *extensions
 		d.decoder,
 
 		d.machineImageToCloudProfilesMapping,
+		d.machineSettings,
 		seedChartApplier,
 		serverVersion.GitVersion,
 
@@ -113,6 +117,7 @@ type workerDelegate struct {
 	decoder runtime.Decoder
 
 	machineImageToCloudProfilesMapping []config.MachineImage
+	machineSettings                    config.MachineSettings
 	seedChartApplier                   gardener.ChartApplier
 	serverVersion                      string
 
@@ -129,6 +134,7 @@ func NewWorkerDelegate(
 	decoder runtime.Decoder,
 
 	machineImageToCloudProfilesMapping []config.MachineImage,
+	machineSettings config.MachineSettings,
 	seedChartApplier gardener.ChartApplier,
 	serverVersion string,
 
@@ -140,6 +146,7 @@ func NewWorkerDelegate(
 		decoder: decoder,
 
 		machineImageToCloudProfilesMapping: machineImageToCloudProfilesMapping,
+		machineSettings:                    machineSettings,
 		seedChartApplier:                   seedChartApplier,
 		serverVersion:                      serverVersion,
 
@@ -27,7 +27,9 @@ import (
 
 var (
 	// DefaultAddOptions are the default AddOptions for AddToManager.
-	DefaultAddOptions = AddOptions{}
+	DefaultAddOptions = AddOptions{
+		RolloutSettings: worker.DefaultRolloutSettings(),
+	}
 )
 
 // AddOptions are options to apply when adding the OpenStack worker controller to the manager.
@@ -36,6 +38,12 @@ type AddOptions struct {
 	Controller controller.Options
 	// MachineImagesToCloudProfilesMapping is the default mapping from machine images to cloud profiles.
 	MachineImagesToCloudProfilesMapping []config.MachineImage
+	// MachineSettings are the default settings of the machine-controller-manager for the machines of all worker pools.
+	MachineSettings config.MachineSettings
+	// IgnoreOperationAnnotation specifies whether to ignore the operation annotation or not.
+	IgnoreOperationAnnotation bool
+	// RolloutSettings are the settings used when rolling out or deleting the machines of a worker.
+	RolloutSettings worker.RolloutSettings
 }
 
 // AddToManagerWithOptions adds a controller with the given Options to the given manager.
@@ -50,9 +58,9 @@ func AddToManagerWithOptions(mgr manager.Manager, opts AddOptions) error {
 	}
 
 	return worker.Add(mgr, worker.AddArgs{
-		Actuator:          NewActuator(opts.MachineImagesToCloudProfilesMapping),
+		Actuator:          worker.OperationAnnotationWrapper(NewActuator(opts.MachineImagesToCloudProfilesMapping, opts.MachineSettings, opts.RolloutSettings)),
 		ControllerOptions: opts.Controller,
-		Predicates:        worker.DefaultPredicates(mgr.GetClient(), openstack.Type),
+		Predicates:        worker.DefaultPredicates(mgr.GetClient(), openstack.Type, opts.IgnoreOperationAnnotation),
 	})
 }
 
@@ -24,10 +24,12 @@ import (
 	openstackapihelper "github.com/gardener/gardener-extensions/controllers/provider-openstack/pkg/apis/openstack/helper"
 	"github.com/gardener/gardener-extensions/controllers/provider-openstack/pkg/internal"
 	"github.com/gardener/gardener-extensions/controllers/provider-openstack/pkg/openstack"
+	workerhelper "github.com/gardener/gardener-extensions/pkg/apis/worker/helper"
 	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
 	"github.com/gardener/gardener-extensions/pkg/controller/worker"
 	"github.com/gardener/gardener-extensions/pkg/util"
 
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
 	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
 	"k8s.io/apimachinery/pkg/runtime"
 )
@@ -42,6 +44,11 @@ func (w *workerDelegate) MachineClassList() runtime.Object {
 	return &machinev1alpha1.OpenStackMachineClassList{}
 }
 
+// MachineClassReplacementFields yields the fields of the OpenStack machine class specs whose changes require new machines.
+func (w *workerDelegate) MachineClassReplacementFields() []string {
+	return []string{"region", "availabilityZone", "machineType", "keyName", "imageName", "networkID", "podNetworkCidr", "securityGroups", "secret"}
+}
+
 // DeployMachineClasses generates and creates the OpenStack specific machine classes.
 func (w *workerDelegate) DeployMachineClasses(ctx context.Context) error {
 	if w.machineClasses == nil {
@@ -89,6 +96,11 @@ func (w *workerDelegate) generateMachineConfig(ctx context.Context) error {
 		return err
 	}
 
+	classNamesInUse, err := worker.MachineClassNamesInUse(ctx, w.client, w.worker.Namespace)
+	if err != nil {
+		return err
+	}
+
 	shootVersionMajorMinor, err := util.VersionMajorMinor(w.cluster.Shoot.Spec.Kubernetes.Version)
 	if err != nil {
 		return err
@@ -105,12 +117,47 @@ func (w *workerDelegate) generateMachineConfig(ctx context.Context) error {
 	}
 
 	for _, pool := range w.worker.Spec.Pools {
-		zoneLen := len(pool.Zones)
+		workerConfig, err := workerhelper.WorkerConfigFromPool(pool)
+		if err != nil {
+			return err
+		}
+
+		spotSettings := worker.SpotSettingsFromConfig(workerConfig)
+		if spotSettings != nil {
+			return fmt.Errorf("pool %q: spot instances are not supported on OpenStack by the used machine-controller-manager", pool.Name)
+		}
+
+		dataVolumes := worker.DataVolumesFromConfig(workerConfig)
+		if len(dataVolumes) > 0 {
+			return fmt.Errorf("pool %q: data volumes are not supported on OpenStack by the used machine-controller-manager", pool.Name)
+		}
+
+		autoscalerOptions := worker.AutoscalerOptionsFromConfig(workerConfig)
+		nodeTemplate := worker.NodeTemplateForMachineType(w.machineTypes(), pool.MachineType, pool.Labels, pool.Taints)
+
+		poolMachineSettings := worker.MachineSettingsFromConfig(workerConfig)
+		machineSettings := poolMachineSettings.WithDefaults(w.defaultMachineSettings())
+
+		zoneDistribution, err := worker.ZoneDistributionFromPool(pool, workerConfig)
+		if err != nil {
+			return err
+		}
 
 		machineImage, err := confighelper.FindImageForCloudProfile(w.machineImageToCloudProfilesMapping, pool.MachineImage.Name, pool.MachineImage.Version, w.cluster.CloudProfile.Name)
 		if err != nil {
 			return err
 		}
+		machineImageSettings, err := confighelper.FindMachineImage(w.machineImageToCloudProfilesMapping, pool.MachineImage.Name, pool.MachineImage.Version)
+		if err != nil {
+			return err
+		}
+		usedMachineImage := &worker.MachineImage{
+			Name:            machineImageSettings.Name,
+			Version:         machineImageSettings.Version,
+			ID:              machineImage,
+			DeprecationDate: machineImageSettings.DeprecationDate,
+			ExpirationDate:  machineImageSettings.ExpirationDate,
+		}
 
 		for zoneIndex, zone := range pool.Zones {
 			machineClassSpec := map[string]interface{}{
@@ -132,22 +179,27 @@ func (w *workerDelegate) generateMachineConfig(ctx context.Context) error {
 			}
 
 			var (
-				machineClassSpecHash = worker.MachineClassHash(machineClassSpec, shootVersionMajorMinor)
-				deploymentName       = fmt.Sprintf("%s-%s-z%d", w.worker.Namespace, pool.Name, zoneIndex+1)
-				className            = fmt.Sprintf("%s-%s", deploymentName, machineClassSpecHash)
+				deploymentName = fmt.Sprintf("%s-%s-z%d", w.worker.Namespace, pool.Name, zoneIndex+1)
+				className      = worker.MachineClassName(deploymentName, machineClassSpec, shootVersionMajorMinor, classNamesInUse, w.MachineClassReplacementFields()...)
 			)
 
 			machineDeployments = append(machineDeployments, worker.MachineDeployment{
-				Name:           deploymentName,
-				ClassName:      className,
-				SecretName:     className,
-				Minimum:        worker.DistributeOverZones(zoneIndex, pool.Minimum, zoneLen),
-				Maximum:        worker.DistributeOverZones(zoneIndex, pool.Maximum, zoneLen),
-				MaxSurge:       worker.DistributePositiveIntOrPercent(zoneIndex, pool.MaxSurge, zoneLen, pool.Maximum),
-				MaxUnavailable: worker.DistributePositiveIntOrPercent(zoneIndex, pool.MaxUnavailable, zoneLen, pool.Minimum),
-				Labels:         pool.Labels,
-				Annotations:    pool.Annotations,
-				Taints:         pool.Taints,
+				Name:            deploymentName,
+				PoolName:        pool.Name,
+				Zone:            zone,
+				ClassName:       className,
+				SecretName:      className,
+				Minimum:         zoneDistribution.Minimum(zoneIndex),
+				Maximum:         zoneDistribution.Maximum(zoneIndex),
+				MaxSurge:        zoneDistribution.MaxSurge(zoneIndex),
+				MaxUnavailable:  zoneDistribution.MaxUnavailable(zoneIndex),
+				Labels:          pool.Labels,
+				Annotations:     pool.Annotations,
+				Taints:          pool.Taints,
+				Autoscaler:      autoscalerOptions,
+				NodeTemplate:    nodeTemplate,
+				MachineSettings: machineSettings,
+				MachineImage:    usedMachineImage,
 			})
 
 			machineClassSpec["name"] = className
@@ -166,3 +218,26 @@ func (w *workerDelegate) generateMachineConfig(ctx context.Context) error {
 
 	return nil
 }
+
+// machineTypes returns the machine types of the OpenStack section of the CloudProfile, if any.
+func (w *workerDelegate) machineTypes() []gardenv1beta1.MachineType {
+	if w.cluster.CloudProfile == nil || w.cluster.CloudProfile.Spec.OpenStack == nil {
+		return nil
+	}
+	var machineTypes []gardenv1beta1.MachineType
+	for _, machineType := range w.cluster.CloudProfile.Spec.OpenStack.Constraints.MachineTypes {
+		machineTypes = append(machineTypes, machineType.MachineType)
+	}
+	return machineTypes
+}
+
+// defaultMachineSettings returns the machine settings of the controller configuration, which are used for all
+// worker pools that do not overwrite them.
+func (w *workerDelegate) defaultMachineSettings() worker.MachineSettings {
+	return worker.MachineSettings{
+		DrainTimeout:    w.machineSettings.DrainTimeout,
+		HealthTimeout:   w.machineSettings.HealthTimeout,
+		CreationTimeout: w.machineSettings.CreationTimeout,
+		MaxEvictRetries: w.machineSettings.MaxEvictRetries,
+	}
+}
@@ -63,7 +63,7 @@ var _ = Describe("Machines", func() {
 	})
 
 	Context("workerDelegate", func() {
-		workerDelegate := NewWorkerDelegate(nil, nil, nil, nil, "", nil, nil)
+		workerDelegate := NewWorkerDelegate(nil, nil, nil, config.MachineSettings{}, nil, "", nil, nil)
 
 		Describe("#MachineClassKind", func() {
 			It("should return the correct kind of the machine class", func() {
@@ -77,6 +77,12 @@ var _ = Describe("Machines", func() {
 			})
 		})
 
+		Describe("#MachineClassReplacementFields", func() {
+			It("should return the fields whose changes require new machines", func() {
+				Expect(workerDelegate.MachineClassReplacementFields()).To(ConsistOf("region", "availabilityZone", "machineType", "keyName", "imageName", "networkID", "podNetworkCidr", "securityGroups", "secret"))
+			})
+		})
+
 		Describe("#GenerateMachineDeployments, #DeployMachineClasses", func() {
 			var (
 				namespace        string
@@ -275,11 +281,12 @@ var _ = Describe("Machines", func() {
 				_ = apisopenstack.AddToScheme(scheme)
 				decoder = serializer.NewCodecFactory(scheme).UniversalDecoder()
 
-				workerDelegate = NewWorkerDelegate(c, decoder, machineImageToCloudProfilesMapping, chartApplier, "", w, cluster)
+				workerDelegate = NewWorkerDelegate(c, decoder, machineImageToCloudProfilesMapping, config.MachineSettings{}, chartApplier, "", w, cluster)
 			})
 
 			It("should return the expected machine deployments", func() {
 				expectGetSecretCallToWork(c, openstackDomainName, openstackTenantName, openstackUserName, openstackPassword)
+				expectListMachineDeploymentsCallToWork(c)
 
 				// Test workerDelegate.DeployMachineClasses()
 				var (
@@ -310,10 +317,10 @@ var _ = Describe("Machines", func() {
 					machineClassNamePool2Zone1 = fmt.Sprintf("%s-%s-z1", namespace, namePool2)
 					machineClassNamePool2Zone2 = fmt.Sprintf("%s-%s-z2", namespace, namePool2)
 
-					machineClassHashPool1Zone1 = worker.MachineClassHash(machineClassPool1Zone1, shootVersionMajorMinor)
-					machineClassHashPool1Zone2 = worker.MachineClassHash(machineClassPool1Zone2, shootVersionMajorMinor)
-					machineClassHashPool2Zone1 = worker.MachineClassHash(machineClassPool2Zone1, shootVersionMajorMinor)
-					machineClassHashPool2Zone2 = worker.MachineClassHash(machineClassPool2Zone2, shootVersionMajorMinor)
+					machineClassHashPool1Zone1 = worker.MachineClassHash(machineClassPool1Zone1, shootVersionMajorMinor, workerDelegate.MachineClassReplacementFields()...)
+					machineClassHashPool1Zone2 = worker.MachineClassHash(machineClassPool1Zone2, shootVersionMajorMinor, workerDelegate.MachineClassReplacementFields()...)
+					machineClassHashPool2Zone1 = worker.MachineClassHash(machineClassPool2Zone1, shootVersionMajorMinor, workerDelegate.MachineClassReplacementFields()...)
+					machineClassHashPool2Zone2 = worker.MachineClassHash(machineClassPool2Zone2, shootVersionMajorMinor, workerDelegate.MachineClassReplacementFields()...)
 
 					machineClassWithHashPool1Zone1 = fmt.Sprintf("%s-%s", machineClassNamePool1Zone1, machineClassHashPool1Zone1)
 					machineClassWithHashPool1Zone2 = fmt.Sprintf("%s-%s", machineClassNamePool1Zone2, machineClassHashPool1Zone2)
@@ -347,42 +354,60 @@ var _ = Describe("Machines", func() {
 				Expect(err).NotTo(HaveOccurred())
 
 				// Test workerDelegate.GenerateMachineDeployments()
+				usedMachineImage := &worker.MachineImage{
+					Name:    machineImageName,
+					Version: machineImageVersion,
+					ID:      machineImage,
+				}
+
 				machineDeployments := worker.MachineDeployments{
 					{
 						Name:           machineClassNamePool1Zone1,
+						PoolName:       namePool1,
+						Zone:           zone1,
 						ClassName:      machineClassWithHashPool1Zone1,
 						SecretName:     machineClassWithHashPool1Zone1,
 						Minimum:        worker.DistributeOverZones(0, minPool1, 2),
 						Maximum:        worker.DistributeOverZones(0, maxPool1, 2),
 						MaxSurge:       worker.DistributePositiveIntOrPercent(0, maxSurgePool1, 2, maxPool1),
 						MaxUnavailable: worker.DistributePositiveIntOrPercent(0, maxUnavailablePool1, 2, minPool1),
+						MachineImage:   usedMachineImage,
 					},
 					{
 						Name:           machineClassNamePool1Zone2,
+						PoolName:       namePool1,
+						Zone:           zone2,
 						ClassName:      machineClassWithHashPool1Zone2,
 						SecretName:     machineClassWithHashPool1Zone2,
 						Minimum:        worker.DistributeOverZones(1, minPool1, 2),
 						Maximum:        worker.DistributeOverZones(1, maxPool1, 2),
 						MaxSurge:       worker.DistributePositiveIntOrPercent(1, maxSurgePool1, 2, maxPool1),
 						MaxUnavailable: worker.DistributePositiveIntOrPercent(1, maxUnavailablePool1, 2, minPool1),
+						MachineImage:   usedMachineImage,
 					},
 					{
 						Name:           machineClassNamePool2Zone1,
+						PoolName:       namePool2,
+						Zone:           zone1,
 						ClassName:      machineClassWithHashPool2Zone1,
 						SecretName:     machineClassWithHashPool2Zone1,
 						Minimum:        worker.DistributeOverZones(0, minPool2, 2),
 						Maximum:        worker.DistributeOverZones(0, maxPool2, 2),
 						MaxSurge:       worker.DistributePositiveIntOrPercent(0, maxSurgePool2, 2, maxPool2),
 						MaxUnavailable: worker.DistributePositiveIntOrPercent(0, maxUnavailablePool2, 2, minPool2),
+						MachineImage:   usedMachineImage,
 					},
 					{
 						Name:           machineClassNamePool2Zone2,
+						PoolName:       namePool2,
+						Zone:           zone2,
 						ClassName:      machineClassWithHashPool2Zone2,
 						SecretName:     machineClassWithHashPool2Zone2,
 						Minimum:        worker.DistributeOverZones(1, minPool2, 2),
 						Maximum:        worker.DistributeOverZones(1, maxPool2, 2),
 						MaxSurge:       worker.DistributePositiveIntOrPercent(1, maxSurgePool2, 2, maxPool2),
 						MaxUnavailable: worker.DistributePositiveIntOrPercent(1, maxUnavailablePool2, 2, minPool2),
+						MachineImage:   usedMachineImage,
 					},
 				}
 
@@ -391,6 +416,36 @@ var _ = Describe("Machines", func() {
 				Expect(result).To(Equal(machineDeployments))
 			})
 
+			It("should distribute the machines of a pool according to its zone distribution", func() {
+				expectGetSecretCallToWork(c, openstackDomainName, openstackTenantName, openstackUserName, openstackPassword)
+				expectListMachineDeploymentsCallToWork(c)
+
+				w.Spec.Pools[1].ProviderConfig = &runtime.RawExtension{
+					Raw: []byte(fmt.Sprintf(`{"apiVersion":"worker.extensions.gardener.cloud/v1alpha1","kind":"WorkerConfig","zoneDistribution":[{"name":%q,"weight":2},{"name":%q,"minimum":5}]}`, zone1, zone2)),
+				}
+
+				workerDelegate = NewWorkerDelegate(c, decoder, machineImageToCloudProfilesMapping, config.MachineSettings{}, chartApplier, "", w, cluster)
+
+				result, err := workerDelegate.GenerateMachineDeployments(context.TODO())
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result).To(HaveLen(4))
+
+				for i, expected := range []struct {
+					minimum, maximum         int
+					maxSurge, maxUnavailable intstr.IntOrString
+				}{
+					{25, 30, intstr.FromInt(7), intstr.FromInt(10)},
+					{5, 15, intstr.FromInt(3), intstr.FromInt(5)},
+				} {
+					deployment := result[2+i]
+					Expect(deployment.PoolName).To(Equal(namePool2))
+					Expect(deployment.Minimum).To(Equal(expected.minimum))
+					Expect(deployment.Maximum).To(Equal(expected.maximum))
+					Expect(deployment.MaxSurge).To(Equal(expected.maxSurge))
+					Expect(deployment.MaxUnavailable).To(Equal(expected.maxUnavailable))
+				}
+			})
+
 			It("should fail because the secret cannot be read", func() {
 				c.EXPECT().
 					Get(context.TODO(), gomock.Any(), gomock.AssignableToTypeOf(&corev1.Secret{})).
@@ -403,9 +458,10 @@ var _ = Describe("Machines", func() {
 
 			It("should fail because the version is invalid", func() {
 				expectGetSecretCallToWork(c, openstackDomainName, openstackTenantName, openstackUserName, openstackPassword)
+				expectListMachineDeploymentsCallToWork(c)
 
 				cluster.Shoot.Spec.Kubernetes.Version = "invalid"
-				workerDelegate = NewWorkerDelegate(c, decoder, machineImageToCloudProfilesMapping, chartApplier, "", w, cluster)
+				workerDelegate = NewWorkerDelegate(c, decoder, machineImageToCloudProfilesMapping, config.MachineSettings{}, chartApplier, "", w, cluster)
 
 				result, err := workerDelegate.GenerateMachineDeployments(context.TODO())
 				Expect(err).To(HaveOccurred())
@@ -414,10 +470,11 @@ var _ = Describe("Machines", func() {
 
 			It("should fail because the infrastructure status cannot be decoded", func() {
 				expectGetSecretCallToWork(c, openstackDomainName, openstackTenantName, openstackUserName, openstackPassword)
+				expectListMachineDeploymentsCallToWork(c)
 
 				w.Spec.InfrastructureProviderStatus = &runtime.RawExtension{}
 
-				workerDelegate = NewWorkerDelegate(c, decoder, machineImageToCloudProfilesMapping, chartApplier, "", w, cluster)
+				workerDelegate = NewWorkerDelegate(c, decoder, machineImageToCloudProfilesMapping, config.MachineSettings{}, chartApplier, "", w, cluster)
 
 				result, err := workerDelegate.GenerateMachineDeployments(context.TODO())
 				Expect(err).To(HaveOccurred())
@@ -426,12 +483,28 @@ var _ = Describe("Machines", func() {
 
 			It("should fail because the security group cannot be found", func() {
 				expectGetSecretCallToWork(c, openstackDomainName, openstackTenantName, openstackUserName, openstackPassword)
+				expectListMachineDeploymentsCallToWork(c)
 
 				w.Spec.InfrastructureProviderStatus = &runtime.RawExtension{
 					Raw: encode(&apisopenstack.InfrastructureStatus{}),
 				}
 
-				workerDelegate = NewWorkerDelegate(c, decoder, machineImageToCloudProfilesMapping, chartApplier, "", w, cluster)
+				workerDelegate = NewWorkerDelegate(c, decoder, machineImageToCloudProfilesMapping, config.MachineSettings{}, chartApplier, "", w, cluster)
+
+				result, err := workerDelegate.GenerateMachineDeployments(context.TODO())
+				Expect(err).To(HaveOccurred())
+				Expect(result).To(BeNil())
+			})
+
+			It("should fail because the zone distribution is invalid", func() {
+				expectGetSecretCallToWork(c, openstackDomainName, openstackTenantName, openstackUserName, openstackPassword)
+				expectListMachineDeploymentsCallToWork(c)
+
+				w.Spec.Pools[1].ProviderConfig = &runtime.RawExtension{
+					Raw: []byte(`{"apiVersion":"worker.extensions.gardener.cloud/v1alpha1","kind":"WorkerConfig","zoneDistribution":[{"name":"unknown-zone"}]}`),
+				}
+
+				workerDelegate = NewWorkerDelegate(c, decoder, machineImageToCloudProfilesMapping, config.MachineSettings{}, chartApplier, "", w, cluster)
 
 				result, err := workerDelegate.GenerateMachineDeployments(context.TODO())
 				Expect(err).To(HaveOccurred())
@@ -440,10 +513,11 @@ var _ = Describe("Machines", func() {
 
 			It("should fail because the machine image for this cloud profile cannot be found", func() {
 				expectGetSecretCallToWork(c, openstackDomainName, openstackTenantName, openstackUserName, openstackPassword)
+				expectListMachineDeploymentsCallToWork(c)
 
 				cluster.CloudProfile.Name = "another-cloud-profile"
 
-				workerDelegate = NewWorkerDelegate(c, decoder, machineImageToCloudProfilesMapping, chartApplier, "", w, cluster)
+				workerDelegate = NewWorkerDelegate(c, decoder, machineImageToCloudProfilesMapping, config.MachineSettings{}, chartApplier, "", w, cluster)
 
 				result, err := workerDelegate.GenerateMachineDeployments(context.TODO())
 				Expect(err).To(HaveOccurred())
@@ -491,3 +565,17 @@ func addNameAndSecretToMachineClass(class map[string]interface{}, openstackAuthU
 	class["secret"].(map[string]interface{})[openstack.UserName] = openstackUserName
 	class["secret"].(map[string]interface{})[openstack.Password] = openstackPassword
 }
+
+func expectListMachineDeploymentsCallToWork(c *mockclient.MockClient, classNames ...string) {
+	c.EXPECT().
+		List(context.TODO(), gomock.Any(), gomock.AssignableToTypeOf(&machinev1alpha1.MachineDeploymentList{})).
+		DoAndReturn(func(_ context.Context, _ *client.ListOptions, list *machinev1alpha1.MachineDeploymentList) error {
+			for _, className := range classNames {
+				machineDeployment := machinev1alpha1.MachineDeployment{}
+				machineDeployment.Spec.Template.Spec.Class.Name = className
+				list.Items = append(list.Items, machineDeployment)
+			}
+			return nil
+		}).
+		AnyTimes()
+}
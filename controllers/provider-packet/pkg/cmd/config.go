@@ -75,6 +75,11 @@ func (c *Config) ApplyMachineImages(machineImages *[]config.MachineImage) {
 	*machineImages = c.Config.MachineImages
 }
 
+// ApplyMachineSettings sets the given machine settings to those of this Config.
+func (c *Config) ApplyMachineSettings(machineSettings *config.MachineSettings) {
+	*machineSettings = c.Config.MachineSettings
+}
+
 // Options initializes empty config.ControllerConfiguration, applies the set values and returns it.
 func (c *Config) Options() config.ControllerConfiguration {
 	var cfg config.ControllerConfiguration
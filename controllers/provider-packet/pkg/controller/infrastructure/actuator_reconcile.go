@@ -25,6 +25,7 @@ import (
 	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
 	controllererrors "github.com/gardener/gardener-extensions/pkg/controller/error"
 
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/gardener/gardener/pkg/chartrenderer"
 	"github.com/gardener/gardener/pkg/operation/terraformer"
@@ -42,16 +43,9 @@ func (a *actuator) reconcile(ctx context.Context, infrastructure *extensionsv1al
 		return err
 	}
 
-	terraformConfig := GenerateTerraformInfraConfig(infrastructure, string(providerSecret.Data[packet.ProjectID]))
-
-	chartRenderer, err := chartrenderer.NewForConfig(a.restConfig)
+	release, err := a.renderTerraformChart(infrastructure, providerSecret)
 	if err != nil {
-		return fmt.Errorf("could not create chart renderer: %+v", err)
-	}
-
-	release, err := chartRenderer.Render(filepath.Join(packet.InternalChartsPath, "packet-infra"), "packet-infra", infrastructure.Namespace, terraformConfig)
-	if err != nil {
-		return fmt.Errorf("could not render Terraform chart: %+v", err)
+		return err
 	}
 
 	tf, err := a.newTerraformer(packet.TerraformerPurposeInfra, infrastructure.Namespace, infrastructure.Name)
@@ -59,24 +53,48 @@ func (a *actuator) reconcile(ctx context.Context, infrastructure *extensionsv1al
 		return fmt.Errorf("could not create terraformer object: %+v", err)
 	}
 
-	if err := tf.
+	extensionscontroller.ReportProgress(ctx, 10, "Initializing the Terraform configuration and state")
+	tf = tf.
 		SetVariablesEnvironment(generateTerraformInfraVariablesEnvironment(providerSecret)).
 		InitializeWith(terraformer.DefaultInitializer(
 			a.client,
 			release.FileContent("main.tf"),
 			release.FileContent("variables.tf"),
 			[]byte(release.FileContent("terraform.tfvars"))),
-		).
-		Apply(); err != nil {
+		)
 
+	extensionscontroller.ReportProgress(ctx, 20, "Applying the Terraform configuration")
+	if err := tf.Apply(); err != nil {
 		a.logger.Error(err, "failed to apply the terraform config", "infrastructure", infrastructure.Name)
+		extensionscontroller.SetCondition(ctx, extensionscontroller.ConditionTypeInfrastructureReady, gardencorev1alpha1.ConditionFalse, "TerraformApplyFailed", err.Error())
 		return &controllererrors.RequeueAfterError{
 			Cause:        err,
 			RequeueAfter: 30 * time.Second,
 		}
 	}
 
-	return a.updateProviderStatus(ctx, tf, infrastructure)
+	extensionscontroller.ReportProgress(ctx, 90, "Extracting the infrastructure provider status from the Terraform state")
+	if err := a.updateProviderStatus(ctx, tf, infrastructure); err != nil {
+		return err
+	}
+
+	extensionscontroller.SetCondition(ctx, extensionscontroller.ConditionTypeInfrastructureReady, gardencorev1alpha1.ConditionTrue, "InfrastructureReconciled", "The infrastructure has been reconciled.")
+	return nil
+}
+
+func (a *actuator) renderTerraformChart(infrastructure *extensionsv1alpha1.Infrastructure, providerSecret *corev1.Secret) (*chartrenderer.RenderedChart, error) {
+	terraformConfig := GenerateTerraformInfraConfig(infrastructure, string(providerSecret.Data[packet.ProjectID]))
+
+	chartRenderer, err := chartrenderer.NewForConfig(a.restConfig)
+	if err != nil {
+		return nil, fmt.Errorf("could not create chart renderer: %+v", err)
+	}
+
+	release, err := chartRenderer.Render(filepath.Join(packet.InternalChartsPath, "packet-infra"), "packet-infra", infrastructure.Namespace, terraformConfig)
+	if err != nil {
+		return nil, fmt.Errorf("could not render Terraform chart: %+v", err)
+	}
+	return release, nil
 }
 
 // GenerateTerraformInfraConfig generates the Packet Terraform configuration based on the given infrastructure and project.
@@ -44,14 +44,16 @@ type delegateFactory struct {
 	scheme  *runtime.Scheme
 	decoder runtime.Decoder
 
-	machineImages []config.MachineImage
+	machineImages   []config.MachineImage
+	machineSettings config.MachineSettings
 }
 
 // NewActuator creates a new Actuator that updates the status of the handled WorkerPoolConfigs.
-func NewActuator(machineImages []config.MachineImage) worker.Actuator {
+func NewActuator(machineImages []config.MachineImage, machineSettings config.MachineSettings, rolloutSettings worker.RolloutSettings) worker.Actuator {
 	delegateFactory := &delegateFactory{
-		logger:        log.Log.WithName("worker-actuator"),
-		machineImages: machineImages,
+		logger:          log.Log.WithName("worker-actuator"),
+		machineImages:   machineImages,
+		machineSettings: machineSettings,
 	}
 	return genericactuator.NewActuator(
 		log.Log.WithName("packet-worker-actuator"),
@@ -60,6 +62,7 @@ func NewActuator(machineImages []config.MachineImage) worker.Actuator {
 		mcmChart,
 		mcmShootChart,
 		imagevector.ImageVector(),
+		rolloutSettings,
 	)
 }
 
@@ -100,6 +103,7 @@ func (d *delegateFactory) WorkerDelegate(ctx context.Context, worker *extensions
 		d.decoder,
 
 		d.machineImages,
+		d.machineSettings,
 		seedChartApplier,
 		serverVersion.GitVersion,
 
@@ -113,6 +117,7 @@ type workerDelegate struct {
 	decoder runtime.Decoder
 
 	machineImages    []config.MachineImage
+	machineSettings  config.MachineSettings
 	seedChartApplier gardener.ChartApplier
 	serverVersion    string
 
@@ -129,6 +134,7 @@ func NewWorkerDelegate(
 	decoder runtime.Decoder,
 
 	machineImages []config.MachineImage,
+	machineSettings config.MachineSettings,
 	seedChartApplier gardener.ChartApplier,
 	serverVersion string,
 
@@ -140,6 +146,7 @@ func NewWorkerDelegate(
 		decoder: decoder,
 
 		machineImages:    machineImages,
+		machineSettings:  machineSettings,
 		seedChartApplier: seedChartApplier,
 		serverVersion:    serverVersion,
 
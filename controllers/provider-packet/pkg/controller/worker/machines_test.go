@@ -62,7 +62,7 @@ var _ = Describe("Machines", func() {
 	})
 
 	Context("workerDelegate", func() {
-		workerDelegate := NewWorkerDelegate(nil, nil, nil, nil, "", nil, nil)
+		workerDelegate := NewWorkerDelegate(nil, nil, nil, config.MachineSettings{}, nil, "", nil, nil)
 
 		Describe("#MachineClassKind", func() {
 			It("should return the correct kind of the machine class", func() {
@@ -76,6 +76,12 @@ var _ = Describe("Machines", func() {
 			})
 		})
 
+		Describe("#MachineClassReplacementFields", func() {
+			It("should return the fields whose changes require new machines", func() {
+				Expect(workerDelegate.MachineClassReplacementFields()).To(ConsistOf("OS", "projectID", "billingCycle", "machineType", "facility", "sshKeys", "secret"))
+			})
+		})
+
 		Describe("#GenerateMachineDeployments, #DeployMachineClasses", func() {
 			var (
 				namespace string
@@ -225,11 +231,12 @@ var _ = Describe("Machines", func() {
 				_ = apispacket.AddToScheme(scheme)
 				decoder = serializer.NewCodecFactory(scheme).UniversalDecoder()
 
-				workerDelegate = NewWorkerDelegate(c, decoder, machineImages, chartApplier, "", w, cluster)
+				workerDelegate = NewWorkerDelegate(c, decoder, machineImages, config.MachineSettings{}, chartApplier, "", w, cluster)
 			})
 
 			It("should return the expected machine deployments", func() {
 				expectGetSecretCallToWork(c, packetAPIToken, packetProjectID)
+				expectListMachineDeploymentsCallToWork(c)
 
 				// Test workerDelegate.DeployMachineClasses()
 				var (
@@ -258,8 +265,8 @@ var _ = Describe("Machines", func() {
 					machineClassNamePool1 = fmt.Sprintf("%s-%s", namespace, namePool1)
 					machineClassNamePool2 = fmt.Sprintf("%s-%s", namespace, namePool2)
 
-					machineClassHashPool1 = worker.MachineClassHash(machineClassPool1, shootVersionMajorMinor)
-					machineClassHashPool2 = worker.MachineClassHash(machineClassPool2, shootVersionMajorMinor)
+					machineClassHashPool1 = worker.MachineClassHash(machineClassPool1, shootVersionMajorMinor, workerDelegate.MachineClassReplacementFields()...)
+					machineClassHashPool2 = worker.MachineClassHash(machineClassPool2, shootVersionMajorMinor, workerDelegate.MachineClassReplacementFields()...)
 
 					machineClassWithHashPool1 = fmt.Sprintf("%s-%s", machineClassNamePool1, machineClassHashPool1)
 					machineClassWithHashPool2 = fmt.Sprintf("%s-%s", machineClassNamePool2, machineClassHashPool2)
@@ -287,24 +294,34 @@ var _ = Describe("Machines", func() {
 				Expect(err).NotTo(HaveOccurred())
 
 				// Test workerDelegate.GenerateMachineDeployments()
+				usedMachineImage := &worker.MachineImage{
+					Name:    machineImageName,
+					Version: machineImageVersion,
+					ID:      machineImage,
+				}
+
 				machineDeployments := worker.MachineDeployments{
 					{
 						Name:           machineClassNamePool1,
+						PoolName:       namePool1,
 						ClassName:      machineClassWithHashPool1,
 						SecretName:     machineClassWithHashPool1,
 						Minimum:        minPool1,
 						Maximum:        maxPool1,
 						MaxSurge:       maxSurgePool1,
 						MaxUnavailable: maxUnavailablePool1,
+						MachineImage:   usedMachineImage,
 					},
 					{
 						Name:           machineClassNamePool2,
+						PoolName:       namePool2,
 						ClassName:      machineClassWithHashPool2,
 						SecretName:     machineClassWithHashPool2,
 						Minimum:        minPool2,
 						Maximum:        maxPool2,
 						MaxSurge:       maxSurgePool2,
 						MaxUnavailable: maxUnavailablePool2,
+						MachineImage:   usedMachineImage,
 					},
 				}
 
@@ -325,9 +342,10 @@ var _ = Describe("Machines", func() {
 
 			It("should fail because the version is invalid", func() {
 				expectGetSecretCallToWork(c, packetAPIToken, packetProjectID)
+				expectListMachineDeploymentsCallToWork(c)
 
 				cluster.Shoot.Spec.Kubernetes.Version = "invalid"
-				workerDelegate = NewWorkerDelegate(c, decoder, machineImages, chartApplier, "", w, cluster)
+				workerDelegate = NewWorkerDelegate(c, decoder, machineImages, config.MachineSettings{}, chartApplier, "", w, cluster)
 
 				result, err := workerDelegate.GenerateMachineDeployments(context.TODO())
 				Expect(err).To(HaveOccurred())
@@ -336,10 +354,11 @@ var _ = Describe("Machines", func() {
 
 			It("should fail because the infrastructure status cannot be decoded", func() {
 				expectGetSecretCallToWork(c, packetAPIToken, packetProjectID)
+				expectListMachineDeploymentsCallToWork(c)
 
 				w.Spec.InfrastructureProviderStatus = &runtime.RawExtension{Raw: []byte(`invalid`)}
 
-				workerDelegate = NewWorkerDelegate(c, decoder, machineImages, chartApplier, "", w, cluster)
+				workerDelegate = NewWorkerDelegate(c, decoder, machineImages, config.MachineSettings{}, chartApplier, "", w, cluster)
 
 				result, err := workerDelegate.GenerateMachineDeployments(context.TODO())
 				Expect(err).To(HaveOccurred())
@@ -348,8 +367,9 @@ var _ = Describe("Machines", func() {
 
 			It("should fail because the machine image cannot be found", func() {
 				expectGetSecretCallToWork(c, packetAPIToken, packetProjectID)
+				expectListMachineDeploymentsCallToWork(c)
 
-				workerDelegate = NewWorkerDelegate(c, decoder, nil, chartApplier, "", w, cluster)
+				workerDelegate = NewWorkerDelegate(c, decoder, nil, config.MachineSettings{}, chartApplier, "", w, cluster)
 
 				result, err := workerDelegate.GenerateMachineDeployments(context.TODO())
 				Expect(err).To(HaveOccurred())
@@ -390,3 +410,17 @@ func addNameAndSecretToMachineClass(class map[string]interface{}, packetAPIToken
 	class["name"] = name
 	class["secret"].(map[string]interface{})[packet.APIToken] = packetAPIToken
 }
+
+func expectListMachineDeploymentsCallToWork(c *mockclient.MockClient, classNames ...string) {
+	c.EXPECT().
+		List(context.TODO(), gomock.Any(), gomock.AssignableToTypeOf(&machinev1alpha1.MachineDeploymentList{})).
+		DoAndReturn(func(_ context.Context, _ *client.ListOptions, list *machinev1alpha1.MachineDeploymentList) error {
+			for _, className := range classNames {
+				machineDeployment := machinev1alpha1.MachineDeployment{}
+				machineDeployment.Spec.Template.Spec.Class.Name = className
+				list.Items = append(list.Items, machineDeployment)
+			}
+			return nil
+		}).
+		AnyTimes()
+}
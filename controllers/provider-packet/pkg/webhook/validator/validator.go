@@ -0,0 +1,62 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validator
+
+import (
+	"context"
+
+	"github.com/gardener/gardener-extensions/controllers/provider-packet/pkg/packet"
+	utilvalidation "github.com/gardener/gardener-extensions/pkg/util/validation"
+	"github.com/gardener/gardener-extensions/pkg/webhook/validator"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// NewValidator creates a new validator that validates Packet workers.
+func NewValidator() validator.Validator {
+	return &packetValidator{}
+}
+
+type packetValidator struct{}
+
+// Validate validates the given object.
+func (v *packetValidator) Validate(ctx context.Context, obj runtime.Object) error {
+	worker, ok := obj.(*extensionsv1alpha1.Worker)
+	if !ok || worker.Spec.Type != packet.Type {
+		return nil
+	}
+	return v.validateWorker(worker)
+}
+
+func (v *packetValidator) validateWorker(worker *extensionsv1alpha1.Worker) error {
+	var (
+		allErrs  = field.ErrorList{}
+		poolPath = field.NewPath("spec", "pools")
+	)
+
+	allErrs = append(allErrs, utilvalidation.ValidateWorkerPools(worker.Spec.Pools, false, poolPath)...)
+	// The used machine-controller-manager can neither create interruptible instances nor attach additional data
+	// volumes for this provider. All machines of a pool belong to a single machine deployment, hence there is no
+	// zone distribution either.
+	allErrs = append(allErrs, utilvalidation.ValidateUnsupportedWorkerConfigFields(worker.Spec.Pools, []string{utilvalidation.WorkerConfigFieldSpot, utilvalidation.WorkerConfigFieldDataVolumes, utilvalidation.WorkerConfigFieldZoneDistribution}, poolPath)...)
+
+	if len(allErrs) > 0 {
+		return apierrors.NewInvalid(extensionsv1alpha1.Kind("Worker"), worker.Name, allErrs)
+	}
+	return nil
+}
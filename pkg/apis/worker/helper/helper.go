@@ -0,0 +1,135 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package helper
+
+import (
+	"strings"
+
+	"github.com/gardener/gardener-extensions/pkg/apis/worker"
+	"github.com/gardener/gardener-extensions/pkg/apis/worker/install"
+	"github.com/gardener/gardener-extensions/pkg/apis/worker/v1alpha1"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+)
+
+var (
+	// Scheme is a scheme with the provider-independent worker configuration types.
+	Scheme *runtime.Scheme
+
+	decoder runtime.Decoder
+	codec   runtime.Codec
+)
+
+func init() {
+	Scheme = runtime.NewScheme()
+	utilruntime.Must(install.AddToScheme(Scheme))
+
+	codecs := serializer.NewCodecFactory(Scheme)
+	decoder = codecs.UniversalDecoder()
+	codec = codecs.LegacyCodec(v1alpha1.SchemeGroupVersion)
+}
+
+// AnnotationWorkerConfig is the annotation of the OperatingSystemConfigs of a worker pool that contains the
+// WorkerConfig of the pool, i.e. the same document as the providerConfig of the pool in the Worker. The
+// OperatingSystemConfigs are created before the Worker, hence the settings that affect the user data of the machines
+// have to be passed with them.
+const AnnotationWorkerConfig = "worker.extensions.gardener.cloud/worker-config"
+
+// WorkerConfigFromPool decodes the WorkerConfig from the providerConfig of the given pool. The providerConfig must
+// specify the apiVersion and kind of the WorkerConfig. If the pool has no providerConfig, an empty WorkerConfig is
+// returned.
+func WorkerConfigFromPool(pool extensionsv1alpha1.WorkerPool) (*worker.WorkerConfig, error) {
+	if pool.ProviderConfig == nil {
+		return &worker.WorkerConfig{}, nil
+	}
+
+	config, err := decodeWorkerConfig(pool.ProviderConfig.Raw)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not decode providerConfig of pool %q", pool.Name)
+	}
+	return config, nil
+}
+
+// WorkerConfigFromOperatingSystemConfig decodes the WorkerConfig of the worker pool from the AnnotationWorkerConfig
+// annotation of the given OperatingSystemConfig. If the annotation is not set, an empty WorkerConfig is returned.
+func WorkerConfigFromOperatingSystemConfig(osc *extensionsv1alpha1.OperatingSystemConfig) (*worker.WorkerConfig, error) {
+	config, err := decodeWorkerConfig([]byte(osc.Annotations[AnnotationWorkerConfig]))
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not decode annotation %s of operating system config %q", AnnotationWorkerConfig, osc.Name)
+	}
+	return config, nil
+}
+
+func decodeWorkerConfig(data []byte) (*worker.WorkerConfig, error) {
+	config := &worker.WorkerConfig{}
+	if len(data) == 0 {
+		return config, nil
+	}
+
+	_, gvk, err := decoder.Decode(data, nil, config)
+	if err != nil {
+		return nil, err
+	}
+	if gvk.Version == runtime.APIVersionInternal {
+		return nil, errors.New("the apiVersion and kind of the WorkerConfig must be specified")
+	}
+	return config, nil
+}
+
+// EncodeWorkerStatus encodes the given WorkerStatus into its versioned JSON representation so that it can be
+// persisted in the state of the Worker status.
+func EncodeWorkerStatus(status *worker.WorkerStatus) (string, error) {
+	data, err := runtime.Encode(codec, status)
+	if err != nil {
+		return "", errors.Wrapf(err, "could not encode worker status")
+	}
+	return strings.TrimSpace(string(data)), nil
+}
+
+// WorkerStatusFromWorker decodes the WorkerStatus persisted in the state of the status of the given Worker. If the
+// state is empty or does not contain a WorkerStatus, nil is returned.
+func WorkerStatusFromWorker(w *extensionsv1alpha1.Worker) (*worker.WorkerStatus, error) {
+	status, err := DecodeWorkerStatus(w.Status.State)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not decode state of worker %s/%s", w.Namespace, w.Name)
+	}
+	return status, nil
+}
+
+// DecodeWorkerStatus decodes the given state of a Worker status into a WorkerStatus. If the state is empty or does
+// not contain a WorkerStatus, nil is returned.
+func DecodeWorkerStatus(state string) (*worker.WorkerStatus, error) {
+	if len(state) == 0 {
+		return nil, nil
+	}
+
+	obj, _, err := decoder.Decode([]byte(state), nil, nil)
+	if err != nil {
+		if runtime.IsMissingKind(err) || runtime.IsMissingVersion(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	status, ok := obj.(*worker.WorkerStatus)
+	if !ok {
+		return nil, nil
+	}
+	return status, nil
+}
@@ -0,0 +1,162 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package helper_test
+
+import (
+	"time"
+
+	"github.com/gardener/gardener-extensions/pkg/apis/worker"
+	. "github.com/gardener/gardener-extensions/pkg/apis/worker/helper"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/pointer"
+)
+
+var _ = Describe("Helper", func() {
+	Describe("#WorkerConfigFromPool", func() {
+		It("should return an empty config if the pool has no providerConfig", func() {
+			config, err := WorkerConfigFromPool(extensionsv1alpha1.WorkerPool{Name: "pool"})
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(config).To(Equal(&worker.WorkerConfig{}))
+		})
+
+		It("should decode a versioned providerConfig", func() {
+			config, err := WorkerConfigFromPool(extensionsv1alpha1.WorkerPool{
+				Name: "pool",
+				ProviderConfig: &runtime.RawExtension{
+					Raw: []byte(`{"apiVersion":"worker.extensions.gardener.cloud/v1alpha1","kind":"WorkerConfig","autoscaler":{"priority":2},"machineDrainTimeout":"1h","spot":{"maxPrice":"0.5"}}`),
+				},
+			})
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(config.Autoscaler).To(Equal(&worker.AutoscalerOptions{Priority: pointer.Int32Ptr(2)}))
+			Expect(config.MachineDrainTimeout).To(Equal(&metav1.Duration{Duration: time.Hour}))
+			Expect(config.Spot).To(Equal(&worker.SpotSettings{MaxPrice: pointer.StringPtr("0.5")}))
+		})
+
+		DescribeTable("should fail for an invalid providerConfig",
+			func(raw string) {
+				_, err := WorkerConfigFromPool(extensionsv1alpha1.WorkerPool{
+					Name:           "pool",
+					ProviderConfig: &runtime.RawExtension{Raw: []byte(raw)},
+				})
+
+				Expect(err).To(HaveOccurred())
+			},
+			Entry("missing apiVersion and kind", `{"minReadySeconds":30}`),
+			Entry("malformed JSON", `{"apiVersion":`),
+			Entry("unknown apiVersion", `{"apiVersion":"foo.gardener.cloud/v1alpha1","kind":"WorkerConfig"}`),
+			Entry("wrong field type", `{"apiVersion":"worker.extensions.gardener.cloud/v1alpha1","kind":"WorkerConfig","minReadySeconds":"foo"}`),
+		)
+	})
+
+	Describe("#WorkerConfigFromOperatingSystemConfig", func() {
+		newOperatingSystemConfig := func(annotations map[string]string) *extensionsv1alpha1.OperatingSystemConfig {
+			return &extensionsv1alpha1.OperatingSystemConfig{
+				ObjectMeta: metav1.ObjectMeta{Name: "cloud-config-pool-a1b2c-original", Annotations: annotations},
+			}
+		}
+
+		It("should return an empty config if the annotation is not set", func() {
+			config, err := WorkerConfigFromOperatingSystemConfig(newOperatingSystemConfig(nil))
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(config).To(Equal(&worker.WorkerConfig{}))
+		})
+
+		It("should decode the WorkerConfig of the annotation", func() {
+			config, err := WorkerConfigFromOperatingSystemConfig(newOperatingSystemConfig(map[string]string{
+				AnnotationWorkerConfig: `{"apiVersion":"worker.extensions.gardener.cloud/v1alpha1","kind":"WorkerConfig","dataVolumes":[{"name":"cache","size":"50Gi"}]}`,
+			}))
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(config.DataVolumes).To(Equal([]worker.DataVolume{{Name: "cache", Size: "50Gi"}}))
+		})
+
+		It("should fail if the annotation does not specify the apiVersion and kind", func() {
+			_, err := WorkerConfigFromOperatingSystemConfig(newOperatingSystemConfig(map[string]string{
+				AnnotationWorkerConfig: `{"dataVolumes":[{"name":"cache","size":"50Gi"}]}`,
+			}))
+
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("#EncodeWorkerStatus, #WorkerStatusFromWorker, #DecodeWorkerStatus", func() {
+		var w *extensionsv1alpha1.Worker
+
+		BeforeEach(func() {
+			w = &extensionsv1alpha1.Worker{ObjectMeta: metav1.ObjectMeta{Namespace: "shoot--foo--bar", Name: "worker"}}
+		})
+
+		It("should encode the versioned status and decode it again", func() {
+			status := &worker.WorkerStatus{
+				MachineDeployments: []worker.MachineDeploymentStatus{{
+					Name:                "shoot--foo--bar-pool-z1",
+					PoolName:            "pool",
+					Phase:               "Failed",
+					DesiredReplicas:     2,
+					UnavailableReplicas: 2,
+					LastFailure:         &worker.MachineFailure{MachineName: "machine-1", Operation: "Create", Description: "quota exceeded"},
+				}},
+			}
+
+			state, err := EncodeWorkerStatus(status)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(state).To(HavePrefix(`{"kind":"WorkerStatus","apiVersion":"worker.extensions.gardener.cloud/v1alpha1",`))
+
+			w.Status.State = state
+			decoded, err := WorkerStatusFromWorker(w)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(decoded.MachineDeployments).To(Equal(status.MachineDeployments))
+		})
+
+		It("should keep the machine state of a migrated worker", func() {
+			status := &worker.WorkerStatus{
+				MachineState: &runtime.RawExtension{Raw: []byte(`{"machines":[{"metadata":{"name":"machine-1"}}]}`)},
+			}
+
+			state, err := EncodeWorkerStatus(status)
+			Expect(err).NotTo(HaveOccurred())
+
+			decoded, err := DecodeWorkerStatus(state)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(decoded.MachineState.Raw).To(MatchJSON(status.MachineState.Raw))
+		})
+
+		It("should return nil if the state is empty", func() {
+			Expect(WorkerStatusFromWorker(w)).To(BeNil())
+		})
+
+		It("should return nil if the state does not contain a worker status", func() {
+			w.Status.State = `{"machineDeployments":[{"metadata":{"name":"foo"}}]}`
+
+			Expect(WorkerStatusFromWorker(w)).To(BeNil())
+		})
+
+		It("should fail if the state cannot be decoded", func() {
+			w.Status.State = `{"kind":"WorkerStatus","apiVersion":"worker.extensions.gardener.cloud/v1alpha1","machineDeployments":"foo"}`
+
+			_, err := WorkerStatusFromWorker(w)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
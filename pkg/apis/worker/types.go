@@ -0,0 +1,170 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package worker
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// WorkerConfig contains the provider-independent configuration of a worker pool. It is read from the providerConfig
+// of the pool.
+type WorkerConfig struct {
+	metav1.TypeMeta
+
+	// Autoscaler contains hints for the cluster-autoscaler about the machine deployments of the pool.
+	Autoscaler *AutoscalerOptions
+
+	// MachineDrainTimeout is the maximum time to drain a machine before it is deleted.
+	MachineDrainTimeout *metav1.Duration
+	// MachineHealthTimeout is the maximum time a machine may be unhealthy before it is replaced.
+	MachineHealthTimeout *metav1.Duration
+	// MachineCreationTimeout is the maximum time to wait until a new machine has joined the cluster.
+	MachineCreationTimeout *metav1.Duration
+	// MaxEvictRetries is the maximum number of times the eviction of a pod is retried while draining a machine.
+	MaxEvictRetries *int32
+
+	// MachineDeploymentsTimeout is the maximum time to wait until the machine deployments of the pool are available.
+	MachineDeploymentsTimeout *metav1.Duration
+	// MachineResourcesDeletionTimeout is the maximum time to wait until the machine resources of the pool are deleted.
+	MachineResourcesDeletionTimeout *metav1.Duration
+	// MinReadySeconds is the minimum number of seconds a new machine of the pool must be ready before it is
+	// considered available.
+	MinReadySeconds *int32
+
+	// Spot contains the settings for running the machines of the pool on interruptible capacity, i.e. spot,
+	// preemptible or low-priority instances. If not set, regular instances are used.
+	Spot *SpotSettings
+
+	// DataVolumes are additional volumes that are attached to the machines of the pool besides the root disk.
+	DataVolumes []DataVolume
+
+	// ZoneDistribution contains the settings for distributing the machines of the pool to its zones.
+	ZoneDistribution []ZoneSettings
+}
+
+// AutoscalerOptions contains hints for the cluster-autoscaler about the machine deployments of a worker pool.
+type AutoscalerOptions struct {
+	// ScaleDownDisabled indicates whether the cluster-autoscaler must not scale down the nodes of the pool.
+	ScaleDownDisabled bool
+	// Priority is the weight of the machine deployments of the pool for the priority expander of the
+	// cluster-autoscaler.
+	Priority *int32
+}
+
+// SpotSettings contains the settings for worker pools whose machines run on interruptible capacity.
+type SpotSettings struct {
+	// MaxPrice is the maximum price that is paid for an instance. Its format and whether it is supported depend on
+	// the provider.
+	MaxPrice *string
+}
+
+// DataVolume is an additional volume that is attached to the machines of a worker pool besides the root disk.
+type DataVolume struct {
+	// Name is the name of the volume. It must be unique within the worker pool.
+	Name string
+	// Size is the size of the volume, e.g. "50Gi".
+	Size string
+	// Type is the provider specific type of the volume. If not set, the type of the root disk is used.
+	Type *string
+	// Encrypted indicates whether the volume is encrypted. Whether it can be disabled depends on the provider.
+	Encrypted *bool
+	// MountPath is the absolute path at which the volume is formatted and mounted on the machines. If not set, the
+	// volume is only attached as a raw block device, e.g. for local storage provisioners.
+	MountPath *string
+}
+
+// ZoneSettings contains the settings for distributing the machines of a worker pool to one of its zones.
+type ZoneSettings struct {
+	// Name is the name of the zone.
+	Name string
+	// Weight is the weight of the zone relative to the other zones of the pool. Defaults to 1.
+	Weight *int32
+	// Minimum overrides the minimum number of machines in the zone.
+	Minimum *int32
+	// Maximum overrides the maximum number of machines in the zone.
+	Maximum *int32
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// WorkerStatus contains the provider-independent status of a Worker. It is persisted in the state of the Worker
+// status while the machine deployments are rolled out, and carries the machine state of a migrated Worker until
+// it has been restored.
+type WorkerStatus struct {
+	metav1.TypeMeta
+
+	// MachineDeployments are the rollout statuses of the machine deployments of the Worker.
+	MachineDeployments []MachineDeploymentStatus
+	// MachineImages are the machine images used by the worker pools, one per pool and zone.
+	MachineImages []MachineImageStatus
+	// MachineState is the state of the machine objects of the Worker that has been exported when the Worker was
+	// migrated. It is kept until the Worker has been restored.
+	MachineState *runtime.RawExtension
+}
+
+// MachineDeploymentStatus is the rollout status of a machine deployment.
+type MachineDeploymentStatus struct {
+	// Name is the name of the machine deployment.
+	Name string
+	// PoolName is the name of the worker pool the machine deployment belongs to.
+	PoolName string
+	// ClassName is the name of the machine class used by the machine deployment.
+	ClassName string
+	// Phase is the rollout phase of the machine deployment.
+	Phase string
+	// DesiredReplicas is the number of desired machines.
+	DesiredReplicas int32
+	// UpdatedReplicas is the number of machines using the current machine class.
+	UpdatedReplicas int32
+	// AvailableReplicas is the number of available machines.
+	AvailableReplicas int32
+	// UnavailableReplicas is the number of machines that are not yet available.
+	UnavailableReplicas int32
+	// LastFailure is the most recent failure of a machine of the machine deployment.
+	LastFailure *MachineFailure
+}
+
+// MachineFailure is a failed operation of a machine.
+type MachineFailure struct {
+	// MachineName is the name of the failed machine.
+	MachineName string
+	// Operation is the type of the failed operation, e.g. Create.
+	Operation string
+	// Description describes the failure.
+	Description string
+	// LastUpdateTime is the time the failure was last observed.
+	LastUpdateTime metav1.Time
+}
+
+// MachineImageStatus is the machine image used by the machines of a worker pool in a zone.
+type MachineImageStatus struct {
+	// PoolName is the name of the worker pool.
+	PoolName string
+	// Zone is the zone of the worker pool. It is empty if the machines of the pool are not distributed over zones.
+	Zone string
+	// Name is the logical name of the machine image.
+	Name string
+	// Version is the logical version of the machine image.
+	Version string
+	// ID is the provider-specific identifier the machine image has been resolved to, e.g. an AMI.
+	ID string
+	// DeprecationDate is the date from which on the machine image is considered deprecated.
+	DeprecationDate *metav1.Time
+	// ExpirationDate is the date after which the machine image must no longer be used.
+	ExpirationDate *metav1.Time
+}
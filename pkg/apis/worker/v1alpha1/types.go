@@ -0,0 +1,197 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// WorkerConfig contains the provider-independent configuration of a worker pool. It is read from the providerConfig
+// of the pool.
+type WorkerConfig struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// Autoscaler contains hints for the cluster-autoscaler about the machine deployments of the pool.
+	// +optional
+	Autoscaler *AutoscalerOptions `json:"autoscaler,omitempty"`
+
+	// MachineDrainTimeout is the maximum time to drain a machine before it is deleted.
+	// +optional
+	MachineDrainTimeout *metav1.Duration `json:"machineDrainTimeout,omitempty"`
+	// MachineHealthTimeout is the maximum time a machine may be unhealthy before it is replaced.
+	// +optional
+	MachineHealthTimeout *metav1.Duration `json:"machineHealthTimeout,omitempty"`
+	// MachineCreationTimeout is the maximum time to wait until a new machine has joined the cluster.
+	// +optional
+	MachineCreationTimeout *metav1.Duration `json:"machineCreationTimeout,omitempty"`
+	// MaxEvictRetries is the maximum number of times the eviction of a pod is retried while draining a machine.
+	// +optional
+	MaxEvictRetries *int32 `json:"maxEvictRetries,omitempty"`
+
+	// MachineDeploymentsTimeout is the maximum time to wait until the machine deployments of the pool are available.
+	// +optional
+	MachineDeploymentsTimeout *metav1.Duration `json:"machineDeploymentsTimeout,omitempty"`
+	// MachineResourcesDeletionTimeout is the maximum time to wait until the machine resources of the pool are deleted.
+	// +optional
+	MachineResourcesDeletionTimeout *metav1.Duration `json:"machineResourcesDeletionTimeout,omitempty"`
+	// MinReadySeconds is the minimum number of seconds a new machine of the pool must be ready before it is
+	// considered available.
+	// +optional
+	MinReadySeconds *int32 `json:"minReadySeconds,omitempty"`
+
+	// Spot contains the settings for running the machines of the pool on interruptible capacity, i.e. spot,
+	// preemptible or low-priority instances. If not set, regular instances are used.
+	// +optional
+	Spot *SpotSettings `json:"spot,omitempty"`
+
+	// DataVolumes are additional volumes that are attached to the machines of the pool besides the root disk.
+	// +optional
+	DataVolumes []DataVolume `json:"dataVolumes,omitempty"`
+
+	// ZoneDistribution contains the settings for distributing the machines of the pool to its zones.
+	// +optional
+	ZoneDistribution []ZoneSettings `json:"zoneDistribution,omitempty"`
+}
+
+// AutoscalerOptions contains hints for the cluster-autoscaler about the machine deployments of a worker pool.
+type AutoscalerOptions struct {
+	// ScaleDownDisabled indicates whether the cluster-autoscaler must not scale down the nodes of the pool.
+	// +optional
+	ScaleDownDisabled bool `json:"scaleDownDisabled,omitempty"`
+	// Priority is the weight of the machine deployments of the pool for the priority expander of the
+	// cluster-autoscaler.
+	// +optional
+	Priority *int32 `json:"priority,omitempty"`
+}
+
+// SpotSettings contains the settings for worker pools whose machines run on interruptible capacity.
+type SpotSettings struct {
+	// MaxPrice is the maximum price that is paid for an instance. Its format and whether it is supported depend on
+	// the provider.
+	// +optional
+	MaxPrice *string `json:"maxPrice,omitempty"`
+}
+
+// DataVolume is an additional volume that is attached to the machines of a worker pool besides the root disk.
+type DataVolume struct {
+	// Name is the name of the volume. It must be unique within the worker pool.
+	Name string `json:"name"`
+	// Size is the size of the volume, e.g. "50Gi".
+	Size string `json:"size"`
+	// Type is the provider specific type of the volume. If not set, the type of the root disk is used.
+	// +optional
+	Type *string `json:"type,omitempty"`
+	// Encrypted indicates whether the volume is encrypted. Whether it can be disabled depends on the provider.
+	// +optional
+	Encrypted *bool `json:"encrypted,omitempty"`
+	// MountPath is the absolute path at which the volume is formatted and mounted on the machines. If not set, the
+	// volume is only attached as a raw block device, e.g. for local storage provisioners.
+	// +optional
+	MountPath *string `json:"mountPath,omitempty"`
+}
+
+// ZoneSettings contains the settings for distributing the machines of a worker pool to one of its zones.
+type ZoneSettings struct {
+	// Name is the name of the zone.
+	Name string `json:"name"`
+	// Weight is the weight of the zone relative to the other zones of the pool. Defaults to 1.
+	// +optional
+	Weight *int32 `json:"weight,omitempty"`
+	// Minimum overrides the minimum number of machines in the zone.
+	// +optional
+	Minimum *int32 `json:"minimum,omitempty"`
+	// Maximum overrides the maximum number of machines in the zone.
+	// +optional
+	Maximum *int32 `json:"maximum,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// WorkerStatus contains the provider-independent status of a Worker. It is persisted in the state of the Worker
+// status while the machine deployments are rolled out, and carries the machine state of a migrated Worker until
+// it has been restored.
+type WorkerStatus struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// MachineDeployments are the rollout statuses of the machine deployments of the Worker.
+	// +optional
+	MachineDeployments []MachineDeploymentStatus `json:"machineDeployments,omitempty"`
+	// MachineImages are the machine images used by the worker pools, one per pool and zone.
+	// +optional
+	MachineImages []MachineImageStatus `json:"machineImages,omitempty"`
+	// MachineState is the state of the machine objects of the Worker that has been exported when the Worker was
+	// migrated. It is kept until the Worker has been restored.
+	// +optional
+	MachineState *runtime.RawExtension `json:"machineState,omitempty"`
+}
+
+// MachineDeploymentStatus is the rollout status of a machine deployment.
+type MachineDeploymentStatus struct {
+	// Name is the name of the machine deployment.
+	Name string `json:"name"`
+	// PoolName is the name of the worker pool the machine deployment belongs to.
+	PoolName string `json:"poolName"`
+	// ClassName is the name of the machine class used by the machine deployment.
+	ClassName string `json:"className"`
+	// Phase is the rollout phase of the machine deployment.
+	Phase string `json:"phase"`
+	// DesiredReplicas is the number of desired machines.
+	DesiredReplicas int32 `json:"desiredReplicas"`
+	// UpdatedReplicas is the number of machines using the current machine class.
+	UpdatedReplicas int32 `json:"updatedReplicas"`
+	// AvailableReplicas is the number of available machines.
+	AvailableReplicas int32 `json:"availableReplicas"`
+	// UnavailableReplicas is the number of machines that are not yet available.
+	UnavailableReplicas int32 `json:"unavailableReplicas"`
+	// LastFailure is the most recent failure of a machine of the machine deployment.
+	// +optional
+	LastFailure *MachineFailure `json:"lastFailure,omitempty"`
+}
+
+// MachineFailure is a failed operation of a machine.
+type MachineFailure struct {
+	// MachineName is the name of the failed machine.
+	MachineName string `json:"machineName"`
+	// Operation is the type of the failed operation, e.g. Create.
+	Operation string `json:"operation"`
+	// Description describes the failure.
+	Description string `json:"description"`
+	// LastUpdateTime is the time the failure was last observed.
+	LastUpdateTime metav1.Time `json:"lastUpdateTime"`
+}
+
+// MachineImageStatus is the machine image used by the machines of a worker pool in a zone.
+type MachineImageStatus struct {
+	// PoolName is the name of the worker pool.
+	PoolName string `json:"poolName"`
+	// Zone is the zone of the worker pool. It is empty if the machines of the pool are not distributed over zones.
+	// +optional
+	Zone string `json:"zone,omitempty"`
+	// Name is the logical name of the machine image.
+	Name string `json:"name"`
+	// Version is the logical version of the machine image.
+	Version string `json:"version"`
+	// ID is the provider-specific identifier the machine image has been resolved to, e.g. an AMI.
+	ID string `json:"id"`
+	// DeprecationDate is the date from which on the machine image is considered deprecated.
+	// +optional
+	DeprecationDate *metav1.Time `json:"deprecationDate,omitempty"`
+	// ExpirationDate is the date after which the machine image must no longer be used.
+	// +optional
+	ExpirationDate *metav1.Time `json:"expirationDate,omitempty"`
+}
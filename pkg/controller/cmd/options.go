@@ -33,11 +33,17 @@ const (
 	LeaderElectionIDFlag = "leader-election-id"
 	// LeaderElectionNamespaceFlag is the name of the command line flag to specify the leader election namespace.
 	LeaderElectionNamespaceFlag = "leader-election-namespace"
+	// MetricsBindAddressFlag is the name of the command line flag to specify the address the metrics endpoint binds to.
+	MetricsBindAddressFlag = "metrics-bind-address"
 
 	// MaxConcurrentReconcilesFlag is the name of the command line flag to specify the maximum number of
 	// concurrent reconciliations a controller can do.
 	MaxConcurrentReconcilesFlag = "max-concurrent-reconciles"
 
+	// IgnoreOperationAnnotationFlag is the name of the command line flag to specify whether the operation annotation
+	// is ignored or not.
+	IgnoreOperationAnnotationFlag = "ignore-operation-annotation"
+
 	// KubeconfigFlag is the name of the command line flag to specify a kubeconfig used to retrieve
 	// a rest.Config for a manager.Manager.
 	KubeconfigFlag = clientcmd.RecommendedConfigPathFlag
@@ -142,6 +148,8 @@ type ManagerOptions struct {
 	LeaderElectionID string
 	// LeaderElectionNamespace is the namespace to do leader election in.
 	LeaderElectionNamespace string
+	// MetricsBindAddress is the TCP address that the metrics endpoint binds to.
+	MetricsBindAddress string
 
 	config *ManagerConfig
 }
@@ -151,11 +159,12 @@ func (m *ManagerOptions) AddFlags(fs *pflag.FlagSet) {
 	fs.BoolVar(&m.LeaderElection, LeaderElectionFlag, m.LeaderElection, "Whether to use leader election or not when running this controller manager.")
 	fs.StringVar(&m.LeaderElectionID, LeaderElectionIDFlag, m.LeaderElectionID, "The leader election id to use.")
 	fs.StringVar(&m.LeaderElectionNamespace, LeaderElectionNamespaceFlag, m.LeaderElectionNamespace, "The namespace to do leader election in.")
+	fs.StringVar(&m.MetricsBindAddress, MetricsBindAddressFlag, m.MetricsBindAddress, "The TCP address that the metrics endpoint binds to, e.g. ':8080'. Metrics are disabled if '0'. An empty address binds to the default ':8080'.")
 }
 
 // Complete implements Completer.Complete.
 func (m *ManagerOptions) Complete() error {
-	m.config = &ManagerConfig{m.LeaderElection, m.LeaderElectionID, m.LeaderElectionNamespace}
+	m.config = &ManagerConfig{m.LeaderElection, m.LeaderElectionID, m.LeaderElectionNamespace, m.MetricsBindAddress}
 	return nil
 }
 
@@ -172,6 +181,8 @@ type ManagerConfig struct {
 	LeaderElectionID string
 	// LeaderElectionNamespace is the namespace to do leader election in.
 	LeaderElectionNamespace string
+	// MetricsBindAddress is the TCP address that the metrics endpoint binds to.
+	MetricsBindAddress string
 }
 
 // Apply sets the values of this ManagerConfig in the given manager.Options.
@@ -179,6 +190,7 @@ func (c *ManagerConfig) Apply(opts *manager.Options) {
 	opts.LeaderElection = c.LeaderElection
 	opts.LeaderElectionID = c.LeaderElectionID
 	opts.LeaderElectionNamespace = c.LeaderElectionNamespace
+	opts.MetricsBindAddress = c.MetricsBindAddress
 }
 
 // Options initializes empty manager.Options, applies the set values and returns it.
@@ -230,6 +242,42 @@ func (c *ControllerConfig) Options() controller.Options {
 	return opts
 }
 
+// ReconcilerOptions are command line options that can be set for a reconciler.
+type ReconcilerOptions struct {
+	// IgnoreOperationAnnotation defines whether to ignore the operation annotation or not.
+	IgnoreOperationAnnotation bool
+
+	config *ReconcilerConfig
+}
+
+// AddFlags implements Flagger.AddFlags.
+func (c *ReconcilerOptions) AddFlags(fs *pflag.FlagSet) {
+	fs.BoolVar(&c.IgnoreOperationAnnotation, IgnoreOperationAnnotationFlag, c.IgnoreOperationAnnotation, "Ignore the operation annotation or not.")
+}
+
+// Complete implements Completer.Complete.
+func (c *ReconcilerOptions) Complete() error {
+	c.config = &ReconcilerConfig{c.IgnoreOperationAnnotation}
+	return nil
+}
+
+// Completed returns the completed ReconcilerConfig. Only call this if `Complete` was successful.
+func (c *ReconcilerOptions) Completed() *ReconcilerConfig {
+	return c.config
+}
+
+// ReconcilerConfig is a completed reconciler configuration.
+type ReconcilerConfig struct {
+	// IgnoreOperationAnnotation defines whether to ignore the operation annotation or not.
+	IgnoreOperationAnnotation bool
+}
+
+// Apply sets the values of this ReconcilerConfig in the given boolean that controls whether
+// the operation annotation is ignored.
+func (c *ReconcilerConfig) Apply(ignore *bool) {
+	*ignore = c.IgnoreOperationAnnotation
+}
+
 // RESTOptions are command line options that can be set for rest.Config.
 type RESTOptions struct {
 	// Kubeconfig is the path to a kubeconfig.
@@ -200,12 +200,14 @@ var _ = Describe("Options", func() {
 			name                    = "foo"
 			leaderElectionID        = "id"
 			leaderElectionNamespace = "namespace"
+			metricsBindAddress      = ":8080"
 		)
 		command := test.NewCommandBuilder(name).
 			Flags(
 				test.BoolFlag(LeaderElectionFlag, true),
 				test.StringFlag(LeaderElectionIDFlag, leaderElectionID),
 				test.StringFlag(LeaderElectionNamespaceFlag, leaderElectionNamespace),
+				test.StringFlag(MetricsBindAddressFlag, metricsBindAddress),
 			).
 			Command().
 			Slice()
@@ -222,6 +224,7 @@ var _ = Describe("Options", func() {
 					LeaderElection:          true,
 					LeaderElectionID:        leaderElectionID,
 					LeaderElectionNamespace: leaderElectionNamespace,
+					MetricsBindAddress:      metricsBindAddress,
 				}))
 			})
 		})
@@ -251,6 +254,7 @@ var _ = Describe("Options", func() {
 					LeaderElection:          true,
 					LeaderElectionID:        leaderElectionID,
 					LeaderElectionNamespace: leaderElectionNamespace,
+					MetricsBindAddress:      metricsBindAddress,
 				}))
 			})
 		})
@@ -308,6 +312,55 @@ var _ = Describe("Options", func() {
 		})
 	})
 
+	Context("ReconcilerOptions", func() {
+		const (
+			name                      = "foo"
+			ignoreOperationAnnotation = true
+		)
+		command := test.NewCommandBuilder(name).
+			Flags(test.BoolFlag(IgnoreOperationAnnotationFlag, ignoreOperationAnnotation)).
+			Command().
+			Slice()
+
+		Describe("#AddFlags", func() {
+			It("should add all flags", func() {
+				fs := pflag.NewFlagSet(name, pflag.ExitOnError)
+				opts := ReconcilerOptions{}
+
+				opts.AddFlags(fs)
+
+				Expect(fs.Parse(command)).NotTo(HaveOccurred())
+				Expect(opts).To(Equal(ReconcilerOptions{
+					IgnoreOperationAnnotation: ignoreOperationAnnotation,
+				}))
+			})
+		})
+
+		Describe("#Completed", func() {
+			It("should yield a correct ReconcilerConfig after completion", func() {
+				fs := pflag.NewFlagSet(name, pflag.ExitOnError)
+				opts := ReconcilerOptions{}
+
+				opts.AddFlags(fs)
+
+				Expect(fs.Parse(command)).NotTo(HaveOccurred())
+				Expect(opts.Complete()).NotTo(HaveOccurred())
+				Expect(opts.Completed()).To(Equal(&ReconcilerConfig{
+					IgnoreOperationAnnotation: ignoreOperationAnnotation,
+				}))
+			})
+		})
+
+		Describe("#Apply", func() {
+			It("should apply the config to the given boolean", func() {
+				var ignore bool
+				(&ReconcilerConfig{IgnoreOperationAnnotation: true}).Apply(&ignore)
+
+				Expect(ignore).To(BeTrue())
+			})
+		})
+	})
+
 	Context("RESTOptions", func() {
 		const (
 			name       = "foo"
@@ -498,6 +551,7 @@ var _ = Describe("Options", func() {
 		const (
 			leaderElectionID        = "id"
 			leaderElectionNamespace = "namespace"
+			metricsBindAddress      = ":8080"
 		)
 
 		Describe("#Apply", func() {
@@ -506,6 +560,7 @@ var _ = Describe("Options", func() {
 					LeaderElection:          true,
 					LeaderElectionID:        leaderElectionID,
 					LeaderElectionNamespace: leaderElectionNamespace,
+					MetricsBindAddress:      metricsBindAddress,
 				}
 
 				opts := manager.Options{}
@@ -515,6 +570,7 @@ var _ = Describe("Options", func() {
 					LeaderElection:          true,
 					LeaderElectionID:        leaderElectionID,
 					LeaderElectionNamespace: leaderElectionNamespace,
+					MetricsBindAddress:      metricsBindAddress,
 				}))
 			})
 		})
@@ -525,6 +581,7 @@ var _ = Describe("Options", func() {
 					LeaderElection:          true,
 					LeaderElectionID:        leaderElectionID,
 					LeaderElectionNamespace: leaderElectionNamespace,
+					MetricsBindAddress:      metricsBindAddress,
 				}
 
 				opts := cfg.Options()
@@ -533,6 +590,7 @@ var _ = Describe("Options", func() {
 					LeaderElection:          true,
 					LeaderElectionID:        leaderElectionID,
 					LeaderElectionNamespace: leaderElectionNamespace,
+					MetricsBindAddress:      metricsBindAddress,
 				}))
 			})
 		})
@@ -0,0 +1,110 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"sync"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	gardencorev1alpha1helper "github.com/gardener/gardener/pkg/apis/core/v1alpha1/helper"
+)
+
+const (
+	// ConditionTypeInfrastructureReady is a condition type reported by infrastructure actuators indicating whether
+	// the infrastructure has been successfully created or updated.
+	ConditionTypeInfrastructureReady gardencorev1alpha1.ConditionType = "InfrastructureReady"
+	// ConditionTypeMachineDeploymentsHealthy is a condition type reported by worker actuators indicating whether
+	// all machine deployments are available.
+	ConditionTypeMachineDeploymentsHealthy gardencorev1alpha1.ConditionType = "MachineDeploymentsHealthy"
+	// ConditionTypeControlPlaneComponentsReady is a condition type reported by control plane actuators indicating
+	// whether all additional control plane components have been deployed.
+	ConditionTypeControlPlaneComponentsReady gardencorev1alpha1.ConditionType = "ControlPlaneComponentsReady"
+)
+
+// ConditionRecorder collects conditions reported by an actuator during a single reconciliation.
+// The recorded conditions are merged into the status of the extension resource by the generic reconcilers.
+type ConditionRecorder struct {
+	lock     sync.Mutex
+	reported []gardencorev1alpha1.Condition
+}
+
+// NewConditionRecorder creates a new, empty ConditionRecorder.
+func NewConditionRecorder() *ConditionRecorder {
+	return &ConditionRecorder{}
+}
+
+// Set records the condition with the given type, status, reason and message. A previously recorded
+// condition with the same type is superseded.
+func (r *ConditionRecorder) Set(conditionType gardencorev1alpha1.ConditionType, status gardencorev1alpha1.ConditionStatus, reason, message string) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	r.reported = gardencorev1alpha1helper.MergeConditions(r.reported, gardencorev1alpha1.Condition{
+		Type:    conditionType,
+		Status:  status,
+		Reason:  reason,
+		Message: message,
+	})
+}
+
+// Merge merges all recorded conditions into the given conditions. The transition time of a condition is
+// only updated if its status has changed compared to the given conditions.
+func (r *ConditionRecorder) Merge(conditions []gardencorev1alpha1.Condition) []gardencorev1alpha1.Condition {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	updated := make([]gardencorev1alpha1.Condition, 0, len(r.reported))
+	for _, reported := range r.reported {
+		existing := gardencorev1alpha1helper.GetCondition(conditions, reported.Type)
+		if existing == nil {
+			initialized := gardencorev1alpha1helper.InitCondition(reported.Type)
+			existing = &initialized
+		}
+		updated = append(updated, gardencorev1alpha1helper.UpdatedCondition(*existing, reported.Status, reported.Reason, reported.Message))
+	}
+
+	return gardencorev1alpha1helper.MergeConditions(conditions, updated...)
+}
+
+type conditionRecorderContextKey struct{}
+
+// WithConditionRecorder returns a copy of the given context that carries the given ConditionRecorder.
+func WithConditionRecorder(ctx context.Context, recorder *ConditionRecorder) context.Context {
+	return context.WithValue(ctx, conditionRecorderContextKey{}, recorder)
+}
+
+// ConditionRecorderFromContext returns the ConditionRecorder carried by the given context, or nil if there is none.
+func ConditionRecorderFromContext(ctx context.Context) *ConditionRecorder {
+	recorder, _ := ctx.Value(conditionRecorderContextKey{}).(*ConditionRecorder)
+	return recorder
+}
+
+// SetCondition records the given condition in the ConditionRecorder carried by the given context.
+// It does nothing if the context does not carry a ConditionRecorder.
+func SetCondition(ctx context.Context, conditionType gardencorev1alpha1.ConditionType, status gardencorev1alpha1.ConditionStatus, reason, message string) {
+	if recorder := ConditionRecorderFromContext(ctx); recorder != nil {
+		recorder.Set(conditionType, status, reason, message)
+	}
+}
+
+// MergeRecordedConditions merges the conditions recorded in the ConditionRecorder carried by the given context into
+// the given conditions. The given conditions are returned unchanged if the context does not carry a ConditionRecorder.
+func MergeRecordedConditions(ctx context.Context, conditions []gardencorev1alpha1.Condition) []gardencorev1alpha1.Condition {
+	if recorder := ConditionRecorderFromContext(ctx); recorder != nil {
+		return recorder.Merge(conditions)
+	}
+	return conditions
+}
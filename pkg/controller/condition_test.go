@@ -0,0 +1,102 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/gardener/gardener-extensions/pkg/controller"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("Condition", func() {
+	var (
+		ctx            context.Context
+		recorder       *controller.ConditionRecorder
+		past                                            = metav1.NewTime(time.Unix(10, 0))
+		otherType      gardencorev1alpha1.ConditionType = "Other"
+		otherCondition                                  = gardencorev1alpha1.Condition{Type: otherType, Status: gardencorev1alpha1.ConditionTrue}
+	)
+
+	BeforeEach(func() {
+		recorder = controller.NewConditionRecorder()
+		ctx = controller.WithConditionRecorder(context.TODO(), recorder)
+	})
+
+	Describe("#ConditionRecorderFromContext", func() {
+		It("should return the recorder of the context", func() {
+			Expect(controller.ConditionRecorderFromContext(ctx)).To(BeIdenticalTo(recorder))
+		})
+
+		It("should return nil if the context carries no recorder", func() {
+			Expect(controller.ConditionRecorderFromContext(context.TODO())).To(BeNil())
+		})
+	})
+
+	Describe("#MergeRecordedConditions", func() {
+		It("should return the conditions unchanged if no recorder is present", func() {
+			conditions := []gardencorev1alpha1.Condition{otherCondition}
+			controller.SetCondition(context.TODO(), controller.ConditionTypeInfrastructureReady, gardencorev1alpha1.ConditionTrue, "Reason", "message")
+
+			Expect(controller.MergeRecordedConditions(context.TODO(), conditions)).To(Equal(conditions))
+		})
+
+		It("should add newly reported conditions and keep the others", func() {
+			controller.SetCondition(ctx, controller.ConditionTypeInfrastructureReady, gardencorev1alpha1.ConditionFalse, "Reason1", "message1")
+			controller.SetCondition(ctx, controller.ConditionTypeInfrastructureReady, gardencorev1alpha1.ConditionTrue, "Reason2", "message2")
+
+			conditions := controller.MergeRecordedConditions(ctx, []gardencorev1alpha1.Condition{otherCondition})
+			Expect(conditions).To(HaveLen(2))
+			Expect(conditions[0]).To(Equal(otherCondition))
+			Expect(conditions[1].Type).To(Equal(controller.ConditionTypeInfrastructureReady))
+			Expect(conditions[1].Status).To(Equal(gardencorev1alpha1.ConditionTrue))
+			Expect(conditions[1].Reason).To(Equal("Reason2"))
+			Expect(conditions[1].Message).To(Equal("message2"))
+		})
+
+		It("should keep the transition time if the status did not change", func() {
+			existing := []gardencorev1alpha1.Condition{{
+				Type:               controller.ConditionTypeMachineDeploymentsHealthy,
+				Status:             gardencorev1alpha1.ConditionTrue,
+				LastTransitionTime: past,
+			}}
+			controller.SetCondition(ctx, controller.ConditionTypeMachineDeploymentsHealthy, gardencorev1alpha1.ConditionTrue, "Healthy", "message")
+
+			conditions := controller.MergeRecordedConditions(ctx, existing)
+			Expect(conditions).To(HaveLen(1))
+			Expect(conditions[0].LastTransitionTime).To(Equal(past))
+			Expect(conditions[0].Reason).To(Equal("Healthy"))
+		})
+
+		It("should update the transition time if the status changed", func() {
+			existing := []gardencorev1alpha1.Condition{{
+				Type:               controller.ConditionTypeMachineDeploymentsHealthy,
+				Status:             gardencorev1alpha1.ConditionTrue,
+				LastTransitionTime: past,
+			}}
+			controller.SetCondition(ctx, controller.ConditionTypeMachineDeploymentsHealthy, gardencorev1alpha1.ConditionFalse, "Unhealthy", "message")
+
+			conditions := controller.MergeRecordedConditions(ctx, existing)
+			Expect(conditions).To(HaveLen(1))
+			Expect(conditions[0].Status).To(Equal(gardencorev1alpha1.ConditionFalse))
+			Expect(conditions[0].LastTransitionTime).NotTo(Equal(past))
+		})
+	})
+})
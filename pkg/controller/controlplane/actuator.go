@@ -20,12 +20,49 @@ import (
 	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
 
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/runtime/inject"
 )
 
 // Actuator acts upon ControlPlane resources.
 type Actuator interface {
-	// Reconcile reconciles the ControlPlane.
-	Reconcile(context.Context, *extensionsv1alpha1.ControlPlane, *extensionscontroller.Cluster) error
+	// Reconcile reconciles the ControlPlane. It returns true if the ControlPlane has been reconciled successfully
+	// but must be reconciled again later, e.g. because some components could not be deployed yet.
+	Reconcile(context.Context, *extensionsv1alpha1.ControlPlane, *extensionscontroller.Cluster) (bool, error)
 	// Delete deletes the ControlPlane.
 	Delete(context.Context, *extensionsv1alpha1.ControlPlane, *extensionscontroller.Cluster) error
 }
+
+type operationAnnotationWrapper struct {
+	Actuator
+	client client.Client
+}
+
+// OperationAnnotationWrapper is a wrapper for an actuator that, after a successful reconcile,
+// removes the Gardener operation annotation.
+//
+// This is useful in conjunction with the OperationAnnotationPredicate.
+func OperationAnnotationWrapper(actuator Actuator) Actuator {
+	return &operationAnnotationWrapper{Actuator: actuator}
+}
+
+// InjectClient implements inject.Client.
+func (o *operationAnnotationWrapper) InjectClient(client client.Client) error {
+	o.client = client
+	return nil
+}
+
+// InjectFunc implements inject.Func.
+func (o *operationAnnotationWrapper) InjectFunc(f inject.Func) error {
+	return f(o.Actuator)
+}
+
+// Reconcile implements Actuator.
+func (o *operationAnnotationWrapper) Reconcile(ctx context.Context, cp *extensionsv1alpha1.ControlPlane, cluster *extensionscontroller.Cluster) (bool, error) {
+	if err := extensionscontroller.RemoveOperationAnnotation(ctx, o.client, cp); err != nil {
+		return false, err
+	}
+
+	return o.Actuator.Reconcile(ctx, cp, cluster)
+}
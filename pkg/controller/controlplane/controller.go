@@ -16,6 +16,7 @@ package controlplane
 
 import (
 	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
+	controllererror "github.com/gardener/gardener-extensions/pkg/controller/error"
 
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	corev1 "k8s.io/api/core/v1"
@@ -46,20 +47,42 @@ type AddArgs struct {
 	// Predicates are the predicates to use.
 	// If unset, GenerationChangedPredicate will be used.
 	Predicates []predicate.Predicate
+	// ErrorClassifier determines the error codes of failed operations.
+	// If unset, the default classifier will be used.
+	ErrorClassifier controllererror.Classifier
 }
 
 // DefaultPredicates returns the default predicates for a controlplane reconciler.
-func DefaultPredicates(mgr manager.Manager) []predicate.Predicate {
+func DefaultPredicates(mgr manager.Manager, ignoreOperationAnnotation bool) []predicate.Predicate {
+	if ignoreOperationAnnotation {
+		return []predicate.Predicate{
+			extensionscontroller.ShootFailedPredicate(mgr.GetClient()),
+			extensionscontroller.ReconciliationNotPausedPredicate(mgr.GetClient()),
+			extensionscontroller.OrPredicate(
+				extensionscontroller.GenerationChangedPredicate(),
+				extensionscontroller.PauseReconciliationAnnotationChangedPredicate(),
+			),
+		}
+	}
+
 	return []predicate.Predicate{
 		extensionscontroller.ShootFailedPredicate(mgr.GetClient()),
-		extensionscontroller.GenerationChangedPredicate(),
+		extensionscontroller.ReconciliationNotPausedPredicate(mgr.GetClient()),
+		extensionscontroller.OrPredicate(
+			OperationAnnotationPredicate(),
+			extensionscontroller.PauseReconciliationAnnotationChangedPredicate(),
+		),
+		extensionscontroller.OrPredicate(
+			extensionscontroller.GenerationChangedPredicate(),
+			extensionscontroller.AnnotationsChangedPredicate(),
+		),
 	}
 }
 
 // Add creates a new ControlPlane Controller and adds it to the Manager.
 // and Start it when the Manager is Started.
 func Add(mgr manager.Manager, args AddArgs) error {
-	args.ControllerOptions.Reconciler = NewReconciler(mgr, args.Actuator)
+	args.ControllerOptions.Reconciler = NewReconciler(mgr, args.Actuator, args.ErrorClassifier)
 	return add(mgr, args.Type, args.ControllerOptions, args.Predicates)
 }
 
@@ -71,7 +94,7 @@ func add(mgr manager.Manager, typeName string, options controller.Options, predi
 	}
 
 	if predicates == nil {
-		predicates = DefaultPredicates(mgr)
+		predicates = DefaultPredicates(mgr, true)
 	}
 	predicates = append(predicates, extensionscontroller.TypePredicate(typeName))
 
@@ -84,5 +107,9 @@ func add(mgr manager.Manager, typeName string, options controller.Options, predi
 	if err := ctrl.Watch(&source.Kind{Type: &extensionsv1alpha1.Cluster{}}, &handler.EnqueueRequestsFromMapFunc{ToRequests: ClusterToControlPlaneMapper(mgr.GetClient(), predicates)}); err != nil {
 		return err
 	}
+	// Pause and resume the reconciliation of the controlplanes together with their cluster.
+	if err := ctrl.Watch(&source.Kind{Type: &extensionsv1alpha1.Cluster{}}, &handler.EnqueueRequestsFromMapFunc{ToRequests: ClusterPauseToControlPlaneMapper(mgr.GetClient(), predicates)}, extensionscontroller.PauseReconciliationAnnotationChangedPredicate()); err != nil {
+		return err
+	}
 	return nil
 }
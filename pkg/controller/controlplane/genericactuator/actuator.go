@@ -16,21 +16,27 @@ package genericactuator
 
 import (
 	"context"
+	"time"
 
 	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
 	"github.com/gardener/gardener-extensions/pkg/controller/controlplane"
 	"github.com/gardener/gardener-extensions/pkg/util"
 
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	gardenerkubernetes "github.com/gardener/gardener/pkg/client/kubernetes"
 	"github.com/gardener/gardener/pkg/operation/common"
+	"github.com/gardener/gardener/pkg/utils/chart"
 	"github.com/gardener/gardener/pkg/utils/imagevector"
 
 	"github.com/go-logr/logr"
 
 	"github.com/pkg/errors"
 
+	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
 
@@ -83,6 +89,7 @@ func NewActuator(
 		shootClientsFactory:    shootClientsFactory,
 		imageVector:            imageVector,
 		configName:             configName,
+		componentsTimeout:      defaultComponentsTimeout,
 		logger:                 logger.WithName("controlplane-actuator"),
 	}
 }
@@ -97,6 +104,7 @@ type actuator struct {
 	shootClientsFactory    ShootClientsFactory
 	imageVector            imagevector.ImageVector
 	configName             string
+	componentsTimeout      time.Duration
 
 	clientset         kubernetes.Interface
 	gardenerClientset gardenerkubernetes.Interface
@@ -141,70 +149,122 @@ func (a *actuator) InjectClient(client client.Client) error {
 }
 
 // Reconcile reconciles the given controlplane and cluster, creating or updating the additional Shoot
-// control plane components as needed.
+// control plane components as needed, and waits until the rendered deployments are healthy. It returns true if the
+// control plane shoot chart could not be applied yet because the shoot API server is not available, and the
+// controlplane must be reconciled again later.
 func (a *actuator) Reconcile(
 	ctx context.Context,
 	cp *extensionsv1alpha1.ControlPlane,
 	cluster *extensionscontroller.Cluster,
-) error {
+) (bool, error) {
 	// Deploy secrets
 	a.logger.Info("Deploying secrets", "controlplane", util.ObjectName(cp))
+	extensionscontroller.ReportProgress(ctx, 10, "Deploying secrets")
 	deployedSecrets, err := a.secrets.Deploy(a.clientset, a.gardenerClientset, cp.Namespace)
 	if err != nil {
-		return errors.Wrapf(err, "could not deploy secrets for controlplane '%s'", util.ObjectName(cp))
+		return false, errors.Wrapf(err, "could not deploy secrets for controlplane '%s'", util.ObjectName(cp))
 	}
 
 	// Get config chart values
 	if a.configChart != nil {
 		values, err := a.vp.GetConfigChartValues(ctx, cp, cluster)
 		if err != nil {
-			return err
+			return false, err
 		}
 
 		// Apply config chart
 		a.logger.Info("Applying configuration chart", "controlplane", util.ObjectName(cp), "values", values)
+		extensionscontroller.ReportProgress(ctx, 30, "Applying configuration chart")
 		if err := a.configChart.Apply(ctx, a.gardenerClientset, a.chartApplier, cp.Namespace, cluster.Shoot, nil, nil, values); err != nil {
-			return errors.Wrapf(err, "could not apply configuration chart for controlplane '%s'", util.ObjectName(cp))
+			extensionscontroller.SetCondition(ctx, extensionscontroller.ConditionTypeControlPlaneComponentsReady, gardencorev1alpha1.ConditionFalse, "ConfigurationChartApplyFailed", err.Error())
+			return false, errors.Wrapf(err, "could not apply configuration chart for controlplane '%s'", util.ObjectName(cp))
 		}
 	}
 
 	// Compute all needed checksums
 	checksums, err := a.computeChecksums(ctx, deployedSecrets, cp.Namespace)
 	if err != nil {
-		return err
+		return false, err
 	}
 
 	// Get control plane chart values
 	values, err := a.vp.GetControlPlaneChartValues(ctx, cp, cluster, checksums)
 	if err != nil {
-		return err
+		return false, err
 	}
 
 	// Apply control plane chart
 	a.logger.Info("Applying control plane chart", "controlplane", util.ObjectName(cp), "values", values)
+	extensionscontroller.ReportProgress(ctx, 60, "Applying control plane chart")
 	if err := a.controlPlaneChart.Apply(ctx, a.gardenerClientset, a.chartApplier, cp.Namespace, cluster.Shoot, a.imageVector, checksums, values); err != nil {
-		return errors.Wrapf(err, "could not apply control plane chart for controlplane '%s'", util.ObjectName(cp))
+		extensionscontroller.SetCondition(ctx, extensionscontroller.ConditionTypeControlPlaneComponentsReady, gardencorev1alpha1.ConditionFalse, "ControlPlaneChartApplyFailed", err.Error())
+		return false, errors.Wrapf(err, "could not apply control plane chart for controlplane '%s'", util.ObjectName(cp))
 	}
 
-	// Create shoot clients
-	// sc, err := a.shootClientsFactory.NewClientsForShoot(ctx, a.client, cp.Namespace, client.Options{})
-	// if err != nil {
-	// 	return errors.Wrapf(err, "could not create shoot clients for shoot '%s'", cp.Namespace)
-	// }
+	// Delete control plane objects of disabled components
+	if err := deleteDisabledSubCharts(ctx, a.controlPlaneChart, a.client, cp.Namespace, values); err != nil {
+		return false, errors.Wrapf(err, "could not delete disabled control plane objects for controlplane '%s'", util.ObjectName(cp))
+	}
 
-	// Get control plane shoot chart values
-	// values, err = a.vp.GetControlPlaneShootChartValues(ctx, cp, cluster)
-	// if err != nil {
-	// 	return err
-	// }
+	if extensionscontroller.IsHibernated(cluster.Shoot) {
+		extensionscontroller.SetCondition(ctx, extensionscontroller.ConditionTypeControlPlaneComponentsReady, gardencorev1alpha1.ConditionTrue, "ControlPlaneComponentsHibernated", "All control plane components have been hibernated.")
+		return false, nil
+	}
 
-	// Apply control plane shoot chart
-	// a.logger.Info("Applying control plane shoot chart", "controlplane", util.ObjectName(cp), "values", values)
-	// if err := a.controlPlaneShootChart.Apply(ctx, sc.GardenerClientset(), sc.ChartApplier(), metav1.NamespaceSystem, cluster.Shoot, a.imageVector, nil, values); err != nil {
-	// 	return errors.Wrapf(err, "could not apply control plane shoot chart for controlplane '%s'", util.ObjectName(cp))
-	// }
+	deployments := deploymentNames(a.controlPlaneChart)
+	if a.controlPlaneShootChart != nil || len(deployments) > 0 {
+		// The shoot API server is deployed after the control plane, hence the control plane shoot chart can only
+		// be applied and the control plane components can only become healthy in a later reconciliation.
+		available, err := a.shootAPIServerAvailable(ctx, cp.Namespace)
+		if err != nil {
+			return false, err
+		}
+		if !available {
+			a.logger.Info("Shoot API server is not available yet, postponing control plane shoot chart and health check", "controlplane", util.ObjectName(cp))
+			extensionscontroller.SetCondition(ctx, extensionscontroller.ConditionTypeControlPlaneComponentsReady, gardencorev1alpha1.ConditionFalse, "ShootAPIServerUnavailable", "The control plane shoot components will be deployed once the shoot API server is available.")
+			return true, nil
+		}
+	}
 
-	return nil
+	if a.controlPlaneShootChart != nil {
+		// Create shoot clients
+		sc, err := a.shootClientsFactory.NewClientsForShoot(ctx, a.client, cp.Namespace, client.Options{})
+		if err != nil {
+			return false, errors.Wrapf(err, "could not create shoot clients for shoot '%s'", cp.Namespace)
+		}
+
+		// Get control plane shoot chart values
+		values, err := a.vp.GetControlPlaneShootChartValues(ctx, cp, cluster)
+		if err != nil {
+			return false, err
+		}
+
+		// Apply control plane shoot chart
+		a.logger.Info("Applying control plane shoot chart", "controlplane", util.ObjectName(cp), "values", values)
+		extensionscontroller.ReportProgress(ctx, 70, "Applying control plane shoot chart")
+		if err := a.controlPlaneShootChart.Apply(ctx, sc.GardenerClientset(), sc.ChartApplier(), metav1.NamespaceSystem, cluster.Shoot, a.imageVector, nil, values); err != nil {
+			extensionscontroller.SetCondition(ctx, extensionscontroller.ConditionTypeControlPlaneComponentsReady, gardencorev1alpha1.ConditionFalse, "ControlPlaneShootChartApplyFailed", err.Error())
+			return false, errors.Wrapf(err, "could not apply control plane shoot chart for controlplane '%s'", util.ObjectName(cp))
+		}
+
+		// Delete control plane shoot objects of disabled components
+		if err := deleteDisabledSubCharts(ctx, a.controlPlaneShootChart, sc.Client(), metav1.NamespaceSystem, values); err != nil {
+			return false, errors.Wrapf(err, "could not delete disabled control plane shoot objects for controlplane '%s'", util.ObjectName(cp))
+		}
+	}
+
+	// Wait until the control plane components are rolled out and healthy.
+	a.logger.Info("Waiting until control plane components are healthy", "controlplane", util.ObjectName(cp))
+	extensionscontroller.ReportProgress(ctx, 80, "Waiting until control plane components are healthy")
+	timeoutCtx, cancel := context.WithTimeout(ctx, a.componentsTimeout)
+	defer cancel()
+	if err := a.waitUntilDeploymentsHealthy(timeoutCtx, cp, deployments); err != nil {
+		extensionscontroller.SetCondition(ctx, extensionscontroller.ConditionTypeControlPlaneComponentsReady, gardencorev1alpha1.ConditionFalse, "ControlPlaneComponentsUnhealthy", err.Error())
+		return false, err
+	}
+
+	extensionscontroller.SetCondition(ctx, extensionscontroller.ConditionTypeControlPlaneComponentsReady, gardencorev1alpha1.ConditionTrue, "ControlPlaneComponentsHealthy", "All control plane components are healthy.")
+	return false, nil
 }
 
 // Delete reconciles the given controlplane and cluster, deleting the additional Shoot
@@ -214,17 +274,28 @@ func (a *actuator) Delete(
 	cp *extensionsv1alpha1.ControlPlane,
 	cluster *extensionscontroller.Cluster,
 ) error {
-	// Create shoot clients
-	// sc, err := a.shootClientsFactory.NewClientsForShoot(ctx, a.client, cp.Namespace, client.Options{})
-	// if err != nil {
-	// 	return errors.Wrapf(err, "could not create shoot clients for shoot '%s'", cp.Namespace)
-	// }
-
-	// Delete control plane shoot objects
-	// a.logger.Info("Deleting control plane shoot objects", "controlplane", util.ObjectName(cp))
-	// if err := a.controlPlaneShootChart.Delete(ctx, sc.Client(), metav1.NamespaceSystem); err != nil {
-	// 	return errors.Wrapf(err, "could not delete control plane shoot objects for controlplane '%s'", util.ObjectName(cp))
-	// }
+	if a.controlPlaneShootChart != nil && !extensionscontroller.IsHibernated(cluster.Shoot) {
+		// The control plane shoot objects can only be deleted while the shoot API server is available. If it is
+		// gone already, they are gone together with the shoot cluster.
+		available, err := a.shootAPIServerAvailable(ctx, cp.Namespace)
+		if err != nil {
+			return err
+		}
+
+		if available {
+			// Create shoot clients
+			sc, err := a.shootClientsFactory.NewClientsForShoot(ctx, a.client, cp.Namespace, client.Options{})
+			if err != nil {
+				return errors.Wrapf(err, "could not create shoot clients for shoot '%s'", cp.Namespace)
+			}
+
+			// Delete control plane shoot objects
+			a.logger.Info("Deleting control plane shoot objects", "controlplane", util.ObjectName(cp))
+			if err := a.controlPlaneShootChart.Delete(ctx, sc.Client(), metav1.NamespaceSystem); err != nil {
+				return errors.Wrapf(err, "could not delete control plane shoot objects for controlplane '%s'", util.ObjectName(cp))
+			}
+		}
+	}
 
 	// Delete control plane objects
 	a.logger.Info("Deleting control plane objects", "controlplane", util.ObjectName(cp))
@@ -249,6 +320,42 @@ func (a *actuator) Delete(
 	return nil
 }
 
+// shootAPIServerAvailable returns true if the kube-apiserver deployment in the given shoot namespace exists and
+// has at least one available replica.
+func (a *actuator) shootAPIServerAvailable(ctx context.Context, namespace string) (bool, error) {
+	deployment := &appsv1.Deployment{}
+	if err := a.client.Get(ctx, client.ObjectKey{Namespace: namespace, Name: common.KubeAPIServerDeploymentName}, deployment); err != nil {
+		if apierrors.IsNotFound(err) {
+			return false, nil
+		}
+		return false, errors.Wrapf(err, "could not get deployment '%s/%s'", namespace, common.KubeAPIServerDeploymentName)
+	}
+	return deployment.Status.AvailableReplicas > 0, nil
+}
+
+// deleteDisabledSubCharts deletes the objects of all sub-charts of the given chart that are disabled in the given
+// values, i.e. whose values contain `enabled: false`. Applying a chart never deletes objects it no longer renders,
+// hence the objects of components that have been disabled since they were deployed must be deleted explicitly.
+func deleteDisabledSubCharts(ctx context.Context, c util.Chart, cl client.Client, namespace string, values map[string]interface{}) error {
+	ch, ok := c.(*chart.Chart)
+	if !ok || ch == nil {
+		return nil
+	}
+
+	for _, sc := range ch.SubCharts {
+		scValues, ok := values[sc.Name].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if enabled, ok := scValues["enabled"].(bool); ok && !enabled {
+			if err := sc.Delete(ctx, cl, namespace); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
 // computeChecksums computes and returns all needed checksums. This includes the checksums for the given deployed secrets,
 // as well as the cloud provider secret and configmap that are fetched from the cluster.
 func (a *actuator) computeChecksums(
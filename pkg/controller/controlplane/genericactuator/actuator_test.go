@@ -26,6 +26,7 @@ import (
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
 	"github.com/gardener/gardener/pkg/operation/common"
+	"github.com/gardener/gardener/pkg/utils/chart"
 	"github.com/gardener/gardener/pkg/utils/imagevector"
 
 	"github.com/golang/mock/gomock"
@@ -33,9 +34,12 @@ import (
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 
+	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/runtime/inject"
@@ -111,9 +115,18 @@ var _ = Describe("Actuator", func() {
 			"clusterName": namespace,
 		}
 
-		// controlPlaneShootChartValues = map[string]interface{}{
-		// 	"foo": "bar",
-		// }
+		controlPlaneShootChartValues = map[string]interface{}{
+			"foo": "bar",
+		}
+
+		kubeAPIServerKey = client.ObjectKey{Namespace: namespace, Name: common.KubeAPIServerDeploymentName}
+		kubeAPIServer    = &appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      common.KubeAPIServerDeploymentName,
+				Namespace: namespace,
+			},
+			Status: appsv1.DeploymentStatus{AvailableReplicas: 1},
+		}
 
 		logger = log.Log.WithName("test")
 	)
@@ -132,6 +145,7 @@ var _ = Describe("Actuator", func() {
 			client := mockclient.NewMockClient(ctrl)
 			client.EXPECT().Get(context.TODO(), cpSecretKey, &corev1.Secret{}).DoAndReturn(clientGet(cpSecret))
 			client.EXPECT().Get(context.TODO(), cpConfigMapKey, &corev1.ConfigMap{}).DoAndReturn(clientGet(cpConfigMap))
+			client.EXPECT().Get(context.TODO(), kubeAPIServerKey, &appsv1.Deployment{}).DoAndReturn(clientGet(kubeAPIServer))
 
 			// Create mock secrets and charts
 			secrets := mockutil.NewMockSecrets(ctrl)
@@ -141,20 +155,21 @@ var _ = Describe("Actuator", func() {
 			ccmChart := mockutil.NewMockChart(ctrl)
 			ccmChart.EXPECT().Apply(context.TODO(), gomock.Any(), gomock.Any(), namespace, cluster.Shoot, imageVector, checksums, controlPlaneChartValues).Return(nil)
 			ccmShootChart := mockutil.NewMockChart(ctrl)
-			// ccmShootChart.EXPECT().Apply(context.TODO(), gomock.Any(), gomock.Any(), metav1.NamespaceSystem, cluster.Shoot, imageVector, nil, controlPlaneShootChartValues).Return(nil)
+			ccmShootChart.EXPECT().Apply(context.TODO(), gomock.Any(), gomock.Any(), metav1.NamespaceSystem, cluster.Shoot, imageVector, nil, controlPlaneShootChartValues).Return(nil)
 
 			// Create mock values provider
 			vp := mockgenericactuator.NewMockValuesProvider(ctrl)
 			vp.EXPECT().GetConfigChartValues(context.TODO(), cp, cluster).Return(configChartValues, nil)
 			vp.EXPECT().GetControlPlaneChartValues(context.TODO(), cp, cluster, checksums).Return(controlPlaneChartValues, nil)
-			// vp.EXPECT().GetControlPlaneShootChartValues(context.TODO(), cp, cluster).Return(controlPlaneShootChartValues, nil)
+			vp.EXPECT().GetControlPlaneShootChartValues(context.TODO(), cp, cluster).Return(controlPlaneShootChartValues, nil)
 
 			// Create mock shoot clients factory
-			// sc := mockutil.NewMockShootClients(ctrl)
-			// sc.EXPECT().GardenerClientset().Return(nil)
-			// sc.EXPECT().ChartApplier().Return(nil)
+			sc := mockutil.NewMockShootClients(ctrl)
+			sc.EXPECT().GardenerClientset().Return(nil)
+			sc.EXPECT().ChartApplier().Return(nil)
+			sc.EXPECT().Client().Return(mockclient.NewMockClient(ctrl))
 			scf := mockgenericactuator.NewMockShootClientsFactory(ctrl)
-			// scf.EXPECT().NewClientsForShoot(context.TODO(), client, namespace, gomock.Any()).Return(sc, nil)
+			scf.EXPECT().NewClientsForShoot(context.TODO(), client, namespace, gomock.Any()).Return(sc, nil)
 
 			// Create actuator
 			a := NewActuator(secrets, configChart, ccmChart, ccmShootChart, vp, scf, imageVector, cloudProviderConfigName, logger)
@@ -162,14 +177,54 @@ var _ = Describe("Actuator", func() {
 			Expect(err).NotTo(HaveOccurred())
 
 			// Call Reconcile method and check the result
-			err = a.Reconcile(context.TODO(), cp, cluster)
+			requeue, err := a.Reconcile(context.TODO(), cp, cluster)
 			Expect(err).NotTo(HaveOccurred())
+			Expect(requeue).To(BeFalse())
 		})
 
 		It("should deploy secrets and apply charts with correct parameters (only controlplane chart)", func() {
 			// Create mock client
 			client := mockclient.NewMockClient(ctrl)
 			client.EXPECT().Get(context.TODO(), cpSecretKey, &corev1.Secret{}).DoAndReturn(clientGet(cpSecret))
+			client.EXPECT().Get(context.TODO(), kubeAPIServerKey, &appsv1.Deployment{}).DoAndReturn(clientGet(kubeAPIServer))
+
+			// Create mock secrets and charts
+			secrets := mockutil.NewMockSecrets(ctrl)
+			secrets.EXPECT().Deploy(gomock.Any(), gomock.Any(), namespace).Return(deployedSecrets, nil)
+			ccmChart := mockutil.NewMockChart(ctrl)
+			ccmChart.EXPECT().Apply(context.TODO(), gomock.Any(), gomock.Any(), namespace, cluster.Shoot, imageVector, checksumsWithoutConfig, controlPlaneChartValues).Return(nil)
+			ccmShootChart := mockutil.NewMockChart(ctrl)
+			ccmShootChart.EXPECT().Apply(context.TODO(), gomock.Any(), gomock.Any(), metav1.NamespaceSystem, cluster.Shoot, imageVector, nil, controlPlaneShootChartValues).Return(nil)
+
+			// Create mock values provider
+			vp := mockgenericactuator.NewMockValuesProvider(ctrl)
+			vp.EXPECT().GetControlPlaneChartValues(context.TODO(), cp, cluster, checksumsWithoutConfig).Return(controlPlaneChartValues, nil)
+			vp.EXPECT().GetControlPlaneShootChartValues(context.TODO(), cp, cluster).Return(controlPlaneShootChartValues, nil)
+
+			// Create mock shoot clients factory
+			sc := mockutil.NewMockShootClients(ctrl)
+			sc.EXPECT().GardenerClientset().Return(nil)
+			sc.EXPECT().ChartApplier().Return(nil)
+			sc.EXPECT().Client().Return(mockclient.NewMockClient(ctrl))
+			scf := mockgenericactuator.NewMockShootClientsFactory(ctrl)
+			scf.EXPECT().NewClientsForShoot(context.TODO(), client, namespace, gomock.Any()).Return(sc, nil)
+
+			// Create actuator
+			a := NewActuator(secrets, nil, ccmChart, ccmShootChart, vp, scf, imageVector, "", logger)
+			err := a.(inject.Client).InjectClient(client)
+			Expect(err).NotTo(HaveOccurred())
+
+			// Call Reconcile method and check the result
+			requeue, err := a.Reconcile(context.TODO(), cp, cluster)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(requeue).To(BeFalse())
+		})
+
+		It("should request a requeue if the shoot API server is not available yet", func() {
+			// Create mock client
+			client := mockclient.NewMockClient(ctrl)
+			client.EXPECT().Get(context.TODO(), cpSecretKey, &corev1.Secret{}).DoAndReturn(clientGet(cpSecret))
+			client.EXPECT().Get(context.TODO(), kubeAPIServerKey, &appsv1.Deployment{}).Return(apierrors.NewNotFound(schema.GroupResource{}, common.KubeAPIServerDeploymentName))
 
 			// Create mock secrets and charts
 			secrets := mockutil.NewMockSecrets(ctrl)
@@ -177,19 +232,13 @@ var _ = Describe("Actuator", func() {
 			ccmChart := mockutil.NewMockChart(ctrl)
 			ccmChart.EXPECT().Apply(context.TODO(), gomock.Any(), gomock.Any(), namespace, cluster.Shoot, imageVector, checksumsWithoutConfig, controlPlaneChartValues).Return(nil)
 			ccmShootChart := mockutil.NewMockChart(ctrl)
-			// ccmShootChart.EXPECT().Apply(context.TODO(), gomock.Any(), gomock.Any(), metav1.NamespaceSystem, cluster.Shoot, imageVector, nil, controlPlaneShootChartValues).Return(nil)
 
 			// Create mock values provider
 			vp := mockgenericactuator.NewMockValuesProvider(ctrl)
 			vp.EXPECT().GetControlPlaneChartValues(context.TODO(), cp, cluster, checksumsWithoutConfig).Return(controlPlaneChartValues, nil)
-			// vp.EXPECT().GetControlPlaneShootChartValues(context.TODO(), cp, cluster).Return(controlPlaneShootChartValues, nil)
 
 			// Create mock shoot clients factory
-			// sc := mockutil.NewMockShootClients(ctrl)
-			// sc.EXPECT().GardenerClientset().Return(nil)
-			// sc.EXPECT().ChartApplier().Return(nil)
 			scf := mockgenericactuator.NewMockShootClientsFactory(ctrl)
-			// scf.EXPECT().NewClientsForShoot(context.TODO(), client, namespace, gomock.Any()).Return(sc, nil)
 
 			// Create actuator
 			a := NewActuator(secrets, nil, ccmChart, ccmShootChart, vp, scf, imageVector, "", logger)
@@ -197,8 +246,47 @@ var _ = Describe("Actuator", func() {
 			Expect(err).NotTo(HaveOccurred())
 
 			// Call Reconcile method and check the result
-			err = a.Reconcile(context.TODO(), cp, cluster)
+			requeue, err := a.Reconcile(context.TODO(), cp, cluster)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(requeue).To(BeTrue())
+		})
+
+		It("should not apply the control plane shoot chart if the shoot is hibernated", func() {
+			hibernatedCluster := &extensionscontroller.Cluster{
+				Shoot: &gardenv1beta1.Shoot{
+					Spec: gardenv1beta1.ShootSpec{
+						Hibernation: &gardenv1beta1.Hibernation{Enabled: true},
+					},
+				},
+			}
+
+			// Create mock client
+			client := mockclient.NewMockClient(ctrl)
+			client.EXPECT().Get(context.TODO(), cpSecretKey, &corev1.Secret{}).DoAndReturn(clientGet(cpSecret))
+
+			// Create mock secrets and charts
+			secrets := mockutil.NewMockSecrets(ctrl)
+			secrets.EXPECT().Deploy(gomock.Any(), gomock.Any(), namespace).Return(deployedSecrets, nil)
+			ccmChart := mockutil.NewMockChart(ctrl)
+			ccmChart.EXPECT().Apply(context.TODO(), gomock.Any(), gomock.Any(), namespace, hibernatedCluster.Shoot, imageVector, checksumsWithoutConfig, controlPlaneChartValues).Return(nil)
+			ccmShootChart := mockutil.NewMockChart(ctrl)
+
+			// Create mock values provider
+			vp := mockgenericactuator.NewMockValuesProvider(ctrl)
+			vp.EXPECT().GetControlPlaneChartValues(context.TODO(), cp, hibernatedCluster, checksumsWithoutConfig).Return(controlPlaneChartValues, nil)
+
+			// Create mock shoot clients factory
+			scf := mockgenericactuator.NewMockShootClientsFactory(ctrl)
+
+			// Create actuator
+			a := NewActuator(secrets, nil, ccmChart, ccmShootChart, vp, scf, imageVector, "", logger)
+			err := a.(inject.Client).InjectClient(client)
+			Expect(err).NotTo(HaveOccurred())
+
+			// Call Reconcile method and check the result
+			requeue, err := a.Reconcile(context.TODO(), cp, hibernatedCluster)
 			Expect(err).NotTo(HaveOccurred())
+			Expect(requeue).To(BeFalse())
 		})
 	})
 
@@ -206,7 +294,8 @@ var _ = Describe("Actuator", func() {
 		It("should delete secrets and charts", func() {
 			// Create mock clients
 			client := mockclient.NewMockClient(ctrl)
-			// shootClient := mockclient.NewMockClient(ctrl)
+			client.EXPECT().Get(context.TODO(), kubeAPIServerKey, &appsv1.Deployment{}).DoAndReturn(clientGet(kubeAPIServer))
+			shootClient := mockclient.NewMockClient(ctrl)
 
 			// Create mock secrets and charts
 			secrets := mockutil.NewMockSecrets(ctrl)
@@ -216,13 +305,13 @@ var _ = Describe("Actuator", func() {
 			ccmChart := mockutil.NewMockChart(ctrl)
 			ccmChart.EXPECT().Delete(context.TODO(), client, namespace).Return(nil)
 			ccmShootChart := mockutil.NewMockChart(ctrl)
-			// ccmShootChart.EXPECT().Delete(context.TODO(), shootClient, metav1.NamespaceSystem).Return(nil)
+			ccmShootChart.EXPECT().Delete(context.TODO(), shootClient, metav1.NamespaceSystem).Return(nil)
 
 			// Create mock shoot clients factory
-			// sc := mockutil.NewMockShootClients(ctrl)
-			// sc.EXPECT().Client().Return(shootClient)
+			sc := mockutil.NewMockShootClients(ctrl)
+			sc.EXPECT().Client().Return(shootClient)
 			scf := mockgenericactuator.NewMockShootClientsFactory(ctrl)
-			// scf.EXPECT().NewClientsForShoot(context.TODO(), client, namespace, gomock.Any()).Return(sc, nil)
+			scf.EXPECT().NewClientsForShoot(context.TODO(), client, namespace, gomock.Any()).Return(sc, nil)
 
 			// Create actuator
 			a := NewActuator(secrets, configChart, ccmChart, ccmShootChart, nil, scf, nil, cloudProviderConfigName, logger)
@@ -237,7 +326,37 @@ var _ = Describe("Actuator", func() {
 		It("should delete secrets and charts (only controlplane chart)", func() {
 			// Create mock client
 			client := mockclient.NewMockClient(ctrl)
-			// shootClient := mockclient.NewMockClient(ctrl)
+			client.EXPECT().Get(context.TODO(), kubeAPIServerKey, &appsv1.Deployment{}).DoAndReturn(clientGet(kubeAPIServer))
+			shootClient := mockclient.NewMockClient(ctrl)
+
+			// Create mock secrets and charts
+			secrets := mockutil.NewMockSecrets(ctrl)
+			secrets.EXPECT().Delete(gomock.Any(), namespace).Return(nil)
+			ccmChart := mockutil.NewMockChart(ctrl)
+			ccmChart.EXPECT().Delete(context.TODO(), client, namespace).Return(nil)
+			ccmShootChart := mockutil.NewMockChart(ctrl)
+			ccmShootChart.EXPECT().Delete(context.TODO(), shootClient, metav1.NamespaceSystem).Return(nil)
+
+			// Create mock shoot clients factory
+			sc := mockutil.NewMockShootClients(ctrl)
+			sc.EXPECT().Client().Return(shootClient)
+			scf := mockgenericactuator.NewMockShootClientsFactory(ctrl)
+			scf.EXPECT().NewClientsForShoot(context.TODO(), client, namespace, gomock.Any()).Return(sc, nil)
+
+			// Create actuator
+			a := NewActuator(secrets, nil, ccmChart, ccmShootChart, nil, scf, nil, "", logger)
+			err := a.(inject.Client).InjectClient(client)
+			Expect(err).NotTo(HaveOccurred())
+
+			// Call Delete method and check the result
+			err = a.Delete(context.TODO(), cp, cluster)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should not delete the control plane shoot objects if the shoot API server is gone", func() {
+			// Create mock client
+			client := mockclient.NewMockClient(ctrl)
+			client.EXPECT().Get(context.TODO(), kubeAPIServerKey, &appsv1.Deployment{}).Return(apierrors.NewNotFound(schema.GroupResource{}, common.KubeAPIServerDeploymentName))
 
 			// Create mock secrets and charts
 			secrets := mockutil.NewMockSecrets(ctrl)
@@ -245,13 +364,9 @@ var _ = Describe("Actuator", func() {
 			ccmChart := mockutil.NewMockChart(ctrl)
 			ccmChart.EXPECT().Delete(context.TODO(), client, namespace).Return(nil)
 			ccmShootChart := mockutil.NewMockChart(ctrl)
-			// ccmShootChart.EXPECT().Delete(context.TODO(), shootClient, metav1.NamespaceSystem).Return(nil)
 
 			// Create mock shoot clients factory
-			// sc := mockutil.NewMockShootClients(ctrl)
-			// sc.EXPECT().Client().Return(shootClient)
 			scf := mockgenericactuator.NewMockShootClientsFactory(ctrl)
-			// scf.EXPECT().NewClientsForShoot(context.TODO(), client, namespace, gomock.Any()).Return(sc, nil)
 
 			// Create actuator
 			a := NewActuator(secrets, nil, ccmChart, ccmShootChart, nil, scf, nil, "", logger)
@@ -263,6 +378,54 @@ var _ = Describe("Actuator", func() {
 			Expect(err).NotTo(HaveOccurred())
 		})
 	})
+
+	Describe("#deleteDisabledSubCharts", func() {
+		var (
+			csiKey = client.ObjectKey{Namespace: namespace, Name: "csi-driver-controller"}
+			c      = &chart.Chart{
+				Name: "seed-controlplane",
+				SubCharts: []*chart.Chart{
+					{
+						Name:    "cloud-controller-manager",
+						Objects: []*chart.Object{{Type: &appsv1.Deployment{}, Name: "cloud-controller-manager"}},
+					},
+					{
+						Name:    "csi",
+						Objects: []*chart.Object{{Type: &appsv1.Deployment{}, Name: "csi-driver-controller"}},
+					},
+				},
+			}
+		)
+
+		It("should delete the objects of disabled sub-charts", func() {
+			csiDriverController := &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: csiKey.Name, Namespace: namespace}}
+
+			client := mockclient.NewMockClient(ctrl)
+			client.EXPECT().Get(context.TODO(), csiKey, &appsv1.Deployment{}).DoAndReturn(clientGet(csiDriverController))
+			client.EXPECT().Delete(context.TODO(), csiDriverController).Return(nil)
+
+			Expect(deleteDisabledSubCharts(context.TODO(), c, client, namespace, map[string]interface{}{
+				"cloud-controller-manager": map[string]interface{}{"replicas": 1},
+				"csi":                      map[string]interface{}{"enabled": false},
+			})).To(Succeed())
+		})
+
+		It("should not delete the objects of enabled sub-charts", func() {
+			client := mockclient.NewMockClient(ctrl)
+
+			Expect(deleteDisabledSubCharts(context.TODO(), c, client, namespace, map[string]interface{}{
+				"csi": map[string]interface{}{"enabled": true},
+			})).To(Succeed())
+		})
+
+		It("should not delete anything for other charts", func() {
+			client := mockclient.NewMockClient(ctrl)
+
+			Expect(deleteDisabledSubCharts(context.TODO(), mockutil.NewMockChart(ctrl), client, namespace, map[string]interface{}{
+				"csi": map[string]interface{}{"enabled": false},
+			})).To(Succeed())
+		})
+	})
 })
 
 func clientGet(result runtime.Object) interface{} {
@@ -272,6 +435,8 @@ func clientGet(result runtime.Object) interface{} {
 			*obj.(*corev1.Secret) = *result.(*corev1.Secret)
 		case *corev1.ConfigMap:
 			*obj.(*corev1.ConfigMap) = *result.(*corev1.ConfigMap)
+		case *appsv1.Deployment:
+			*obj.(*appsv1.Deployment) = *result.(*appsv1.Deployment)
 		}
 		return nil
 	}
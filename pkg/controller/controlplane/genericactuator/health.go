@@ -0,0 +1,234 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package genericactuator
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
+	controllererror "github.com/gardener/gardener-extensions/pkg/controller/error"
+	"github.com/gardener/gardener-extensions/pkg/util"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	gardencorev1alpha1helper "github.com/gardener/gardener/pkg/apis/core/v1alpha1/helper"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/utils/chart"
+	"github.com/gardener/gardener/pkg/utils/kubernetes/health"
+
+	"github.com/pkg/errors"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// defaultComponentsTimeout is the default time to wait for the control plane components to become healthy.
+	defaultComponentsTimeout = 3 * time.Minute
+	// componentsPollInterval is the interval in which the health of the control plane components is checked.
+	componentsPollInterval = 5 * time.Second
+)
+
+// deploymentNames returns the names of all deployments that are rendered by the given chart and its sub-charts.
+// Charts that are not backed by a gardener chart.Chart don't expose their objects and hence have no deployments.
+func deploymentNames(c util.Chart) []string {
+	ch, ok := c.(*chart.Chart)
+	if !ok || ch == nil {
+		return nil
+	}
+
+	var names []string
+	for _, o := range ch.Objects {
+		if _, ok := o.Type.(*appsv1.Deployment); ok {
+			names = append(names, o.Name)
+		}
+	}
+	for _, sc := range ch.SubCharts {
+		names = append(names, deploymentNames(sc)...)
+	}
+	return names
+}
+
+// waitUntilDeploymentsHealthy waits until all given deployments in the namespace of the given controlplane are rolled
+// out and healthy. Deployments that don't exist are skipped, as the chart didn't render them. If the deployments don't
+// become healthy before the given context is done, the last observed pod failure reason is returned as coded error.
+func (a *actuator) waitUntilDeploymentsHealthy(ctx context.Context, cp *extensionsv1alpha1.ControlPlane, names []string) error {
+	var (
+		unhealthy   map[string]error
+		deployments map[string]*appsv1.Deployment
+	)
+
+	err := wait.PollImmediateUntil(componentsPollInterval, func() (bool, error) {
+		unhealthy = make(map[string]error)
+		deployments = make(map[string]*appsv1.Deployment)
+		for _, name := range names {
+			deployment := &appsv1.Deployment{}
+			if err := a.client.Get(ctx, client.ObjectKey{Namespace: cp.Namespace, Name: name}, deployment); err != nil {
+				if apierrors.IsNotFound(err) {
+					continue
+				}
+				return false, errors.Wrapf(err, "could not get deployment '%s/%s'", cp.Namespace, name)
+			}
+
+			if err := checkDeploymentRolledOut(deployment); err != nil {
+				unhealthy[name] = err
+				deployments[name] = deployment
+			}
+		}
+
+		if len(unhealthy) == 0 {
+			return true, nil
+		}
+
+		msg := fmt.Sprintf("Waiting until control plane components are healthy (%s)...", unhealthyDescription(unhealthy))
+		a.logger.Info(msg, "controlplane", util.ObjectName(cp))
+		extensionscontroller.SetCondition(ctx, extensionscontroller.ConditionTypeControlPlaneComponentsReady, gardencorev1alpha1.ConditionProgressing, "ControlPlaneComponentsRollingOut", msg)
+		return false, nil
+	}, ctx.Done())
+	if err == nil {
+		return nil
+	}
+	if err != wait.ErrWaitTimeout {
+		return err
+	}
+
+	// Use a fresh context, the one used while waiting is already done.
+	message := fmt.Sprintf("control plane components did not become healthy: %s", unhealthyDescription(unhealthy))
+	reason, err := a.lastPodFailureReason(context.Background(), deployments)
+	if err != nil {
+		a.logger.Error(err, "Could not determine the pod failure reason", "controlplane", util.ObjectName(cp))
+	}
+	if reason == "" {
+		return gardencorev1alpha1helper.NewErrorWithCode(controllererror.ErrorTimeout, message)
+	}
+	return componentsError(fmt.Sprintf("%s, last pod failure: %s", message, reason))
+}
+
+// checkDeploymentRolledOut checks whether the given deployment is healthy and all of its desired replicas have been
+// updated and are available.
+func checkDeploymentRolledOut(deployment *appsv1.Deployment) error {
+	if err := health.CheckDeployment(deployment); err != nil {
+		return err
+	}
+
+	var replicas int32 = 1
+	if deployment.Spec.Replicas != nil {
+		replicas = *deployment.Spec.Replicas
+	}
+	if deployment.Status.UpdatedReplicas < replicas {
+		return fmt.Errorf("%d/%d replicas updated", deployment.Status.UpdatedReplicas, replicas)
+	}
+	if deployment.Status.Replicas > deployment.Status.UpdatedReplicas {
+		return fmt.Errorf("%d old replicas pending termination", deployment.Status.Replicas-deployment.Status.UpdatedReplicas)
+	}
+	if deployment.Status.AvailableReplicas < replicas {
+		return fmt.Errorf("%d/%d replicas available", deployment.Status.AvailableReplicas, replicas)
+	}
+	return nil
+}
+
+// lastPodFailureReason returns the failure reason of the first failing container found in the pods of the given
+// unhealthy deployments, or an empty string if no container is failing.
+func (a *actuator) lastPodFailureReason(ctx context.Context, deployments map[string]*appsv1.Deployment) (string, error) {
+	names := make([]string, 0, len(deployments))
+	for name := range deployments {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		deployment := deployments[name]
+		namespace := deployment.Namespace
+		if deployment.Spec.Selector == nil {
+			continue
+		}
+
+		selector, err := metav1.LabelSelectorAsSelector(deployment.Spec.Selector)
+		if err != nil {
+			return "", errors.Wrapf(err, "could not convert label selector of deployment '%s/%s'", namespace, name)
+		}
+
+		pods := &corev1.PodList{}
+		if err := a.client.List(ctx, &client.ListOptions{Namespace: namespace, LabelSelector: selector}, pods); err != nil {
+			return "", errors.Wrapf(err, "could not list pods of deployment '%s/%s'", namespace, name)
+		}
+
+		for _, pod := range pods.Items {
+			if reason := podFailureReason(&pod); reason != "" {
+				return reason, nil
+			}
+		}
+	}
+	return "", nil
+}
+
+// podFailureReason returns a description of the first failing container of the given pod, including the reason
+// and message of its last termination, or an empty string if none of its containers is failing.
+func podFailureReason(pod *corev1.Pod) string {
+	for _, status := range pod.Status.ContainerStatuses {
+		waiting := status.State.Waiting
+		if waiting == nil || waiting.Reason == "" || waiting.Reason == "ContainerCreating" {
+			continue
+		}
+
+		reason := fmt.Sprintf("container '%s' of pod '%s' is in state %s", status.Name, pod.Name, waiting.Reason)
+		if len(waiting.Message) > 0 {
+			reason = fmt.Sprintf("%s (%s)", reason, waiting.Message)
+		}
+		if terminated := status.LastTerminationState.Terminated; terminated != nil {
+			reason = fmt.Sprintf("%s, last termination: %s", reason, terminated.Reason)
+			if len(terminated.Message) > 0 {
+				reason = fmt.Sprintf("%s: %s", reason, strings.TrimSpace(terminated.Message))
+			}
+		}
+		return reason
+	}
+	return ""
+}
+
+// componentsError returns an error for the given message. If no specific code can be determined from the message,
+// the error is reported with the controllererror.ErrorTimeout code, as the components didn't become healthy in time.
+func componentsError(message string) error {
+	err := gardencorev1alpha1helper.DetermineError(message)
+	if _, ok := err.(gardencorev1alpha1helper.Coder); ok {
+		return err
+	}
+	return gardencorev1alpha1helper.NewErrorWithCode(controllererror.ErrorTimeout, message)
+}
+
+func unhealthyDescription(unhealthy map[string]error) string {
+	var descriptions []string
+	for _, name := range sortedKeys(unhealthy) {
+		descriptions = append(descriptions, fmt.Sprintf("deployment '%s': %v", name, unhealthy[name]))
+	}
+	return strings.Join(descriptions, ", ")
+}
+
+func sortedKeys(m map[string]error) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
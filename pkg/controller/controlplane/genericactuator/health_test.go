@@ -0,0 +1,195 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package genericactuator
+
+import (
+	"context"
+	"time"
+
+	controllererror "github.com/gardener/gardener-extensions/pkg/controller/error"
+	mockclient "github.com/gardener/gardener-extensions/pkg/mock/controller-runtime/client"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	gardencorev1alpha1helper "github.com/gardener/gardener/pkg/apis/core/v1alpha1/helper"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/utils/chart"
+
+	"github.com/golang/mock/gomock"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/runtime/log"
+)
+
+var _ = Describe("Health", func() {
+	var (
+		ctrl *gomock.Controller
+
+		cp = &extensionsv1alpha1.ControlPlane{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "control-plane",
+				Namespace: namespace,
+			},
+		}
+
+		ccmKey = client.ObjectKey{Namespace: namespace, Name: "cloud-controller-manager"}
+		csiKey = client.ObjectKey{Namespace: namespace, Name: "csi-driver-controller"}
+
+		newDeployment = func(available bool) *appsv1.Deployment {
+			var (
+				replicas        int32 = 1
+				availableStatus       = corev1.ConditionTrue
+			)
+			if !available {
+				availableStatus = corev1.ConditionFalse
+			}
+
+			deployment := &appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:       "cloud-controller-manager",
+					Namespace:  namespace,
+					Generation: 1,
+				},
+				Spec: appsv1.DeploymentSpec{
+					Replicas: &replicas,
+					Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "kubernetes", "role": "cloud-controller-manager"}},
+				},
+				Status: appsv1.DeploymentStatus{
+					ObservedGeneration: 1,
+					Replicas:           1,
+					UpdatedReplicas:    1,
+					Conditions: []appsv1.DeploymentCondition{
+						{Type: appsv1.DeploymentAvailable, Status: availableStatus},
+					},
+				},
+			}
+			if available {
+				deployment.Status.AvailableReplicas = 1
+			}
+			return deployment
+		}
+
+		crashLoopingPod = func(message string) corev1.Pod {
+			return corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{Name: "cloud-controller-manager-abc", Namespace: namespace},
+				Status: corev1.PodStatus{
+					ContainerStatuses: []corev1.ContainerStatus{
+						{
+							Name: "cloud-controller-manager",
+							State: corev1.ContainerState{
+								Waiting: &corev1.ContainerStateWaiting{Reason: "CrashLoopBackOff"},
+							},
+							LastTerminationState: corev1.ContainerState{
+								Terminated: &corev1.ContainerStateTerminated{Reason: "Error", Message: message},
+							},
+						},
+					},
+				},
+			}
+		}
+
+		logger = log.Log.WithName("test")
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Describe("#deploymentNames", func() {
+		It("should return the deployments of the chart and its sub-charts", func() {
+			c := &chart.Chart{
+				Objects: []*chart.Object{
+					{Type: &corev1.Secret{}, Name: "foo"},
+					{Type: &appsv1.Deployment{}, Name: "bar"},
+				},
+				SubCharts: []*chart.Chart{
+					{
+						Objects: []*chart.Object{
+							{Type: &corev1.Service{}, Name: "baz"},
+							{Type: &appsv1.Deployment{}, Name: "qux"},
+						},
+					},
+				},
+			}
+
+			Expect(deploymentNames(c)).To(Equal([]string{"bar", "qux"}))
+		})
+
+		It("should return no deployments for other charts", func() {
+			Expect(deploymentNames(nil)).To(BeEmpty())
+		})
+	})
+
+	Describe("#waitUntilDeploymentsHealthy", func() {
+		It("should succeed if all deployments are healthy or not rendered", func() {
+			c := mockclient.NewMockClient(ctrl)
+			c.EXPECT().Get(gomock.Any(), ccmKey, &appsv1.Deployment{}).DoAndReturn(clientGet(newDeployment(true)))
+			c.EXPECT().Get(gomock.Any(), csiKey, &appsv1.Deployment{}).Return(apierrors.NewNotFound(schema.GroupResource{}, "csi-driver-controller"))
+
+			a := &actuator{client: c, logger: logger}
+			err := a.waitUntilDeploymentsHealthy(context.TODO(), cp, []string{"cloud-controller-manager", "csi-driver-controller"})
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should return the last pod failure reason with a proper code if a deployment does not become healthy", func() {
+			c := mockclient.NewMockClient(ctrl)
+			c.EXPECT().Get(gomock.Any(), ccmKey, &appsv1.Deployment{}).DoAndReturn(clientGet(newDeployment(false))).MinTimes(1)
+			c.EXPECT().List(gomock.Any(), gomock.Any(), &corev1.PodList{}).DoAndReturn(
+				func(_ context.Context, opts *client.ListOptions, list runtime.Object) error {
+					Expect(opts.Namespace).To(Equal(namespace))
+					Expect(opts.LabelSelector.String()).To(Equal("app=kubernetes,role=cloud-controller-manager"))
+					list.(*corev1.PodList).Items = []corev1.Pod{crashLoopingPod("AuthFailure: AWS was not able to validate the provided access credentials")}
+					return nil
+				})
+
+			ctx, cancel := context.WithTimeout(context.TODO(), 10*time.Millisecond)
+			defer cancel()
+
+			a := &actuator{client: c, logger: logger}
+			err := a.waitUntilDeploymentsHealthy(ctx, cp, []string{"cloud-controller-manager"})
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("container 'cloud-controller-manager' of pod 'cloud-controller-manager-abc' is in state CrashLoopBackOff"))
+			Expect(err.Error()).To(ContainSubstring("AuthFailure"))
+			Expect(gardencorev1alpha1helper.ExtractErrorCodes(err)).To(Equal([]gardencorev1alpha1.ErrorCode{gardencorev1alpha1.ErrorInfraUnauthorized}))
+		})
+
+		It("should return a timeout error if a deployment does not become healthy without failing pods", func() {
+			c := mockclient.NewMockClient(ctrl)
+			c.EXPECT().Get(gomock.Any(), ccmKey, &appsv1.Deployment{}).DoAndReturn(clientGet(newDeployment(false))).MinTimes(1)
+			c.EXPECT().List(gomock.Any(), gomock.Any(), &corev1.PodList{}).Return(nil)
+
+			ctx, cancel := context.WithTimeout(context.TODO(), 10*time.Millisecond)
+			defer cancel()
+
+			a := &actuator{client: c, logger: logger}
+			err := a.waitUntilDeploymentsHealthy(ctx, cp, []string{"cloud-controller-manager"})
+			Expect(err).To(HaveOccurred())
+			Expect(gardencorev1alpha1helper.ExtractErrorCodes(err)).To(Equal([]gardencorev1alpha1.ErrorCode{controllererror.ErrorTimeout}))
+		})
+	})
+})
@@ -78,3 +78,9 @@ func SecretToControlPlaneMapper(client client.Client, predicates []predicate.Pre
 func ClusterToControlPlaneMapper(client client.Client, predicates []predicate.Predicate) handler.Mapper {
 	return extensionscontroller.ClusterToObjectMapper(client, func() runtime.Object { return &extensions1alpha1.ControlPlaneList{} }, predicates)
 }
+
+// ClusterPauseToControlPlaneMapper returns a mapper that returns requests for ControlPlanes whose
+// referenced clusters have been paused or resumed.
+func ClusterPauseToControlPlaneMapper(client client.Client, predicates []predicate.Predicate) handler.Mapper {
+	return extensionscontroller.ClusterPauseToObjectMapper(client, func() runtime.Object { return &extensions1alpha1.ControlPlaneList{} }, predicates)
+}
@@ -15,6 +15,12 @@
 package controlplane
 
 import (
+	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+
+	"k8s.io/apimachinery/pkg/runtime"
+
 	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 )
@@ -27,3 +33,26 @@ func GenerationChangedPredicate() predicate.Predicate {
 		},
 	}
 }
+
+// OperationAnnotationPredicate is a predicate for the operation annotation.
+func OperationAnnotationPredicate() predicate.Predicate {
+	annotationExists := func(obj runtime.Object) bool {
+		cp, ok := obj.(*extensionsv1alpha1.ControlPlane)
+		if !ok {
+			return false
+		}
+		return extensionscontroller.ReconcileRequired(cp, &cp.Status.DefaultStatus)
+	}
+
+	return predicate.Funcs{
+		CreateFunc: func(event event.CreateEvent) bool {
+			return annotationExists(event.Object)
+		},
+		UpdateFunc: func(event event.UpdateEvent) bool {
+			return annotationExists(event.ObjectNew)
+		},
+		GenericFunc: func(event event.GenericEvent) bool {
+			return annotationExists(event.Object)
+		},
+	}
+}
@@ -17,8 +17,11 @@ package controlplane
 import (
 	"context"
 	"fmt"
+	"time"
 
 	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
+	controllererror "github.com/gardener/gardener-extensions/pkg/controller/error"
+	"github.com/gardener/gardener-extensions/pkg/controller/metrics"
 	"github.com/gardener/gardener-extensions/pkg/util"
 
 	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
@@ -42,9 +45,14 @@ const (
 	EventControlPlaneDeletion string = "ControlPlaneDeletion"
 )
 
+// RequeueAfter is the duration after which a successfully reconciled controlplane is reconciled again if its
+// actuator requested so.
+const RequeueAfter = 30 * time.Second
+
 type reconciler struct {
-	logger   logr.Logger
-	actuator Actuator
+	logger          logr.Logger
+	actuator        Actuator
+	errorClassifier controllererror.Classifier
 
 	ctx      context.Context
 	client   client.Client
@@ -53,11 +61,14 @@ type reconciler struct {
 
 // NewReconciler creates a new reconcile.Reconciler that reconciles
 // controlplane resources of Gardener's `extensions.gardener.cloud` API group.
-func NewReconciler(mgr manager.Manager, actuator Actuator) reconcile.Reconciler {
+// The given error classifier determines the error codes of failed operations. If it is nil or does not determine
+// any code, the default classifier is used.
+func NewReconciler(mgr manager.Manager, actuator Actuator, errorClassifier controllererror.Classifier) reconcile.Reconciler {
 	return &reconciler{
-		logger:   log.Log.WithName(ControllerName),
-		actuator: actuator,
-		recorder: mgr.GetRecorder(ControllerName),
+		logger:          log.Log.WithName(ControllerName),
+		actuator:        actuator,
+		errorClassifier: errorClassifier,
+		recorder:        mgr.GetRecorder(ControllerName),
 	}
 }
 
@@ -89,10 +100,21 @@ func (r *reconciler) Reconcile(request reconcile.Request) (reconcile.Result, err
 		return reconcile.Result{}, err
 	}
 
+	ctx := extensionscontroller.WithConditionRecorder(r.ctx, extensionscontroller.NewConditionRecorder())
+
 	if cp.DeletionTimestamp != nil {
-		return r.delete(r.ctx, cp, cluster)
+		return r.delete(ctx, cp, cluster)
+	}
+
+	paused, err := extensionscontroller.ReconciliationPaused(ctx, r.client, cp)
+	if err != nil {
+		return reconcile.Result{}, err
 	}
-	return r.reconcile(r.ctx, cp, cluster)
+	extensionscontroller.SetReconciliationPausedCondition(ctx, cp.Status.Conditions, paused)
+	if paused {
+		return r.pause(ctx, cp)
+	}
+	return r.reconcile(ctx, cp, cluster)
 }
 
 func (r *reconciler) reconcile(ctx context.Context, cp *extensionsv1alpha1.ControlPlane, cluster *extensionscontroller.Cluster) (reconcile.Result, error) {
@@ -101,19 +123,25 @@ func (r *reconciler) reconcile(ctx context.Context, cp *extensionsv1alpha1.Contr
 	}
 
 	operationType := gardencorev1alpha1helper.ComputeOperationType(cp.ObjectMeta, cp.Status.LastOperation)
+	ctx = extensionscontroller.WithProgressReporter(ctx, r.newProgressReporter(cp, operationType))
 	if err := r.updateStatusProcessing(ctx, cp, operationType, "Reconciling the controlplane"); err != nil {
 		return reconcile.Result{}, err
 	}
 
 	r.logger.Info("Starting the reconciliation of controlplane", "controlplane", cp.Name)
 	r.recorder.Event(cp, corev1.EventTypeNormal, EventControlPlaneReconciliation, "Reconciling the controlplane")
-	if err := r.actuator.Reconcile(ctx, cp, cluster); err != nil {
+	observeOperation := metrics.ObserveOperation(extensionscontroller.UnsafeGuessKind(cp), cp.Spec.Type, operationType)
+	requeue, err := r.actuator.Reconcile(ctx, cp, cluster)
+	if err != nil {
+		observeOperation(extensionscontroller.ReconcileErrCauseOrErr(err), controllererror.Codes(r.errorClassifier, extensionscontroller.ReconcileErrCauseOrErr(err))...)
 		msg := "Error reconciling controlplane"
 		_ = r.updateStatusError(ctx, extensionscontroller.ReconcileErrCauseOrErr(err), cp, operationType, msg)
 		r.logger.Error(err, msg, "controlplane", cp.Name)
 		return extensionscontroller.ReconcileErr(err)
 	}
 
+	observeOperation(nil)
+
 	msg := "Successfully reconciled controlplane"
 	r.logger.Info(msg, "controlplane", cp.Name)
 	r.recorder.Event(cp, corev1.EventTypeNormal, EventControlPlaneReconciliation, msg)
@@ -121,9 +149,20 @@ func (r *reconciler) reconcile(ctx context.Context, cp *extensionsv1alpha1.Contr
 		return reconcile.Result{}, err
 	}
 
+	if requeue {
+		r.logger.Info("Controlplane must be reconciled again", "controlplane", cp.Name, "requeueAfter", RequeueAfter)
+		return reconcile.Result{RequeueAfter: RequeueAfter}, nil
+	}
 	return reconcile.Result{}, nil
 }
 
+func (r *reconciler) pause(ctx context.Context, cp *extensionsv1alpha1.ControlPlane) (reconcile.Result, error) {
+	msg := "Reconciliation of the controlplane is paused"
+	r.logger.Info(msg, "controlplane", cp.Name)
+	r.recorder.Event(cp, corev1.EventTypeNormal, extensionscontroller.EventReconciliationPaused, msg)
+	return reconcile.Result{}, r.updateStatusConditions(ctx, cp)
+}
+
 func (r *reconciler) delete(ctx context.Context, cp *extensionsv1alpha1.ControlPlane, cluster *extensionscontroller.Cluster) (reconcile.Result, error) {
 	hasFinalizer, err := extensionscontroller.HasFinalizer(cp, FinalizerName)
 	if err != nil {
@@ -136,13 +175,16 @@ func (r *reconciler) delete(ctx context.Context, cp *extensionsv1alpha1.ControlP
 	}
 
 	operationType := gardencorev1alpha1helper.ComputeOperationType(cp.ObjectMeta, cp.Status.LastOperation)
+	ctx = extensionscontroller.WithProgressReporter(ctx, r.newProgressReporter(cp, operationType))
 	if err := r.updateStatusProcessing(ctx, cp, operationType, "Deleting the controlplane"); err != nil {
 		return reconcile.Result{}, err
 	}
 
 	r.logger.Info("Starting the deletion of controlplane", "controlplane", cp.Name)
 	r.recorder.Event(cp, corev1.EventTypeNormal, EventControlPlaneDeletion, "Deleting the cp")
-	if err := r.actuator.Delete(r.ctx, cp, cluster); err != nil {
+	observeOperation := metrics.ObserveOperation(extensionscontroller.UnsafeGuessKind(cp), cp.Spec.Type, operationType)
+	if err := r.actuator.Delete(ctx, cp, cluster); err != nil {
+		observeOperation(extensionscontroller.ReconcileErrCauseOrErr(err), controllererror.Codes(r.errorClassifier, extensionscontroller.ReconcileErrCauseOrErr(err))...)
 		msg := "Error deleting controlplane"
 		r.recorder.Eventf(cp, corev1.EventTypeWarning, EventControlPlaneDeletion, "%s: %+v", msg, err)
 		_ = r.updateStatusError(ctx, extensionscontroller.ReconcileErrCauseOrErr(err), cp, operationType, msg)
@@ -150,6 +192,8 @@ func (r *reconciler) delete(ctx context.Context, cp *extensionsv1alpha1.ControlP
 		return extensionscontroller.ReconcileErr(err)
 	}
 
+	observeOperation(nil)
+
 	msg := "Successfully deleted controlplane"
 	r.logger.Info(msg, "controlplane", cp.Name)
 	r.recorder.Event(cp, corev1.EventTypeNormal, EventControlPlaneDeletion, msg)
@@ -167,18 +211,36 @@ func (r *reconciler) delete(ctx context.Context, cp *extensionsv1alpha1.ControlP
 }
 
 func (r *reconciler) updateStatusProcessing(ctx context.Context, cp *extensionsv1alpha1.ControlPlane, lastOperationType gardencorev1alpha1.LastOperationType, description string) error {
-	cp.Status.LastOperation = extensionscontroller.LastOperation(lastOperationType, gardencorev1alpha1.LastOperationStateProcessing, 1, description)
+	cp.Status.LastOperation = extensionscontroller.LastOperation(lastOperationType, gardencorev1alpha1.LastOperationStateProcessing, extensionscontroller.LastReportedProgress(ctx, 1), description)
+	return r.client.Status().Update(ctx, cp)
+}
+
+func (r *reconciler) newProgressReporter(cp *extensionsv1alpha1.ControlPlane, lastOperationType gardencorev1alpha1.LastOperationType) extensionscontroller.ProgressReporter {
+	return extensionscontroller.NewThrottledProgressReporter(extensionscontroller.DefaultProgressReportInterval, extensionscontroller.InitialProgress(cp.Status.LastOperation, lastOperationType), func(ctx context.Context, progress int, description string) error {
+		return r.updateStatusProgress(ctx, cp, lastOperationType, progress, description)
+	})
+}
+
+func (r *reconciler) updateStatusProgress(ctx context.Context, cp *extensionsv1alpha1.ControlPlane, lastOperationType gardencorev1alpha1.LastOperationType, progress int, description string) error {
+	cp.Status.LastOperation = extensionscontroller.LastOperation(lastOperationType, gardencorev1alpha1.LastOperationStateProcessing, progress, description)
+	return r.client.Status().Update(ctx, cp)
+}
+
+func (r *reconciler) updateStatusConditions(ctx context.Context, cp *extensionsv1alpha1.ControlPlane) error {
+	cp.Status.Conditions = extensionscontroller.MergeRecordedConditions(ctx, cp.Status.Conditions)
 	return r.client.Status().Update(ctx, cp)
 }
 
 func (r *reconciler) updateStatusError(ctx context.Context, err error, cp *extensionsv1alpha1.ControlPlane, lastOperationType gardencorev1alpha1.LastOperationType, description string) error {
 	cp.Status.ObservedGeneration = cp.Generation
-	cp.Status.LastOperation, cp.Status.LastError = extensionscontroller.ReconcileError(lastOperationType, gardencorev1alpha1helper.FormatLastErrDescription(fmt.Errorf("%s: %v", description, err)), 50, gardencorev1alpha1helper.ExtractErrorCodes(err)...)
+	cp.Status.Conditions = extensionscontroller.MergeRecordedConditions(ctx, cp.Status.Conditions)
+	cp.Status.LastOperation, cp.Status.LastError = extensionscontroller.ReconcileError(lastOperationType, gardencorev1alpha1helper.FormatLastErrDescription(fmt.Errorf("%s: %v", description, err)), extensionscontroller.LastReportedProgress(ctx, 1), controllererror.Codes(r.errorClassifier, err)...)
 	return r.client.Status().Update(ctx, cp)
 }
 
 func (r *reconciler) updateStatusSuccess(ctx context.Context, cp *extensionsv1alpha1.ControlPlane, lastOperationType gardencorev1alpha1.LastOperationType, description string) error {
 	cp.Status.ObservedGeneration = cp.Generation
+	cp.Status.Conditions = extensionscontroller.MergeRecordedConditions(ctx, cp.Status.Conditions)
 	cp.Status.LastOperation, cp.Status.LastError = extensionscontroller.ReconcileSucceeded(lastOperationType, description)
 	return r.client.Status().Update(ctx, cp)
 }
@@ -0,0 +1,162 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package error
+
+import (
+	"regexp"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	gardencorev1alpha1helper "github.com/gardener/gardener/pkg/apis/core/v1alpha1/helper"
+	"github.com/gardener/gardener/pkg/utils"
+	"github.com/pkg/errors"
+)
+
+// Classifier determines the Gardener error codes of errors.
+type Classifier interface {
+	// Classify returns the error codes for the given error. It returns nil if no code could be determined.
+	Classify(err error) []gardencorev1alpha1.ErrorCode
+}
+
+// ClassifierFunc is a function that implements Classifier.
+type ClassifierFunc func(err error) []gardencorev1alpha1.ErrorCode
+
+// Classify implements Classifier.
+func (f ClassifierFunc) Classify(err error) []gardencorev1alpha1.ErrorCode {
+	return f(err)
+}
+
+// DefaultClassifier extracts the codes of errors implementing the Gardener Coder interface. If there are none,
+// it falls back to the generic error message based detection of Gardener.
+var DefaultClassifier Classifier = ClassifierFunc(classifyDefault)
+
+func classifyDefault(err error) []gardencorev1alpha1.ErrorCode {
+	if err == nil {
+		return nil
+	}
+
+	if codes := explicitCodes(err); len(codes) > 0 {
+		return codes
+	}
+	return gardencorev1alpha1helper.ExtractErrorCodes(gardencorev1alpha1helper.DetermineError(err.Error()))
+}
+
+// explicitCodes returns the unique codes of all causes of the given error that implement the Gardener Coder interface.
+func explicitCodes(err error) []gardencorev1alpha1.ErrorCode {
+	var codes []gardencorev1alpha1.ErrorCode
+	for _, err := range Errors(err) {
+		codes = append(codes, gardencorev1alpha1helper.ExtractErrorCodes(err)...)
+	}
+	return UniqueCodes(codes)
+}
+
+// NewClassifier returns a Classifier that consults the given classifiers in order and returns
+// the codes of the first one that determines any.
+func NewClassifier(classifiers ...Classifier) Classifier {
+	return ClassifierFunc(func(err error) []gardencorev1alpha1.ErrorCode {
+		for _, classifier := range classifiers {
+			if codes := classifier.Classify(err); len(codes) > 0 {
+				return codes
+			}
+		}
+		return nil
+	})
+}
+
+// Pattern maps error messages matching the Regexp to the Code.
+type Pattern struct {
+	// Regexp is the regular expression the error message is matched against.
+	Regexp *regexp.Regexp
+	// Code is the error code of matching errors.
+	Code gardencorev1alpha1.ErrorCode
+}
+
+// NewPattern creates a new Pattern for the given expression and code. It panics if the expression cannot be parsed.
+func NewPattern(expr string, code gardencorev1alpha1.ErrorCode) Pattern {
+	return Pattern{regexp.MustCompile(expr), code}
+}
+
+// NewPatternClassifier returns a Classifier that matches the messages of the given error against
+// the given patterns. The codes of all matching patterns are returned.
+func NewPatternClassifier(patterns ...Pattern) Classifier {
+	return ClassifierFunc(func(err error) []gardencorev1alpha1.ErrorCode {
+		if err == nil {
+			return nil
+		}
+
+		return MatchPatterns(err.Error(), patterns...)
+	})
+}
+
+// MatchPatterns returns the codes of all given patterns the message matches.
+func MatchPatterns(message string, patterns ...Pattern) []gardencorev1alpha1.ErrorCode {
+	var codes []gardencorev1alpha1.ErrorCode
+	for _, pattern := range patterns {
+		if pattern.Regexp.MatchString(message) {
+			codes = append(codes, pattern.Code)
+		}
+	}
+	return UniqueCodes(codes)
+}
+
+// Codes returns the error codes for the given error. Codes that were set explicitly (e.g. via
+// gardencorev1alpha1helper.NewErrorWithCode) always win. Otherwise, the given classifier is consulted and,
+// if it is nil or does not determine any code, the message based detection of the DefaultClassifier is used.
+func Codes(classifier Classifier, err error) []gardencorev1alpha1.ErrorCode {
+	if err == nil {
+		return nil
+	}
+
+	if codes := explicitCodes(err); len(codes) > 0 {
+		return codes
+	}
+	if classifier != nil {
+		if codes := classifier.Classify(err); len(codes) > 0 {
+			return codes
+		}
+	}
+	return DefaultClassifier.Classify(err)
+}
+
+// Errors returns the causes of the given error. Multi errors are flattened, and RequeueAfterErrors as well
+// as errors annotated with github.com/pkg/errors are unwrapped.
+func Errors(err error) []error {
+	var out []error
+	for _, err := range utils.Errors(err) {
+		err = errors.Cause(err)
+		if requeueAfter, ok := err.(*RequeueAfterError); ok && requeueAfter.Cause != nil {
+			out = append(out, Errors(requeueAfter.Cause)...)
+			continue
+		}
+		out = append(out, err)
+	}
+	return out
+}
+
+// UniqueCodes returns the given codes without duplicates, preserving their order.
+func UniqueCodes(codes []gardencorev1alpha1.ErrorCode) []gardencorev1alpha1.ErrorCode {
+	var (
+		out  []gardencorev1alpha1.ErrorCode
+		seen = make(map[gardencorev1alpha1.ErrorCode]struct{}, len(codes))
+	)
+
+	for _, code := range codes {
+		if _, ok := seen[code]; ok {
+			continue
+		}
+		seen[code] = struct{}{}
+		out = append(out, code)
+	}
+	return out
+}
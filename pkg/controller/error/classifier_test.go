@@ -0,0 +1,125 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package error_test
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	controllererror "github.com/gardener/gardener-extensions/pkg/controller/error"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	gardencorev1alpha1helper "github.com/gardener/gardener/pkg/apis/core/v1alpha1/helper"
+	"github.com/hashicorp/go-multierror"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	pkgerrors "github.com/pkg/errors"
+)
+
+var _ = Describe("Classifier", func() {
+	var (
+		quotaPattern     = controllererror.NewPattern(`quotaExceeded`, gardencorev1alpha1.ErrorInfraQuotaExceeded)
+		forbiddenPattern = controllererror.NewPattern(`forbidden`, gardencorev1alpha1.ErrorInfraInsufficientPrivileges)
+	)
+
+	Describe("#DefaultClassifier", func() {
+		It("should return nil for nil errors", func() {
+			Expect(controllererror.DefaultClassifier.Classify(nil)).To(BeNil())
+		})
+
+		It("should extract the codes of wrapped coders", func() {
+			err := &controllererror.RequeueAfterError{
+				Cause:        pkgerrors.Wrap(gardencorev1alpha1helper.NewErrorWithCode(gardencorev1alpha1.ErrorInfraDependencies, "foo"), "bar"),
+				RequeueAfter: time.Second,
+			}
+
+			Expect(controllererror.DefaultClassifier.Classify(err)).To(Equal([]gardencorev1alpha1.ErrorCode{gardencorev1alpha1.ErrorInfraDependencies}))
+		})
+
+		It("should fall back to the message based detection", func() {
+			err := fmt.Errorf("failed: %v", errors.New("LimitExceeded"))
+
+			Expect(controllererror.DefaultClassifier.Classify(err)).To(Equal([]gardencorev1alpha1.ErrorCode{gardencorev1alpha1.ErrorInfraQuotaExceeded}))
+		})
+
+		It("should return nil if no code can be determined", func() {
+			Expect(controllererror.DefaultClassifier.Classify(errors.New("foo"))).To(BeNil())
+		})
+	})
+
+	Describe("#NewPatternClassifier", func() {
+		It("should return the unique codes of all matching patterns", func() {
+			classifier := controllererror.NewPatternClassifier(quotaPattern, forbiddenPattern, quotaPattern)
+
+			Expect(classifier.Classify(errors.New("quotaExceeded, forbidden"))).To(Equal([]gardencorev1alpha1.ErrorCode{
+				gardencorev1alpha1.ErrorInfraQuotaExceeded,
+				gardencorev1alpha1.ErrorInfraInsufficientPrivileges,
+			}))
+		})
+	})
+
+	Describe("#NewClassifier", func() {
+		It("should return the codes of the first classifier that determines any", func() {
+			classifier := controllererror.NewClassifier(
+				controllererror.NewPatternClassifier(quotaPattern),
+				controllererror.NewPatternClassifier(forbiddenPattern),
+				controllererror.NewPatternClassifier(controllererror.NewPattern(`forbidden`, gardencorev1alpha1.ErrorInfraUnauthorized)),
+			)
+
+			Expect(classifier.Classify(errors.New("forbidden"))).To(Equal([]gardencorev1alpha1.ErrorCode{gardencorev1alpha1.ErrorInfraInsufficientPrivileges}))
+			Expect(classifier.Classify(errors.New("foo"))).To(BeNil())
+		})
+	})
+
+	Describe("#Codes", func() {
+		It("should prefer explicitly set codes over the given classifier", func() {
+			err := pkgerrors.Wrap(gardencorev1alpha1helper.NewErrorWithCode(gardencorev1alpha1.ErrorInfraUnauthorized, "forbidden"), "failed")
+
+			Expect(controllererror.Codes(controllererror.NewPatternClassifier(forbiddenPattern), err)).To(Equal([]gardencorev1alpha1.ErrorCode{gardencorev1alpha1.ErrorInfraUnauthorized}))
+		})
+
+		It("should use the given classifier if no codes were set explicitly", func() {
+			err := errors.New("forbidden: LimitExceeded")
+
+			Expect(controllererror.Codes(controllererror.NewPatternClassifier(forbiddenPattern), err)).To(Equal([]gardencorev1alpha1.ErrorCode{gardencorev1alpha1.ErrorInfraInsufficientPrivileges}))
+		})
+
+		It("should fall back to the message based detection", func() {
+			err := errors.New("LimitExceeded")
+
+			Expect(controllererror.Codes(nil, err)).To(Equal([]gardencorev1alpha1.ErrorCode{gardencorev1alpha1.ErrorInfraQuotaExceeded}))
+			Expect(controllererror.Codes(controllererror.NewPatternClassifier(forbiddenPattern), err)).To(Equal([]gardencorev1alpha1.ErrorCode{gardencorev1alpha1.ErrorInfraQuotaExceeded}))
+		})
+
+		It("should return nil for nil errors", func() {
+			Expect(controllererror.Codes(controllererror.NewPatternClassifier(forbiddenPattern), nil)).To(BeNil())
+		})
+	})
+
+	Describe("#Errors", func() {
+		It("should flatten and unwrap the errors", func() {
+			var (
+				err1 = errors.New("foo")
+				err2 = errors.New("bar")
+			)
+
+			Expect(controllererror.Errors(&multierror.Error{Errors: []error{
+				pkgerrors.Wrap(err1, "baz"),
+				&controllererror.RequeueAfterError{Cause: err2},
+			}})).To(Equal([]error{err1, err2}))
+		})
+	})
+})
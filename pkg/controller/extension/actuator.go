@@ -17,7 +17,12 @@ package extension
 import (
 	"context"
 
+	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
+
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/runtime/inject"
 )
 
 // Actuator acts upon Extension resources.
@@ -27,3 +32,36 @@ type Actuator interface {
 	// Delete the Extension resource.
 	Delete(ctx context.Context, ex *extensionsv1alpha1.Extension) error
 }
+
+type operationAnnotationWrapper struct {
+	Actuator
+	client client.Client
+}
+
+// OperationAnnotationWrapper is a wrapper for an actuator that, after a successful reconcile,
+// removes the Gardener operation annotation.
+//
+// This is useful in conjunction with the OperationAnnotationPredicate.
+func OperationAnnotationWrapper(actuator Actuator) Actuator {
+	return &operationAnnotationWrapper{Actuator: actuator}
+}
+
+// InjectClient implements inject.Client.
+func (o *operationAnnotationWrapper) InjectClient(client client.Client) error {
+	o.client = client
+	return nil
+}
+
+// InjectFunc implements inject.Func.
+func (o *operationAnnotationWrapper) InjectFunc(f inject.Func) error {
+	return f(o.Actuator)
+}
+
+// Reconcile implements Actuator.
+func (o *operationAnnotationWrapper) Reconcile(ctx context.Context, ex *extensionsv1alpha1.Extension) error {
+	if err := extensionscontroller.RemoveOperationAnnotation(ctx, o.client, ex); err != nil {
+		return err
+	}
+
+	return o.Actuator.Reconcile(ctx, ex)
+}
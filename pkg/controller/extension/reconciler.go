@@ -30,6 +30,8 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/source"
 
 	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
+	controllererror "github.com/gardener/gardener-extensions/pkg/controller/error"
+	"github.com/gardener/gardener-extensions/pkg/controller/metrics"
 	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
 	gardencorev1alpha1helper "github.com/gardener/gardener/pkg/apis/core/v1alpha1/helper"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
@@ -73,6 +75,31 @@ func Add(mgr manager.Manager, args AddArgs) error {
 	return add(mgr, args)
 }
 
+// DefaultPredicates returns the default predicates for an Extension reconciler.
+func DefaultPredicates(client client.Client, ignoreOperationAnnotation bool) []predicate.Predicate {
+	if ignoreOperationAnnotation {
+		return []predicate.Predicate{
+			extensionscontroller.ReconciliationNotPausedPredicate(client),
+			extensionscontroller.OrPredicate(
+				extensionscontroller.GenerationChangedPredicate(),
+				extensionscontroller.PauseReconciliationAnnotationChangedPredicate(),
+			),
+		}
+	}
+
+	return []predicate.Predicate{
+		extensionscontroller.ReconciliationNotPausedPredicate(client),
+		extensionscontroller.OrPredicate(
+			OperationAnnotationPredicate(),
+			extensionscontroller.PauseReconciliationAnnotationChangedPredicate(),
+		),
+		extensionscontroller.OrPredicate(
+			extensionscontroller.GenerationChangedPredicate(),
+			extensionscontroller.AnnotationsChangedPredicate(),
+		),
+	}
+}
+
 func add(mgr manager.Manager, args AddArgs) error {
 	ctrl, err := controller.New(args.Name, mgr, args.ControllerOptions)
 	if err != nil {
@@ -80,7 +107,7 @@ func add(mgr manager.Manager, args AddArgs) error {
 	}
 
 	if args.Predicates == nil {
-		args.Predicates = append(args.Predicates, extensionscontroller.GenerationChangedPredicate())
+		args.Predicates = DefaultPredicates(mgr.GetClient(), true)
 	}
 	args.Predicates = append(args.Predicates, extensionscontroller.TypePredicate(args.Type))
 
@@ -89,6 +116,11 @@ func add(mgr manager.Manager, args AddArgs) error {
 		return err
 	}
 
+	// Pause and resume the reconciliation of the Extension resources together with their cluster.
+	if err := ctrl.Watch(&source.Kind{Type: &extensionsv1alpha1.Cluster{}}, &handler.EnqueueRequestsFromMapFunc{ToRequests: ClusterPauseToExtensionMapper(mgr.GetClient(), args.Predicates)}, extensionscontroller.PauseReconciliationAnnotationChangedPredicate()); err != nil {
+		return err
+	}
+
 	// Add additional watches to the controller besides the standard one.
 	return args.WatchBuilder.AddToController(ctrl)
 }
@@ -154,11 +186,23 @@ func (r *reconciler) Reconcile(request reconcile.Request) (reconcile.Result, err
 		err    error
 	)
 
+	ctx := extensionscontroller.WithConditionRecorder(r.ctx, extensionscontroller.NewConditionRecorder())
+
 	if ex.DeletionTimestamp != nil {
-		return r.delete(r.ctx, ex)
+		return r.delete(ctx, ex)
+	}
+
+	paused, err := extensionscontroller.ReconciliationPaused(ctx, r.client, ex)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+	extensionscontroller.SetReconciliationPausedCondition(ctx, ex.Status.Conditions, paused)
+	if paused {
+		r.logger.Info("Reconciliation of Extension resource is paused", "extension", ex.Name, "namespace", ex.Namespace)
+		return reconcile.Result{}, r.updateStatusConditions(ctx, ex)
 	}
 
-	result, err = r.reconcile(r.ctx, ex)
+	result, err = r.reconcile(ctx, ex)
 	if err != nil {
 		return result, err
 	}
@@ -177,13 +221,17 @@ func (r *reconciler) reconcile(ctx context.Context, ex *extensionsv1alpha1.Exten
 		return reconcile.Result{}, err
 	}
 
+	observeOperation := metrics.ObserveOperation(extensionscontroller.UnsafeGuessKind(ex), ex.Spec.Type, operationType)
 	if err := r.actuator.Reconcile(ctx, ex); err != nil {
+		observeOperation(extensionscontroller.ReconcileErrCauseOrErr(err))
 		msg := "Unable to reconcile Extension resource"
 		_ = r.updateStatusError(ctx, extensionscontroller.ReconcileErrCauseOrErr(err), ex, operationType, msg)
 		r.logger.Error(err, msg, "extension", ex.Name, "namespace", ex.Namespace)
 		return extensionscontroller.ReconcileErr(err)
 	}
 
+	observeOperation(nil)
+
 	msg = "Successfully reconciled Extension resource"
 	r.logger.Info(msg, "extension", ex.Name, "namespace", ex.Namespace)
 	if err := r.updateStatusSuccess(ctx, ex, operationType, msg); err != nil {
@@ -209,13 +257,17 @@ func (r *reconciler) delete(ctx context.Context, ex *extensionsv1alpha1.Extensio
 		return reconcile.Result{}, err
 	}
 
+	observeOperation := metrics.ObserveOperation(extensionscontroller.UnsafeGuessKind(ex), ex.Spec.Type, operationType)
 	if err := r.actuator.Delete(ctx, ex); err != nil {
+		observeOperation(extensionscontroller.ReconcileErrCauseOrErr(err))
 		msg := "Error deleting Extension resource"
 		_ = r.updateStatusError(ctx, extensionscontroller.ReconcileErrCauseOrErr(err), ex, operationType, msg)
 		r.logger.Error(err, msg, "extension", ex.Name, "namespace", ex.Namespace)
 		return extensionscontroller.ReconcileErr(err)
 	}
 
+	observeOperation(nil)
+
 	msg := "Successfully deleted Extension resource"
 	r.logger.Info(msg, "extension", ex.Name, "namespace", ex.Namespace)
 	if err := r.updateStatusSuccess(ctx, ex, operationType, msg); err != nil {
@@ -237,10 +289,18 @@ func (r *reconciler) updateStatusProcessing(ctx context.Context, ex *extensionsv
 	})
 }
 
+func (r *reconciler) updateStatusConditions(ctx context.Context, ex *extensionsv1alpha1.Extension) error {
+	return extensionscontroller.TryUpdateStatus(ctx, retry.DefaultBackoff, r.client, ex, func() error {
+		ex.Status.Conditions = extensionscontroller.MergeRecordedConditions(ctx, ex.Status.Conditions)
+		return nil
+	})
+}
+
 func (r *reconciler) updateStatusError(ctx context.Context, err error, ex *extensionsv1alpha1.Extension, lastOperationType gardencorev1alpha1.LastOperationType, description string) error {
 	return extensionscontroller.TryUpdateStatus(ctx, retry.DefaultBackoff, r.client, ex, func() error {
 		ex.Status.ObservedGeneration = ex.Generation
-		ex.Status.LastOperation, ex.Status.LastError = extensionscontroller.ReconcileError(lastOperationType, gardencorev1alpha1helper.FormatLastErrDescription(fmt.Errorf("%s: %v", description, err)), 50, gardencorev1alpha1helper.ExtractErrorCodes(err)...)
+		ex.Status.Conditions = extensionscontroller.MergeRecordedConditions(ctx, ex.Status.Conditions)
+		ex.Status.LastOperation, ex.Status.LastError = extensionscontroller.ReconcileError(lastOperationType, gardencorev1alpha1helper.FormatLastErrDescription(fmt.Errorf("%s: %v", description, err)), 50, controllererror.Codes(nil, err)...)
 		return nil
 	})
 }
@@ -248,6 +308,7 @@ func (r *reconciler) updateStatusError(ctx context.Context, err error, ex *exten
 func (r *reconciler) updateStatusSuccess(ctx context.Context, ex *extensionsv1alpha1.Extension, lastOperationType gardencorev1alpha1.LastOperationType, description string) error {
 	return extensionscontroller.TryUpdateStatus(ctx, retry.DefaultBackoff, r.client, ex, func() error {
 		ex.Status.ObservedGeneration = ex.Generation
+		ex.Status.Conditions = extensionscontroller.MergeRecordedConditions(ctx, ex.Status.Conditions)
 		ex.Status.LastOperation, ex.Status.LastError = extensionscontroller.ReconcileSucceeded(lastOperationType, description)
 		return nil
 	})
@@ -16,11 +16,11 @@ package infrastructure
 
 import (
 	"context"
+	"fmt"
 
 	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
-	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	"github.com/gardener/gardener-extensions/pkg/gardener/terraformer"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
-	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/runtime/inject"
 )
@@ -42,8 +42,14 @@ type operationAnnotationWrapper struct {
 // removes the Gardener operation annotation.
 //
 // This is useful in conjunction with the OperationAnnotationPredicate.
+//
+// The returned actuator only implements DriftDetector if the given actuator does.
 func OperationAnnotationWrapper(actuator Actuator) Actuator {
-	return &operationAnnotationWrapper{Actuator: actuator}
+	wrapper := &operationAnnotationWrapper{Actuator: actuator}
+	if detector, ok := actuator.(DriftDetector); ok {
+		return &driftDetectingOperationAnnotationWrapper{wrapper, detector}
+	}
+	return wrapper
 }
 
 // InjectClient implements inject.Client.
@@ -59,12 +65,54 @@ func (o *operationAnnotationWrapper) InjectFunc(f inject.Func) error {
 
 // Reconcile implements Actuator.
 func (o *operationAnnotationWrapper) Reconcile(ctx context.Context, infra *extensionsv1alpha1.Infrastructure, cluster *extensionscontroller.Cluster) error {
-	if kutil.HasMetaDataAnnotation(&infra.ObjectMeta, gardencorev1alpha1.GardenerOperation, gardencorev1alpha1.GardenerOperationReconcile) {
-		delete(infra.Annotations, gardencorev1alpha1.GardenerOperation)
-		if err := o.client.Update(ctx, infra); err != nil {
-			return err
-		}
+	if err := extensionscontroller.RemoveOperationAnnotation(ctx, o.client, infra); err != nil {
+		return err
 	}
 
 	return o.Actuator.Reconcile(ctx, infra, cluster)
 }
+
+// Plan implements Planner.
+func (o *operationAnnotationWrapper) Plan(ctx context.Context, infra *extensionsv1alpha1.Infrastructure, cluster *extensionscontroller.Cluster) (*terraformer.Plan, error) {
+	planner, ok := o.Actuator.(Planner)
+	if !ok {
+		return nil, fmt.Errorf("actuator for infrastructure type %q does not support planning", infra.Spec.Type)
+	}
+
+	if err := extensionscontroller.RemoveOperationAnnotation(ctx, o.client, infra); err != nil {
+		return nil, err
+	}
+
+	return planner.Plan(ctx, infra, cluster)
+}
+
+// Migrate implements Migrator.
+func (o *operationAnnotationWrapper) Migrate(ctx context.Context, infra *extensionsv1alpha1.Infrastructure, cluster *extensionscontroller.Cluster) (string, error) {
+	migrator, ok := o.Actuator.(Migrator)
+	if !ok {
+		return "", fmt.Errorf("actuator for infrastructure type %q does not support migration", infra.Spec.Type)
+	}
+
+	return migrator.Migrate(ctx, infra, cluster)
+}
+
+// Restore implements Migrator.
+func (o *operationAnnotationWrapper) Restore(ctx context.Context, infra *extensionsv1alpha1.Infrastructure, cluster *extensionscontroller.Cluster, state string) error {
+	migrator, ok := o.Actuator.(Migrator)
+	if !ok {
+		return fmt.Errorf("actuator for infrastructure type %q does not support migration", infra.Spec.Type)
+	}
+
+	return migrator.Restore(ctx, infra, cluster, state)
+}
+
+// driftDetectingOperationAnnotationWrapper is an operationAnnotationWrapper for actuators that implement DriftDetector.
+type driftDetectingOperationAnnotationWrapper struct {
+	*operationAnnotationWrapper
+	detector DriftDetector
+}
+
+// DetectDrift implements DriftDetector.
+func (o *driftDetectingOperationAnnotationWrapper) DetectDrift(ctx context.Context, infra *extensionsv1alpha1.Infrastructure, cluster *extensionscontroller.Cluster) ([]string, error) {
+	return o.detector.DetectDrift(ctx, infra, cluster)
+}
@@ -15,7 +15,10 @@
 package infrastructure
 
 import (
+	"time"
+
 	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
+	controllererror "github.com/gardener/gardener-extensions/pkg/controller/error"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -48,6 +51,19 @@ type AddArgs struct {
 	Predicates []predicate.Predicate
 	// WatchBuilder defines additional watches on controllers that should be set up.
 	WatchBuilder extensionscontroller.WatchBuilder
+	// ErrorClassifier determines the error codes of failed operations.
+	// If unset, the default classifier will be used.
+	ErrorClassifier controllererror.Classifier
+	// DryRun specifies whether the changes to the infrastructure are only planned instead of being applied.
+	// It requires the Actuator to implement Planner.
+	DryRun bool
+	// ResyncPeriod is the period after which successfully reconciled infrastructures are checked for drift.
+	// If unset, infrastructures are not resynced.
+	ResyncPeriod time.Duration
+	// AutoRepairDrift specifies whether infrastructures are reconciled again if drift is detected while
+	// resyncing. Infrastructures whose Actuator does not implement DriftDetector are never reconciled while
+	// resyncing, their drift condition is reported as unknown instead.
+	AutoRepairDrift bool
 }
 
 // DefaultPredicates returns the default predicates for an infrastructure reconciler.
@@ -56,14 +72,25 @@ func DefaultPredicates(client client.Client, typeName string, ignoreOperationAnn
 		return []predicate.Predicate{
 			extensionscontroller.TypePredicate(typeName),
 			extensionscontroller.ShootFailedPredicate(client),
-			extensionscontroller.GenerationChangedPredicate(),
+			extensionscontroller.ReconciliationNotPausedPredicate(client),
+			extensionscontroller.OrPredicate(
+				extensionscontroller.GenerationChangedPredicate(),
+				extensionscontroller.PauseReconciliationAnnotationChangedPredicate(),
+				DryRunAnnotationChangedPredicate(),
+				extensionscontroller.MigrationOperationAnnotationPredicate(),
+			),
 		}
 	}
 
 	return []predicate.Predicate{
 		extensionscontroller.TypePredicate(typeName),
 		extensionscontroller.ShootFailedPredicate(client),
-		OperationAnnotationPredicate(),
+		extensionscontroller.ReconciliationNotPausedPredicate(client),
+		extensionscontroller.OrPredicate(
+			OperationAnnotationPredicate(),
+			extensionscontroller.PauseReconciliationAnnotationChangedPredicate(),
+			DryRunAnnotationChangedPredicate(),
+		),
 		extensionscontroller.OrPredicate(
 			extensionscontroller.GenerationChangedPredicate(),
 			extensionscontroller.AnnotationsChangedPredicate(),
@@ -74,7 +101,12 @@ func DefaultPredicates(client client.Client, typeName string, ignoreOperationAnn
 // Add creates a new Infrastructure Controller and adds it to the Manager.
 // and Start it when the Manager is Started.
 func Add(mgr manager.Manager, args AddArgs) error {
-	args.ControllerOptions.Reconciler = NewReconciler(mgr, args.Actuator)
+	args.ControllerOptions.Reconciler = NewReconciler(mgr, args.Actuator, ReconcilerOptions{
+		ErrorClassifier: args.ErrorClassifier,
+		DryRun:          args.DryRun,
+		ResyncPeriod:    args.ResyncPeriod,
+		AutoRepairDrift: args.AutoRepairDrift,
+	})
 	return add(mgr, args)
 }
 
@@ -91,6 +123,10 @@ func add(mgr manager.Manager, args AddArgs) error {
 	if err := ctrl.Watch(&source.Kind{Type: &corev1.Secret{}}, &handler.EnqueueRequestsFromMapFunc{ToRequests: SecretToInfrastructureMapper(mgr.GetClient(), args.Predicates)}); err != nil {
 		return err
 	}
+	// Pause and resume the reconciliation of the infrastructures together with their cluster.
+	if err := ctrl.Watch(&source.Kind{Type: &extensionsv1alpha1.Cluster{}}, &handler.EnqueueRequestsFromMapFunc{ToRequests: ClusterPauseToInfrastructureMapper(mgr.GetClient(), args.Predicates)}, extensionscontroller.PauseReconciliationAnnotationChangedPredicate()); err != nil {
+		return err
+	}
 
 	// Add additional watches to the controller besides the standard one.
 	err = args.WatchBuilder.AddToController(ctrl)
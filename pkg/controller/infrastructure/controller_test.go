@@ -0,0 +1,147 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package infrastructure_test
+
+import (
+	"context"
+
+	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
+	. "github.com/gardener/gardener-extensions/pkg/controller/infrastructure"
+	mockclient "github.com/gardener/gardener-extensions/pkg/mock/controller-runtime/client"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+)
+
+var _ = Describe("Controller", func() {
+	const namespace = "shoot--foo--bar"
+
+	var (
+		ctrl *gomock.Controller
+		c    *mockclient.MockClient
+
+		infrastructure *extensionsv1alpha1.Infrastructure
+
+		updateWithAnnotations = func(annotations map[string]string) event.UpdateEvent {
+			infrastructureNew := infrastructure.DeepCopy()
+			infrastructureNew.Annotations = annotations
+			return event.UpdateEvent{
+				MetaOld:   infrastructure,
+				ObjectOld: infrastructure,
+				MetaNew:   infrastructureNew,
+				ObjectNew: infrastructureNew,
+			}
+		}
+
+		evalUpdate = func(predicates []predicate.Predicate, e event.UpdateEvent) bool {
+			for _, p := range predicates {
+				if !p.Update(e) {
+					return false
+				}
+			}
+			return true
+		}
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		c = mockclient.NewMockClient(ctrl)
+
+		c.EXPECT().Get(gomock.Any(), kutil.Key(namespace), gomock.AssignableToTypeOf(&extensionsv1alpha1.Cluster{})).
+			DoAndReturn(func(_ context.Context, _ interface{}, obj *extensionsv1alpha1.Cluster) error {
+				obj.Name = namespace
+				obj.Spec = extensionsv1alpha1.ClusterSpec{
+					CloudProfile: runtime.RawExtension{Raw: []byte(`{"apiVersion":"garden.sapcloud.io/v1beta1","kind":"CloudProfile"}`)},
+					Seed:         runtime.RawExtension{Raw: []byte(`{"apiVersion":"garden.sapcloud.io/v1beta1","kind":"Seed"}`)},
+					Shoot:        runtime.RawExtension{Raw: []byte(`{"apiVersion":"garden.sapcloud.io/v1beta1","kind":"Shoot"}`)},
+				}
+				return nil
+			}).AnyTimes()
+
+		infrastructure = &extensionsv1alpha1.Infrastructure{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:  namespace,
+				Name:       "infrastructure",
+				Generation: 1,
+			},
+			Spec: extensionsv1alpha1.InfrastructureSpec{
+				DefaultSpec: extensionsv1alpha1.DefaultSpec{Type: "aws"},
+			},
+			Status: extensionsv1alpha1.InfrastructureStatus{
+				DefaultStatus: extensionsv1alpha1.DefaultStatus{
+					ObservedGeneration: 1,
+					LastOperation: &gardencorev1alpha1.LastOperation{
+						Type:  gardencorev1alpha1.LastOperationTypeReconcile,
+						State: gardencorev1alpha1.LastOperationStateSucceeded,
+					},
+				},
+			},
+		}
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Describe("#DefaultPredicates", func() {
+		Context("respecting the operation annotation", func() {
+			var predicates []predicate.Predicate
+
+			BeforeEach(func() {
+				predicates = DefaultPredicates(c, "aws", false)
+			})
+
+			It("should let updates that pause the reconciliation pass", func() {
+				e := updateWithAnnotations(map[string]string{extensionscontroller.PauseReconciliationAnnotation: "true"})
+
+				Expect(evalUpdate(predicates, e)).To(BeTrue())
+			})
+
+			It("should let updates that resume the reconciliation pass", func() {
+				infrastructure.Annotations = map[string]string{extensionscontroller.PauseReconciliationAnnotation: "true"}
+				e := updateWithAnnotations(nil)
+
+				Expect(evalUpdate(predicates, e)).To(BeTrue())
+			})
+
+			It("should let updates that add the dry-run annotation pass", func() {
+				e := updateWithAnnotations(map[string]string{DryRunAnnotation: "true"})
+
+				Expect(evalUpdate(predicates, e)).To(BeTrue())
+			})
+
+			It("should let updates that remove the dry-run annotation pass", func() {
+				infrastructure.Annotations = map[string]string{DryRunAnnotation: "true"}
+				e := updateWithAnnotations(nil)
+
+				Expect(evalUpdate(predicates, e)).To(BeTrue())
+			})
+
+			It("should drop other annotation changes without the operation annotation", func() {
+				e := updateWithAnnotations(map[string]string{"foo": "bar"})
+
+				Expect(evalUpdate(predicates, e)).To(BeFalse())
+			})
+		})
+	})
+})
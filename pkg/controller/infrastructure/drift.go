@@ -0,0 +1,87 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package infrastructure
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
+	"github.com/gardener/gardener-extensions/pkg/gardener/terraformer"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	gardencorev1alpha1helper "github.com/gardener/gardener/pkg/apis/core/v1alpha1/helper"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+)
+
+const (
+	// ConditionTypeInfrastructureDrift is a condition type indicating whether the live state of the infrastructure
+	// has drifted from the Terraform state.
+	ConditionTypeInfrastructureDrift gardencorev1alpha1.ConditionType = "InfrastructureDrift"
+
+	// EventInfrastructureDrift an event reason to describe infrastructure drift.
+	EventInfrastructureDrift string = "InfrastructureDrift"
+)
+
+// DriftDetector detects differences between the Terraform state of Infrastructure resources and the live
+// state of the resources at the cloud provider.
+type DriftDetector interface {
+	// DetectDrift returns a description for each infrastructure resource that has drifted from its Terraform state.
+	// It returns no descriptions if the infrastructure has not drifted.
+	DetectDrift(context.Context, *extensionsv1alpha1.Infrastructure, *extensionscontroller.Cluster) ([]string, error)
+}
+
+// DriftFromPlan returns a description for each resource Terraform plans to change. As an infrastructure that has
+// already been reconciled only has planned changes if its cloud resources were modified outside of Terraform, this
+// can be used to detect drift without checking the cloud resources individually.
+func DriftFromPlan(plan *terraformer.Plan) []string {
+	if !plan.HasChanges() {
+		return nil
+	}
+	if len(plan.Resources) == 0 {
+		return []string{fmt.Sprintf("Terraform plans changes: %s", plan)}
+	}
+
+	drift := make([]string, 0, len(plan.Resources))
+	for _, resource := range plan.Resources {
+		drift = append(drift, fmt.Sprintf("%s must be %s", resource.Address, driftActions[resource.Action]))
+	}
+	return drift
+}
+
+var driftActions = map[terraformer.PlanAction]string{
+	terraformer.PlanActionCreate:  "created",
+	terraformer.PlanActionUpdate:  "updated in-place",
+	terraformer.PlanActionDestroy: "destroyed",
+	terraformer.PlanActionReplace: "replaced",
+}
+
+// setDriftCondition records the InfrastructureDrift condition for the given drift descriptions.
+func setDriftCondition(ctx context.Context, drift []string) {
+	if len(drift) > 0 {
+		extensionscontroller.SetCondition(ctx, ConditionTypeInfrastructureDrift, gardencorev1alpha1.ConditionTrue, "DriftDetected", strings.Join(drift, "; "))
+		return
+	}
+	extensionscontroller.SetCondition(ctx, ConditionTypeInfrastructureDrift, gardencorev1alpha1.ConditionFalse, "NoDriftDetected", "The infrastructure has not drifted.")
+}
+
+// resetDriftCondition marks an existing InfrastructureDrift condition as resolved after the infrastructure
+// has been reconciled.
+func resetDriftCondition(ctx context.Context, conditions []gardencorev1alpha1.Condition) {
+	if gardencorev1alpha1helper.GetCondition(conditions, ConditionTypeInfrastructureDrift) != nil {
+		extensionscontroller.SetCondition(ctx, ConditionTypeInfrastructureDrift, gardencorev1alpha1.ConditionFalse, "InfrastructureReconciled", "The infrastructure has been reconciled.")
+	}
+}
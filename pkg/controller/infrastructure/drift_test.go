@@ -0,0 +1,52 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package infrastructure_test
+
+import (
+	. "github.com/gardener/gardener-extensions/pkg/controller/infrastructure"
+	"github.com/gardener/gardener-extensions/pkg/gardener/terraformer"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Drift", func() {
+	Describe("#DriftFromPlan", func() {
+		It("should not report drift for plans without changes", func() {
+			Expect(DriftFromPlan(&terraformer.Plan{})).To(BeEmpty())
+		})
+
+		It("should describe every planned resource", func() {
+			Expect(DriftFromPlan(&terraformer.Plan{
+				Add:     1,
+				Change:  1,
+				Destroy: 2,
+				Resources: []terraformer.PlannedResource{
+					{Address: "azurerm_subnet.workers", Action: terraformer.PlanActionCreate},
+					{Address: "azurerm_route_table.workers", Action: terraformer.PlanActionUpdate},
+					{Address: "azurerm_network_security_group.workers", Action: terraformer.PlanActionReplace},
+				},
+			})).To(Equal([]string{
+				"azurerm_subnet.workers must be created",
+				"azurerm_route_table.workers must be updated in-place",
+				"azurerm_network_security_group.workers must be replaced",
+			}))
+		})
+
+		It("should fall back to the plan summary if no resources were parsed", func() {
+			Expect(DriftFromPlan(&terraformer.Plan{Destroy: 1})).To(Equal([]string{"Terraform plans changes: 0 to add, 0 to change, 1 to destroy"}))
+		})
+	})
+})
@@ -0,0 +1,87 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package infrastructure
+
+import (
+	"context"
+	"time"
+
+	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
+	"github.com/gardener/gardener-extensions/pkg/gardener/terraformer"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	gardencorev1alpha1helper "github.com/gardener/gardener/pkg/apis/core/v1alpha1/helper"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+)
+
+const (
+	// DryRunAnnotation is the annotation that makes the infrastructure controller plan the changes to the
+	// infrastructure instead of applying them if its value is "true".
+	DryRunAnnotation = "extensions.gardener.cloud/dry-run"
+
+	// ConditionTypeInfrastructurePlan is a condition type containing the summary of the last infrastructure plan.
+	ConditionTypeInfrastructurePlan gardencorev1alpha1.ConditionType = "InfrastructurePlan"
+
+	// EventInfrastructurePlan an event reason to describe infrastructure plans.
+	EventInfrastructurePlan string = "InfrastructurePlan"
+
+	// DryRunDeletionRequeueInterval is the interval after which the deletion of infrastructures is planned again
+	// in dry-run mode.
+	DryRunDeletionRequeueInterval = 5 * time.Minute
+)
+
+// Planner plans the changes to Infrastructure resources without applying them.
+type Planner interface {
+	// Plan the changes to the Infrastructure config. The destruction of all resources is planned for
+	// Infrastructures that are being deleted.
+	Plan(context.Context, *extensionsv1alpha1.Infrastructure, *extensionscontroller.Cluster) (*terraformer.Plan, error)
+}
+
+// HasDryRunAnnotation returns true if the given object is annotated with the DryRunAnnotation.
+func HasDryRunAnnotation(meta metav1.Object) bool {
+	return meta.GetAnnotations()[DryRunAnnotation] == "true"
+}
+
+var dryRunAnnotationChangedPredicate = predicate.Funcs{
+	UpdateFunc: func(e event.UpdateEvent) bool {
+		return HasDryRunAnnotation(e.MetaOld) != HasDryRunAnnotation(e.MetaNew)
+	},
+}
+
+// DryRunAnnotationChangedPredicate is a predicate for updates that add or remove the DryRunAnnotation.
+func DryRunAnnotationChangedPredicate() predicate.Predicate {
+	return dryRunAnnotationChangedPredicate
+}
+
+// setPlanCondition records the InfrastructurePlan condition for the given plan.
+func setPlanCondition(ctx context.Context, plan *terraformer.Plan) {
+	if plan.HasChanges() {
+		extensionscontroller.SetCondition(ctx, ConditionTypeInfrastructurePlan, gardencorev1alpha1.ConditionTrue, "ChangesPlanned", plan.String())
+		return
+	}
+	extensionscontroller.SetCondition(ctx, ConditionTypeInfrastructurePlan, gardencorev1alpha1.ConditionFalse, "NoChangesPlanned", plan.String())
+}
+
+// resetPlanCondition marks an existing InfrastructurePlan condition as outdated after the infrastructure
+// has been reconciled.
+func resetPlanCondition(ctx context.Context, conditions []gardencorev1alpha1.Condition) {
+	if gardencorev1alpha1helper.GetCondition(conditions, ConditionTypeInfrastructurePlan) != nil {
+		extensionscontroller.SetCondition(ctx, ConditionTypeInfrastructurePlan, gardencorev1alpha1.ConditionFalse, "PlanApplied", "The infrastructure has been reconciled.")
+	}
+}
@@ -80,3 +80,9 @@ func SecretToInfrastructureMapper(client client.Client, predicates []predicate.P
 func ClusterToInfrastructureMapper(client client.Client, predicates []predicate.Predicate) handler.Mapper {
 	return extensionscontroller.ClusterToObjectMapper(client, func() runtime.Object { return &extensions1alpha1.InfrastructureList{} }, predicates)
 }
+
+// ClusterPauseToInfrastructureMapper returns a mapper that returns requests for Infrastructures whose
+// referenced clusters have been paused or resumed.
+func ClusterPauseToInfrastructureMapper(client client.Client, predicates []predicate.Predicate) handler.Mapper {
+	return extensionscontroller.ClusterPauseToObjectMapper(client, func() runtime.Object { return &extensions1alpha1.InfrastructureList{} }, predicates)
+}
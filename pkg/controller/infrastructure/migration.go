@@ -0,0 +1,71 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package infrastructure
+
+import (
+	"context"
+
+	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
+	"github.com/gardener/gardener-extensions/pkg/gardener/terraformer"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// EventInfrastructureMigration an event reason to describe infrastructure migration.
+	EventInfrastructureMigration string = "InfrastructureMigration"
+	// EventInfrastructureRestoration an event reason to describe infrastructure restoration.
+	EventInfrastructureRestoration string = "InfrastructureRestoration"
+)
+
+// Migrator migrates Infrastructure resources between seeds without recreating the cloud resources.
+type Migrator interface {
+	// Migrate exports the state of the Infrastructure config, e.g. the Terraform state, so that it can be
+	// stored in the status of the Infrastructure and restored on another seed.
+	Migrate(context.Context, *extensionsv1alpha1.Infrastructure, *extensionscontroller.Cluster) (string, error)
+	// Restore restores the given state of the Infrastructure config that has been exported by Migrate.
+	// It is called before the Infrastructure is reconciled on the new seed.
+	Restore(context.Context, *extensionsv1alpha1.Infrastructure, *extensionscontroller.Cluster, string) error
+}
+
+// TerraformerMigrator implements Migrator for actuators that manage the cloud resources of Infrastructures with
+// the Terraformer. It exports and restores the Terraform state of its purpose. Actuators embed it and pass their
+// client to it when it is injected.
+type TerraformerMigrator struct {
+	purpose string
+	client  client.Client
+}
+
+// NewTerraformerMigrator creates a new TerraformerMigrator for the Terraform state of the given purpose.
+func NewTerraformerMigrator(purpose string) TerraformerMigrator {
+	return TerraformerMigrator{purpose: purpose}
+}
+
+// InjectClient implements inject.Client.
+func (m *TerraformerMigrator) InjectClient(client client.Client) error {
+	m.client = client
+	return nil
+}
+
+// Migrate implements Migrator.
+func (m *TerraformerMigrator) Migrate(ctx context.Context, infra *extensionsv1alpha1.Infrastructure, _ *extensionscontroller.Cluster) (string, error) {
+	return terraformer.GetState(ctx, m.client, m.purpose, infra.Namespace, infra.Name)
+}
+
+// Restore implements Migrator.
+func (m *TerraformerMigrator) Restore(ctx context.Context, infra *extensionsv1alpha1.Infrastructure, _ *extensionscontroller.Cluster, state string) error {
+	return terraformer.RestoreState(ctx, m.client, m.purpose, infra.Namespace, infra.Name, state)
+}
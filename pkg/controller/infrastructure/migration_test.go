@@ -0,0 +1,74 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package infrastructure_test
+
+import (
+	"context"
+
+	. "github.com/gardener/gardener-extensions/pkg/controller/infrastructure"
+	mockclient "github.com/gardener/gardener-extensions/pkg/mock/controller-runtime/client"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var _ = Describe("TerraformerMigrator", func() {
+	var (
+		ctrl *gomock.Controller
+		c    *mockclient.MockClient
+		ctx  = context.TODO()
+
+		infra = &extensionsv1alpha1.Infrastructure{ObjectMeta: metav1.ObjectMeta{Namespace: "shoot--foo--bar", Name: "infra"}}
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		c = mockclient.NewMockClient(ctrl)
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	newMigrator := func() *TerraformerMigrator {
+		migrator := NewTerraformerMigrator("infra")
+		Expect(migrator.InjectClient(c)).To(Succeed())
+		return &migrator
+	}
+
+	Describe("#Migrate", func() {
+		It("should export the Terraform state of the purpose", func() {
+			c.EXPECT().Get(ctx, client.ObjectKey{Namespace: infra.Namespace, Name: "infra.infra.tf-state"}, &corev1.ConfigMap{}).DoAndReturn(
+				func(_ context.Context, _ client.ObjectKey, obj runtime.Object) error {
+					obj.(*corev1.ConfigMap).Data = map[string]string{"terraform.tfstate": "state"}
+					return nil
+				})
+
+			Expect(newMigrator().Migrate(ctx, infra, nil)).To(Equal("state"))
+		})
+	})
+
+	Describe("#Restore", func() {
+		It("should not restore an empty state", func() {
+			Expect(newMigrator().Restore(ctx, infra, nil, "")).To(Succeed())
+		})
+	})
+})
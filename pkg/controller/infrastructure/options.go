@@ -15,46 +15,75 @@
 package infrastructure
 
 import (
+	"time"
+
 	"github.com/spf13/pflag"
 )
 
 const (
-	// IgnoreOperationAnnotationFlag is the name of the command line flag to specify whether the operation annotation
-	// is ignored or not.
-	IgnoreOperationAnnotationFlag = "ignore-operation-annotation"
+	// DryRunFlag is the name of the command line flag to specify whether the infrastructure
+	// should only be planned instead of being reconciled.
+	DryRunFlag = "dry-run"
+	// AutoRepairDriftFlag is the name of the command line flag to specify whether drifted infrastructure
+	// should be repaired automatically.
+	AutoRepairDriftFlag = "auto-repair-drift"
+	// ResyncPeriodFlag is the name of the command line flag to specify the period after which successfully
+	// reconciled infrastructures are checked for drift.
+	ResyncPeriodFlag = "resync-period"
 )
 
-// ReconcilerOptions are command line options that can be set for controller.Options.
-type ReconcilerOptions struct {
-	// IgnoreOperationAnnotation defines whether to ignore the operation annotation or not.
-	IgnoreOperationAnnotation bool
+// Options are command line options that can be set for the infrastructure controller.
+type Options struct {
+	// DryRun defines whether to only plan the infrastructure changes instead of applying them.
+	DryRun bool
+	// AutoRepairDrift defines whether to reconcile the infrastructure if it has drifted.
+	AutoRepairDrift bool
+	// ResyncPeriod is the period after which successfully reconciled infrastructures are checked for drift.
+	// A zero value disables resyncing.
+	ResyncPeriod time.Duration
 
-	config *ReconcilerConfig
+	config *Config
 }
 
 // AddFlags implements Flagger.AddFlags.
-func (c *ReconcilerOptions) AddFlags(fs *pflag.FlagSet) {
-	fs.BoolVar(&c.IgnoreOperationAnnotation, IgnoreOperationAnnotationFlag, c.IgnoreOperationAnnotation, "Ignore the operation annotation or not.")
+func (c *Options) AddFlags(fs *pflag.FlagSet) {
+	fs.BoolVar(&c.DryRun, DryRunFlag, c.DryRun, "Only plan the infrastructure changes and report them instead of applying them. Deleted infrastructures are not released while this is set.")
+	fs.BoolVar(&c.AutoRepairDrift, AutoRepairDriftFlag, c.AutoRepairDrift, "Reconcile the infrastructure if drift is detected while resyncing. Infrastructures are not reconciled while resyncing if the provider does not support drift detection.")
+	fs.DurationVar(&c.ResyncPeriod, ResyncPeriodFlag, c.ResyncPeriod, "The period after which successfully reconciled infrastructures are checked for drift. Zero disables resyncing.")
 }
 
 // Complete implements Completer.Complete.
-func (c *ReconcilerOptions) Complete() error {
-	c.config = &ReconcilerConfig{c.IgnoreOperationAnnotation}
+func (c *Options) Complete() error {
+	c.config = &Config{c.DryRun, c.AutoRepairDrift, c.ResyncPeriod}
 	return nil
 }
 
-// Completed returns the completed ReconcilerConfig. Only call this if `Complete` was successful.
-func (c *ReconcilerOptions) Completed() *ReconcilerConfig {
+// Completed returns the completed Config. Only call this if `Complete` was successful.
+func (c *Options) Completed() *Config {
 	return c.config
 }
 
-// ReconcilerConfig is a completed controller configuration.
-type ReconcilerConfig struct {
-	// IgnoreOperationAnnotation defines whether to ignore the operation annotation or not.
-	IgnoreOperationAnnotation bool
+// Config is a completed controller configuration.
+type Config struct {
+	// DryRun defines whether to only plan the infrastructure changes instead of applying them.
+	DryRun bool
+	// AutoRepairDrift defines whether to reconcile the infrastructure if it has drifted.
+	AutoRepairDrift bool
+	// ResyncPeriod is the period after which successfully reconciled infrastructures are checked for drift.
+	ResyncPeriod time.Duration
+}
+
+// Apply sets the dry-run value of this Config in the given boolean.
+func (c *Config) Apply(dryRun *bool) {
+	*dryRun = c.DryRun
+}
+
+// ApplyAutoRepairDrift sets the auto-repair value of this Config in the given boolean.
+func (c *Config) ApplyAutoRepairDrift(autoRepairDrift *bool) {
+	*autoRepairDrift = c.AutoRepairDrift
 }
 
-// Apply sets the values of this ReconcilerConfig in the given controller.Options.
-func (c *ReconcilerConfig) Apply(ignore *bool) {
-	*ignore = c.IgnoreOperationAnnotation
+// ApplyResyncPeriod sets the resync period of this Config in the given duration.
+func (c *Config) ApplyResyncPeriod(resyncPeriod *time.Duration) {
+	*resyncPeriod = c.ResyncPeriod
 }
@@ -0,0 +1,97 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package infrastructure_test
+
+import (
+	"time"
+
+	. "github.com/gardener/gardener-extensions/pkg/controller/infrastructure"
+	"github.com/gardener/gardener-extensions/pkg/util/test"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/pflag"
+)
+
+var _ = Describe("Options", func() {
+	const (
+		name         = "foo"
+		resyncPeriod = 10 * time.Minute
+	)
+	command := test.NewCommandBuilder(name).
+		Flags(
+			test.BoolFlag(DryRunFlag, true),
+			test.BoolFlag(AutoRepairDriftFlag, true),
+			test.DurationFlag(ResyncPeriodFlag, resyncPeriod),
+		).
+		Command().
+		Slice()
+
+	Describe("#AddFlags", func() {
+		It("should add all flags", func() {
+			fs := pflag.NewFlagSet(name, pflag.ExitOnError)
+			opts := Options{}
+
+			opts.AddFlags(fs)
+
+			Expect(fs.Parse(command)).NotTo(HaveOccurred())
+			Expect(opts).To(Equal(Options{
+				DryRun:          true,
+				AutoRepairDrift: true,
+				ResyncPeriod:    resyncPeriod,
+			}))
+		})
+	})
+
+	Describe("#Completed", func() {
+		It("should yield a correct Config after completion", func() {
+			fs := pflag.NewFlagSet(name, pflag.ExitOnError)
+			opts := Options{}
+
+			opts.AddFlags(fs)
+
+			Expect(fs.Parse(command)).NotTo(HaveOccurred())
+			Expect(opts.Complete()).NotTo(HaveOccurred())
+			Expect(opts.Completed()).To(Equal(&Config{
+				DryRun:          true,
+				AutoRepairDrift: true,
+				ResyncPeriod:    resyncPeriod,
+			}))
+		})
+	})
+
+	Describe("Config", func() {
+		cfg := &Config{
+			DryRun:          true,
+			AutoRepairDrift: true,
+			ResyncPeriod:    resyncPeriod,
+		}
+
+		It("should apply the values to the given variables", func() {
+			var (
+				dryRun, autoRepairDrift bool
+				period                  time.Duration
+			)
+
+			cfg.Apply(&dryRun)
+			cfg.ApplyAutoRepairDrift(&autoRepairDrift)
+			cfg.ApplyResyncPeriod(&period)
+
+			Expect(dryRun).To(BeTrue())
+			Expect(autoRepairDrift).To(BeTrue())
+			Expect(period).To(Equal(resyncPeriod))
+		})
+	})
+})
@@ -15,9 +15,9 @@
 package infrastructure
 
 import (
-	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
+
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
-	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
 
 	"k8s.io/apimachinery/pkg/runtime"
 
@@ -32,7 +32,7 @@ func OperationAnnotationPredicate() predicate.Predicate {
 		if !ok {
 			return false
 		}
-		return mayReconcile(infrastructure)
+		return extensionscontroller.ReconcileRequired(infrastructure, &infrastructure.Status.DefaultStatus)
 	}
 
 	return predicate.Funcs{
@@ -47,13 +47,3 @@ func OperationAnnotationPredicate() predicate.Predicate {
 		},
 	}
 }
-
-func mayReconcile(infrastructure *extensionsv1alpha1.Infrastructure) bool {
-	return infrastructure.DeletionTimestamp != nil ||
-		infrastructure.Generation != infrastructure.Status.ObservedGeneration ||
-		infrastructure.Status.LastOperation == nil ||
-		infrastructure.Status.LastOperation.Type == gardencorev1alpha1.LastOperationTypeCreate ||
-		infrastructure.Status.LastOperation.Type == gardencorev1alpha1.LastOperationTypeDelete ||
-		infrastructure.Status.LastOperation.State != gardencorev1alpha1.LastOperationStateSucceeded ||
-		kutil.HasMetaDataAnnotation(&infrastructure.ObjectMeta, gardencorev1alpha1.GardenerOperation, gardencorev1alpha1.GardenerOperationReconcile)
-}
@@ -17,8 +17,12 @@ package infrastructure
 import (
 	"context"
 	"fmt"
+	"strings"
+	"time"
 
 	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
+	controllererror "github.com/gardener/gardener-extensions/pkg/controller/error"
+	"github.com/gardener/gardener-extensions/pkg/controller/metrics"
 	"github.com/gardener/gardener-extensions/pkg/util"
 
 	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
@@ -50,18 +54,37 @@ const (
 type reconciler struct {
 	logger   logr.Logger
 	actuator Actuator
+	options  ReconcilerOptions
 
 	ctx      context.Context
 	client   client.Client
 	recorder record.EventRecorder
 }
 
+// ReconcilerOptions are options for an infrastructure reconciler.
+type ReconcilerOptions struct {
+	// ErrorClassifier determines the error codes of failed operations. If it is nil or does not determine
+	// any code, the default classifier is used.
+	ErrorClassifier controllererror.Classifier
+	// DryRun specifies whether the changes to infrastructures are only planned by the actuator and reported
+	// instead of being applied. Infrastructures annotated with the DryRunAnnotation are always planned.
+	// Deleted infrastructures keep their finalizer until they leave the dry-run mode.
+	DryRun bool
+	// ResyncPeriod is the period after which successfully reconciled infrastructures are checked for drift.
+	// A zero value disables resyncing.
+	ResyncPeriod time.Duration
+	// AutoRepairDrift specifies whether infrastructures are reconciled again if drift is detected while resyncing.
+	// Infrastructures whose Actuator does not implement DriftDetector are never reconciled while resyncing.
+	AutoRepairDrift bool
+}
+
 // NewReconciler creates a new reconcile.Reconciler that reconciles
 // infrastructure resources of Gardener's `extensions.gardener.cloud` API group.
-func NewReconciler(mgr manager.Manager, actuator Actuator) reconcile.Reconciler {
+func NewReconciler(mgr manager.Manager, actuator Actuator, options ReconcilerOptions) reconcile.Reconciler {
 	return &reconciler{
 		logger:   log.Log.WithName(ControllerName),
 		actuator: actuator,
+		options:  options,
 		recorder: mgr.GetRecorder(ControllerName),
 	}
 }
@@ -94,10 +117,43 @@ func (r *reconciler) Reconcile(request reconcile.Request) (reconcile.Result, err
 		return reconcile.Result{}, err
 	}
 
+	ctx := extensionscontroller.WithConditionRecorder(r.ctx, extensionscontroller.NewConditionRecorder())
+
+	dryRun := r.options.DryRun || HasDryRunAnnotation(infrastructure)
+
 	if infrastructure.DeletionTimestamp != nil {
-		return r.delete(r.ctx, infrastructure, cluster)
+		if dryRun {
+			return r.planDeletion(ctx, infrastructure, cluster)
+		}
+		return r.delete(ctx, infrastructure, cluster)
+	}
+
+	if extensionscontroller.IsMigrateOperation(infrastructure) {
+		return r.migrate(ctx, infrastructure, cluster)
+	}
+	if extensionscontroller.IsRestoreOperation(infrastructure) {
+		return r.restore(ctx, infrastructure, cluster)
+	}
+	if extensionscontroller.IsMigrated(&infrastructure.Status.DefaultStatus) {
+		r.logger.Info("Skipping the reconciliation of migrated infrastructure", "infrastructure", infrastructure.Name)
+		return reconcile.Result{}, nil
+	}
+
+	paused, err := extensionscontroller.ReconciliationPaused(ctx, r.client, infrastructure)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+	extensionscontroller.SetReconciliationPausedCondition(ctx, infrastructure.Status.Conditions, paused)
+	if paused {
+		return r.pause(ctx, infrastructure)
+	}
+	if dryRun {
+		return r.plan(ctx, infrastructure, cluster)
+	}
+	if r.options.ResyncPeriod > 0 && !extensionscontroller.ReconcileRequired(infrastructure, &infrastructure.Status.DefaultStatus) {
+		return r.resync(ctx, infrastructure, cluster)
 	}
-	return r.reconcile(r.ctx, infrastructure, cluster)
+	return r.reconcile(ctx, infrastructure, cluster)
 }
 
 func (r *reconciler) reconcile(ctx context.Context, infrastructure *extensionsv1alpha1.Infrastructure, cluster *extensionscontroller.Cluster) (reconcile.Result, error) {
@@ -106,19 +162,26 @@ func (r *reconciler) reconcile(ctx context.Context, infrastructure *extensionsv1
 	}
 
 	operationType := gardencorev1alpha1helper.ComputeOperationType(infrastructure.ObjectMeta, infrastructure.Status.LastOperation)
+	ctx = extensionscontroller.WithProgressReporter(ctx, r.newProgressReporter(infrastructure, operationType))
 	if err := r.updateStatusProcessing(ctx, infrastructure, operationType, "Reconciling the infrastructure"); err != nil {
 		return reconcile.Result{}, err
 	}
 
 	r.logger.Info("Starting the reconciliation of infrastructure", "infrastructure", infrastructure.Name)
 	r.recorder.Event(infrastructure, corev1.EventTypeNormal, EventInfrastructureReconciliation, "Reconciling the infrastructure")
+	observeOperation := metrics.ObserveOperation(extensionscontroller.UnsafeGuessKind(infrastructure), infrastructure.Spec.Type, operationType)
 	if err := r.actuator.Reconcile(ctx, infrastructure, cluster); err != nil {
+		observeOperation(extensionscontroller.ReconcileErrCauseOrErr(err), controllererror.Codes(r.options.ErrorClassifier, extensionscontroller.ReconcileErrCauseOrErr(err))...)
 		msg := "Error reconciling infrastructure"
 		utilruntime.HandleError(r.updateStatusError(ctx, extensionscontroller.ReconcileErrCauseOrErr(err), infrastructure, operationType, msg))
 		r.logger.Error(err, msg, "infrastructure", infrastructure.Name)
 		return extensionscontroller.ReconcileErr(err)
 	}
 
+	observeOperation(nil)
+	resetPlanCondition(ctx, infrastructure.Status.Conditions)
+	resetDriftCondition(ctx, infrastructure.Status.Conditions)
+
 	msg := "Successfully reconciled infrastructure"
 	r.logger.Info(msg, "infrastructure", infrastructure.Name)
 	r.recorder.Event(infrastructure, corev1.EventTypeNormal, EventInfrastructureReconciliation, msg)
@@ -126,7 +189,210 @@ func (r *reconciler) reconcile(ctx context.Context, infrastructure *extensionsv1
 		return reconcile.Result{}, err
 	}
 
-	return reconcile.Result{}, nil
+	return reconcile.Result{RequeueAfter: r.options.ResyncPeriod}, nil
+}
+
+// migrate exports the state of the infrastructure into its status and releases it without deleting the cloud
+// resources, so that it can be restored on another seed.
+func (r *reconciler) migrate(ctx context.Context, infrastructure *extensionsv1alpha1.Infrastructure, cluster *extensionscontroller.Cluster) (reconcile.Result, error) {
+	operationType := extensionscontroller.LastOperationTypeMigrate
+	ctx = extensionscontroller.WithProgressReporter(ctx, r.newProgressReporter(infrastructure, operationType))
+	if err := r.updateStatusProcessing(ctx, infrastructure, operationType, "Migrating the infrastructure"); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	r.logger.Info("Starting the migration of infrastructure", "infrastructure", infrastructure.Name)
+	r.recorder.Event(infrastructure, corev1.EventTypeNormal, EventInfrastructureMigration, "Migrating the infrastructure")
+	observeOperation := metrics.ObserveOperation(extensionscontroller.UnsafeGuessKind(infrastructure), infrastructure.Spec.Type, operationType)
+	state, err := r.exportState(ctx, infrastructure, cluster)
+	if err != nil {
+		observeOperation(extensionscontroller.ReconcileErrCauseOrErr(err), controllererror.Codes(r.options.ErrorClassifier, extensionscontroller.ReconcileErrCauseOrErr(err))...)
+		msg := "Error migrating infrastructure"
+		r.recorder.Eventf(infrastructure, corev1.EventTypeWarning, EventInfrastructureMigration, "%s: %+v", msg, err)
+		utilruntime.HandleError(r.updateStatusError(ctx, extensionscontroller.ReconcileErrCauseOrErr(err), infrastructure, operationType, msg))
+		r.logger.Error(err, msg, "infrastructure", infrastructure.Name)
+		return extensionscontroller.ReconcileErr(err)
+	}
+
+	observeOperation(nil)
+
+	msg := "Successfully migrated infrastructure"
+	r.logger.Info(msg, "infrastructure", infrastructure.Name)
+	r.recorder.Event(infrastructure, corev1.EventTypeNormal, EventInfrastructureMigration, msg)
+	if err := r.updateStatusMigrated(ctx, infrastructure, state, msg); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	// The finalizer is removed so that deleting the migrated infrastructure does not destroy the cloud resources.
+	r.logger.Info("Removing finalizer.", "infrastructure", infrastructure.Name)
+	if err := extensionscontroller.DeleteFinalizer(ctx, r.client, FinalizerName, infrastructure); err != nil {
+		r.logger.Error(err, "Error removing finalizer from Infrastructure", "infrastructure", infrastructure.Name)
+		return reconcile.Result{}, err
+	}
+
+	return reconcile.Result{}, extensionscontroller.RemoveMigrationOperationAnnotation(ctx, r.client, infrastructure)
+}
+
+// restore restores the state of a migrated infrastructure from its status and reconciles it afterwards.
+func (r *reconciler) restore(ctx context.Context, infrastructure *extensionsv1alpha1.Infrastructure, cluster *extensionscontroller.Cluster) (reconcile.Result, error) {
+	if err := extensionscontroller.EnsureFinalizer(ctx, r.client, FinalizerName, infrastructure); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	operationType := extensionscontroller.LastOperationTypeRestore
+	ctx = extensionscontroller.WithProgressReporter(ctx, r.newProgressReporter(infrastructure, operationType))
+	if err := r.updateStatusProcessing(ctx, infrastructure, operationType, "Restoring the infrastructure"); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	r.logger.Info("Starting the restoration of infrastructure", "infrastructure", infrastructure.Name)
+	r.recorder.Event(infrastructure, corev1.EventTypeNormal, EventInfrastructureRestoration, "Restoring the infrastructure")
+	observeOperation := metrics.ObserveOperation(extensionscontroller.UnsafeGuessKind(infrastructure), infrastructure.Spec.Type, operationType)
+	if err := r.restoreState(ctx, infrastructure, cluster); err != nil {
+		observeOperation(extensionscontroller.ReconcileErrCauseOrErr(err), controllererror.Codes(r.options.ErrorClassifier, extensionscontroller.ReconcileErrCauseOrErr(err))...)
+		msg := "Error restoring infrastructure"
+		r.recorder.Eventf(infrastructure, corev1.EventTypeWarning, EventInfrastructureRestoration, "%s: %+v", msg, err)
+		utilruntime.HandleError(r.updateStatusError(ctx, extensionscontroller.ReconcileErrCauseOrErr(err), infrastructure, operationType, msg))
+		r.logger.Error(err, msg, "infrastructure", infrastructure.Name)
+		return extensionscontroller.ReconcileErr(err)
+	}
+
+	observeOperation(nil)
+	resetPlanCondition(ctx, infrastructure.Status.Conditions)
+	resetDriftCondition(ctx, infrastructure.Status.Conditions)
+
+	msg := "Successfully restored infrastructure"
+	r.logger.Info(msg, "infrastructure", infrastructure.Name)
+	r.recorder.Event(infrastructure, corev1.EventTypeNormal, EventInfrastructureRestoration, msg)
+	if err := r.updateStatusSuccess(ctx, infrastructure, operationType, msg); err != nil {
+		return reconcile.Result{}, err
+	}
+	if err := extensionscontroller.RemoveMigrationOperationAnnotation(ctx, r.client, infrastructure); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	return reconcile.Result{RequeueAfter: r.options.ResyncPeriod}, nil
+}
+
+func (r *reconciler) exportState(ctx context.Context, infrastructure *extensionsv1alpha1.Infrastructure, cluster *extensionscontroller.Cluster) (string, error) {
+	migrator, ok := r.actuator.(Migrator)
+	if !ok {
+		return "", fmt.Errorf("actuator for infrastructure type %q does not support migration", infrastructure.Spec.Type)
+	}
+
+	return migrator.Migrate(ctx, infrastructure, cluster)
+}
+
+func (r *reconciler) restoreState(ctx context.Context, infrastructure *extensionsv1alpha1.Infrastructure, cluster *extensionscontroller.Cluster) error {
+	migrator, ok := r.actuator.(Migrator)
+	if !ok {
+		return fmt.Errorf("actuator for infrastructure type %q does not support migration", infrastructure.Spec.Type)
+	}
+
+	if err := migrator.Restore(ctx, infrastructure, cluster, infrastructure.Status.State); err != nil {
+		return err
+	}
+	return r.actuator.Reconcile(ctx, infrastructure, cluster)
+}
+
+// resync checks an already reconciled infrastructure for drift. If the infrastructure has drifted and drift
+// is repaired automatically, it is reconciled again.
+func (r *reconciler) resync(ctx context.Context, infrastructure *extensionsv1alpha1.Infrastructure, cluster *extensionscontroller.Cluster) (reconcile.Result, error) {
+	detector, ok := r.actuator.(DriftDetector)
+	if !ok {
+		msg := "Cannot check the infrastructure for drift as the actuator does not support drift detection"
+		r.logger.Info(msg, "infrastructure", infrastructure.Name)
+		extensionscontroller.SetCondition(ctx, ConditionTypeInfrastructureDrift, gardencorev1alpha1.ConditionUnknown, "DriftDetectionUnsupported", msg)
+		return reconcile.Result{RequeueAfter: r.options.ResyncPeriod}, r.updateStatusConditions(ctx, infrastructure)
+	}
+
+	r.logger.Info("Checking the infrastructure for drift", "infrastructure", infrastructure.Name)
+	drift, err := detector.DetectDrift(ctx, infrastructure, cluster)
+	if err != nil {
+		msg := "Error checking infrastructure for drift"
+		extensionscontroller.SetCondition(ctx, ConditionTypeInfrastructureDrift, gardencorev1alpha1.ConditionUnknown, "DriftCheckFailed", fmt.Sprintf("%s: %v", msg, extensionscontroller.ReconcileErrCauseOrErr(err)))
+		r.recorder.Eventf(infrastructure, corev1.EventTypeWarning, EventInfrastructureDrift, "%s: %+v", msg, err)
+		utilruntime.HandleError(r.updateStatusConditions(ctx, infrastructure))
+		r.logger.Error(err, msg, "infrastructure", infrastructure.Name)
+		return reconcile.Result{RequeueAfter: r.options.ResyncPeriod}, nil
+	}
+
+	setDriftCondition(ctx, drift)
+	if len(drift) == 0 {
+		return reconcile.Result{RequeueAfter: r.options.ResyncPeriod}, r.updateStatusConditions(ctx, infrastructure)
+	}
+
+	msg := fmt.Sprintf("Infrastructure has drifted: %s", strings.Join(drift, "; "))
+	r.logger.Info(msg, "infrastructure", infrastructure.Name)
+	r.recorder.Event(infrastructure, corev1.EventTypeWarning, EventInfrastructureDrift, msg)
+	if err := r.updateStatusConditions(ctx, infrastructure); err != nil {
+		return reconcile.Result{}, err
+	}
+	if r.options.AutoRepairDrift {
+		return r.reconcile(ctx, infrastructure, cluster)
+	}
+	return reconcile.Result{RequeueAfter: r.options.ResyncPeriod}, nil
+}
+
+func (r *reconciler) plan(ctx context.Context, infrastructure *extensionsv1alpha1.Infrastructure, cluster *extensionscontroller.Cluster) (reconcile.Result, error) {
+	planner, ok := r.actuator.(Planner)
+	if !ok {
+		r.logger.Info("Cannot plan the infrastructure as the actuator does not support planning", "infrastructure", infrastructure.Name)
+		return reconcile.Result{}, nil
+	}
+
+	r.logger.Info("Starting to plan the infrastructure", "infrastructure", infrastructure.Name)
+	plan, err := planner.Plan(ctx, infrastructure, cluster)
+	if err != nil {
+		msg := "Error planning infrastructure"
+		extensionscontroller.SetCondition(ctx, ConditionTypeInfrastructurePlan, gardencorev1alpha1.ConditionUnknown, "PlanFailed", fmt.Sprintf("%s: %v", msg, extensionscontroller.ReconcileErrCauseOrErr(err)))
+		r.recorder.Eventf(infrastructure, corev1.EventTypeWarning, EventInfrastructurePlan, "%s: %+v", msg, err)
+		utilruntime.HandleError(r.updateStatusConditions(ctx, infrastructure))
+		r.logger.Error(err, msg, "infrastructure", infrastructure.Name)
+		return extensionscontroller.ReconcileErr(err)
+	}
+
+	eventType := corev1.EventTypeNormal
+	if plan.Destroy > 0 {
+		eventType = corev1.EventTypeWarning
+	}
+
+	msg := fmt.Sprintf("Planned infrastructure changes: %s", plan)
+	setPlanCondition(ctx, plan)
+	r.logger.Info(msg, "infrastructure", infrastructure.Name)
+	r.recorder.Event(infrastructure, eventType, EventInfrastructurePlan, msg)
+	return reconcile.Result{}, r.updateStatusConditions(ctx, infrastructure)
+}
+
+// planDeletion plans the destruction of the infrastructure instead of deleting it, as changes to the infrastructure
+// are never applied in dry-run mode. The finalizer is kept, so the infrastructure is deleted once it leaves the
+// dry-run mode.
+func (r *reconciler) planDeletion(ctx context.Context, infrastructure *extensionsv1alpha1.Infrastructure, cluster *extensionscontroller.Cluster) (reconcile.Result, error) {
+	hasFinalizer, err := extensionscontroller.HasFinalizer(infrastructure, FinalizerName)
+	if err != nil {
+		r.logger.Error(err, "Could not instantiate finalizer deletion")
+		return reconcile.Result{}, err
+	}
+	if !hasFinalizer {
+		r.logger.Info("Deleting infrastructure causes a no-op as there is no finalizer.", "infrastructure", infrastructure.Name)
+		return reconcile.Result{}, nil
+	}
+
+	msg := "Skipping the deletion of the infrastructure in dry-run mode, planning its destruction instead"
+	r.logger.Info(msg, "infrastructure", infrastructure.Name)
+	r.recorder.Event(infrastructure, corev1.EventTypeWarning, EventInfrastructureDeleton, msg)
+
+	if result, err := r.plan(ctx, infrastructure, cluster); err != nil || result.Requeue {
+		return result, err
+	}
+	return reconcile.Result{RequeueAfter: DryRunDeletionRequeueInterval}, nil
+}
+
+func (r *reconciler) pause(ctx context.Context, infrastructure *extensionsv1alpha1.Infrastructure) (reconcile.Result, error) {
+	msg := "Reconciliation of the infrastructure is paused"
+	r.logger.Info(msg, "infrastructure", infrastructure.Name)
+	r.recorder.Event(infrastructure, corev1.EventTypeNormal, extensionscontroller.EventReconciliationPaused, msg)
+	return reconcile.Result{}, r.updateStatusConditions(ctx, infrastructure)
 }
 
 func (r *reconciler) delete(ctx context.Context, infrastructure *extensionsv1alpha1.Infrastructure, cluster *extensionscontroller.Cluster) (reconcile.Result, error) {
@@ -141,13 +407,16 @@ func (r *reconciler) delete(ctx context.Context, infrastructure *extensionsv1alp
 	}
 
 	operationType := gardencorev1alpha1helper.ComputeOperationType(infrastructure.ObjectMeta, infrastructure.Status.LastOperation)
+	ctx = extensionscontroller.WithProgressReporter(ctx, r.newProgressReporter(infrastructure, operationType))
 	if err := r.updateStatusProcessing(ctx, infrastructure, operationType, "Deleting the infrastructure"); err != nil {
 		return reconcile.Result{}, err
 	}
 
 	r.logger.Info("Starting the deletion of infrastructure", "infrastructure", infrastructure.Name)
 	r.recorder.Event(infrastructure, corev1.EventTypeNormal, EventInfrastructureDeleton, "Deleting the infrastructure")
-	if err := r.actuator.Delete(r.ctx, infrastructure, cluster); err != nil {
+	observeOperation := metrics.ObserveOperation(extensionscontroller.UnsafeGuessKind(infrastructure), infrastructure.Spec.Type, operationType)
+	if err := r.actuator.Delete(ctx, infrastructure, cluster); err != nil {
+		observeOperation(extensionscontroller.ReconcileErrCauseOrErr(err), controllererror.Codes(r.options.ErrorClassifier, extensionscontroller.ReconcileErrCauseOrErr(err))...)
 		msg := "Error deleting infrastructure"
 		r.recorder.Eventf(infrastructure, corev1.EventTypeWarning, EventInfrastructureDeleton, "%s: %+v", msg, err)
 		utilruntime.HandleError(r.updateStatusError(ctx, extensionscontroller.ReconcileErrCauseOrErr(err), infrastructure, operationType, msg))
@@ -155,6 +424,8 @@ func (r *reconciler) delete(ctx context.Context, infrastructure *extensionsv1alp
 		return extensionscontroller.ReconcileErr(err)
 	}
 
+	observeOperation(nil)
+
 	msg := "Successfully deleted infrastructure"
 	r.logger.Info(msg, "infrastructure", infrastructure.Name)
 	r.recorder.Event(infrastructure, corev1.EventTypeNormal, EventInfrastructureDeleton, msg)
@@ -173,7 +444,28 @@ func (r *reconciler) delete(ctx context.Context, infrastructure *extensionsv1alp
 
 func (r *reconciler) updateStatusProcessing(ctx context.Context, infrastructure *extensionsv1alpha1.Infrastructure, lastOperationType gardencorev1alpha1.LastOperationType, description string) error {
 	return extensionscontroller.TryUpdateStatus(ctx, retry.DefaultBackoff, r.client, infrastructure, func() error {
-		infrastructure.Status.LastOperation = extensionscontroller.LastOperation(lastOperationType, gardencorev1alpha1.LastOperationStateProcessing, 1, description)
+		infrastructure.Status.LastOperation = extensionscontroller.LastOperation(lastOperationType, gardencorev1alpha1.LastOperationStateProcessing, extensionscontroller.LastReportedProgress(ctx, 1), description)
+		return nil
+	})
+}
+
+func (r *reconciler) newProgressReporter(infrastructure *extensionsv1alpha1.Infrastructure, lastOperationType gardencorev1alpha1.LastOperationType) extensionscontroller.ProgressReporter {
+	return extensionscontroller.NewThrottledProgressReporter(extensionscontroller.DefaultProgressReportInterval, extensionscontroller.InitialProgress(infrastructure.Status.LastOperation, lastOperationType), func(ctx context.Context, progress int, description string) error {
+		return r.updateStatusProgress(ctx, infrastructure, lastOperationType, progress, description)
+	})
+}
+
+func (r *reconciler) updateStatusProgress(ctx context.Context, infrastructure *extensionsv1alpha1.Infrastructure, lastOperationType gardencorev1alpha1.LastOperationType, progress int, description string) error {
+	return extensionscontroller.TryUpdateStatus(ctx, retry.DefaultBackoff, r.client, infrastructure, func() error {
+		infrastructure.Status.Conditions = extensionscontroller.MergeRecordedConditions(ctx, infrastructure.Status.Conditions)
+		infrastructure.Status.LastOperation = extensionscontroller.LastOperation(lastOperationType, gardencorev1alpha1.LastOperationStateProcessing, progress, description)
+		return nil
+	})
+}
+
+func (r *reconciler) updateStatusConditions(ctx context.Context, infrastructure *extensionsv1alpha1.Infrastructure) error {
+	return extensionscontroller.TryUpdateStatus(ctx, retry.DefaultBackoff, r.client, infrastructure, func() error {
+		infrastructure.Status.Conditions = extensionscontroller.MergeRecordedConditions(ctx, infrastructure.Status.Conditions)
 		return nil
 	})
 }
@@ -181,7 +473,17 @@ func (r *reconciler) updateStatusProcessing(ctx context.Context, infrastructure
 func (r *reconciler) updateStatusError(ctx context.Context, err error, infrastructure *extensionsv1alpha1.Infrastructure, lastOperationType gardencorev1alpha1.LastOperationType, description string) error {
 	return extensionscontroller.TryUpdateStatus(ctx, retry.DefaultBackoff, r.client, infrastructure, func() error {
 		infrastructure.Status.ObservedGeneration = infrastructure.Generation
-		infrastructure.Status.LastOperation, infrastructure.Status.LastError = extensionscontroller.ReconcileError(lastOperationType, gardencorev1alpha1helper.FormatLastErrDescription(fmt.Errorf("%s: %v", description, err)), 50, gardencorev1alpha1helper.ExtractErrorCodes(err)...)
+		infrastructure.Status.Conditions = extensionscontroller.MergeRecordedConditions(ctx, infrastructure.Status.Conditions)
+		infrastructure.Status.LastOperation, infrastructure.Status.LastError = extensionscontroller.ReconcileError(lastOperationType, gardencorev1alpha1helper.FormatLastErrDescription(fmt.Errorf("%s: %v", description, err)), extensionscontroller.LastReportedProgress(ctx, 1), controllererror.Codes(r.options.ErrorClassifier, err)...)
+		return nil
+	})
+}
+
+func (r *reconciler) updateStatusMigrated(ctx context.Context, infrastructure *extensionsv1alpha1.Infrastructure, state, description string) error {
+	return extensionscontroller.TryUpdateStatus(ctx, retry.DefaultBackoff, r.client, infrastructure, func() error {
+		infrastructure.Status.State = state
+		infrastructure.Status.Conditions = extensionscontroller.MergeRecordedConditions(ctx, infrastructure.Status.Conditions)
+		infrastructure.Status.LastOperation, infrastructure.Status.LastError = extensionscontroller.ReconcileSucceeded(extensionscontroller.LastOperationTypeMigrate, description)
 		return nil
 	})
 }
@@ -189,6 +491,7 @@ func (r *reconciler) updateStatusError(ctx context.Context, err error, infrastru
 func (r *reconciler) updateStatusSuccess(ctx context.Context, infrastructure *extensionsv1alpha1.Infrastructure, lastOperationType gardencorev1alpha1.LastOperationType, description string) error {
 	return extensionscontroller.TryUpdateStatus(ctx, retry.DefaultBackoff, r.client, infrastructure, func() error {
 		infrastructure.Status.ObservedGeneration = infrastructure.Generation
+		infrastructure.Status.Conditions = extensionscontroller.MergeRecordedConditions(ctx, infrastructure.Status.Conditions)
 		infrastructure.Status.LastOperation, infrastructure.Status.LastError = extensionscontroller.ReconcileSucceeded(lastOperationType, description)
 		return nil
 	})
@@ -0,0 +1,325 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package infrastructure_test
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
+	. "github.com/gardener/gardener-extensions/pkg/controller/infrastructure"
+	"github.com/gardener/gardener-extensions/pkg/gardener/terraformer"
+	mockclient "github.com/gardener/gardener-extensions/pkg/mock/controller-runtime/client"
+	mockmanager "github.com/gardener/gardener-extensions/pkg/mock/controller-runtime/manager"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gstruct"
+	"github.com/onsi/gomega/types"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/runtime/inject"
+)
+
+type fakeActuator struct {
+	plan     *terraformer.Plan
+	err      error
+	progress []int
+
+	reconciled, deleted, planned bool
+}
+
+func (a *fakeActuator) Reconcile(ctx context.Context, _ *extensionsv1alpha1.Infrastructure, _ *extensionscontroller.Cluster) error {
+	a.reconciled = true
+	for _, progress := range a.progress {
+		extensionscontroller.ReportProgress(ctx, progress, fmt.Sprintf("Step %d", progress))
+	}
+	return a.err
+}
+
+func (a *fakeActuator) Delete(context.Context, *extensionsv1alpha1.Infrastructure, *extensionscontroller.Cluster) error {
+	a.deleted = true
+	return a.err
+}
+
+func (a *fakeActuator) Plan(context.Context, *extensionsv1alpha1.Infrastructure, *extensionscontroller.Cluster) (*terraformer.Plan, error) {
+	a.planned = true
+	return a.plan, a.err
+}
+
+var _ = Describe("Reconciler", func() {
+	const (
+		namespace = "shoot--foo--bar"
+		name      = "bar"
+	)
+
+	var (
+		ctrl         *gomock.Controller
+		c            *mockclient.MockClient
+		statusWriter *mockclient.MockStatusWriter
+		mgr          *mockmanager.MockManager
+		stopCh       chan struct{}
+
+		actuator       *fakeActuator
+		infrastructure *extensionsv1alpha1.Infrastructure
+		request        = reconcile.Request{NamespacedName: kutil.Key(namespace, name)}
+
+		newReconciler = func(options ReconcilerOptions) reconcile.Reconciler {
+			r := NewReconciler(mgr, actuator, options)
+			Expect(inject.ClientInto(c, r)).To(BeTrue())
+			Expect(inject.StopChannelInto(stopCh, r)).To(BeTrue())
+			return r
+		}
+
+		expectGetInfrastructure = func() {
+			c.EXPECT().Get(gomock.Any(), kutil.Key(namespace, name), gomock.AssignableToTypeOf(&extensionsv1alpha1.Infrastructure{})).
+				DoAndReturn(func(_ context.Context, _ interface{}, obj *extensionsv1alpha1.Infrastructure) error {
+					infrastructure.DeepCopyInto(obj)
+					return nil
+				})
+		}
+
+		expectGetCluster = func() {
+			c.EXPECT().Get(gomock.Any(), kutil.Key(namespace), gomock.AssignableToTypeOf(&extensionsv1alpha1.Cluster{})).
+				DoAndReturn(func(_ context.Context, _ interface{}, obj *extensionsv1alpha1.Cluster) error {
+					obj.Name = namespace
+					obj.Spec = extensionsv1alpha1.ClusterSpec{
+						CloudProfile: runtime.RawExtension{Raw: []byte(`{"apiVersion":"garden.sapcloud.io/v1beta1","kind":"CloudProfile"}`)},
+						Seed:         runtime.RawExtension{Raw: []byte(`{"apiVersion":"garden.sapcloud.io/v1beta1","kind":"Seed"}`)},
+						Shoot:        runtime.RawExtension{Raw: []byte(`{"apiVersion":"garden.sapcloud.io/v1beta1","kind":"Shoot"}`)},
+					}
+					return nil
+				}).AnyTimes()
+		}
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		c = mockclient.NewMockClient(ctrl)
+		statusWriter = mockclient.NewMockStatusWriter(ctrl)
+		mgr = mockmanager.NewMockManager(ctrl)
+		stopCh = make(chan struct{})
+
+		mgr.EXPECT().GetRecorder(ControllerName).Return(record.NewFakeRecorder(10))
+		c.EXPECT().Status().Return(statusWriter).AnyTimes()
+
+		actuator = &fakeActuator{}
+		infrastructure = &extensionsv1alpha1.Infrastructure{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:  namespace,
+				Name:       name,
+				Finalizers: []string{FinalizerName},
+			},
+		}
+	})
+
+	AfterEach(func() {
+		close(stopCh)
+		ctrl.Finish()
+	})
+
+	Describe("#Reconcile", func() {
+		var (
+			oldNow   func() time.Time
+			progress []int
+		)
+
+		BeforeEach(func() {
+			now := time.Unix(100, 0)
+			oldNow = extensionscontroller.Now
+			extensionscontroller.Now = func() time.Time {
+				now = now.Add(extensionscontroller.DefaultProgressReportInterval)
+				return now
+			}
+
+			progress = nil
+			c.EXPECT().Get(gomock.Any(), kutil.Key(namespace, name), gomock.AssignableToTypeOf(&extensionsv1alpha1.Infrastructure{})).
+				DoAndReturn(func(_ context.Context, _ interface{}, obj *extensionsv1alpha1.Infrastructure) error {
+					infrastructure.DeepCopyInto(obj)
+					return nil
+				}).AnyTimes()
+			expectGetCluster()
+			statusWriter.EXPECT().Update(gomock.Any(), gomock.AssignableToTypeOf(&extensionsv1alpha1.Infrastructure{})).
+				DoAndReturn(func(_ context.Context, obj *extensionsv1alpha1.Infrastructure) error {
+					progress = append(progress, obj.Status.LastOperation.Progress)
+					return nil
+				}).AnyTimes()
+		})
+
+		AfterEach(func() {
+			extensionscontroller.Now = oldNow
+		})
+
+		It("should report increasing progress while reconciling the infrastructure", func() {
+			actuator.progress = []int{10, 20, 90}
+
+			_, err := newReconciler(ReconcilerOptions{}).Reconcile(request)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(progress).To(Equal([]int{1, 10, 20, 90, 100}))
+		})
+
+		It("should keep the last reported progress if reconciling the infrastructure fails", func() {
+			actuator.progress = []int{10, 20}
+			actuator.err = fmt.Errorf("foo")
+
+			_, err := newReconciler(ReconcilerOptions{}).Reconcile(request)
+
+			Expect(err).To(MatchError("foo"))
+			Expect(progress).To(Equal([]int{1, 10, 20, 20}))
+		})
+
+		It("should resume with the progress of the last failed reconciliation", func() {
+			infrastructure.Status.LastOperation = &gardencorev1alpha1.LastOperation{
+				Type:     gardencorev1alpha1.LastOperationTypeReconcile,
+				State:    gardencorev1alpha1.LastOperationStateError,
+				Progress: 20,
+			}
+			infrastructure.Generation = 1
+			infrastructure.Status.ObservedGeneration = 1
+			actuator.progress = []int{10, 20, 90}
+
+			_, err := newReconciler(ReconcilerOptions{}).Reconcile(request)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(progress).To(Equal([]int{20, 20, 20, 90, 100}))
+		})
+	})
+
+	Describe("#Reconcile while resyncing", func() {
+		It("should not reconcile infrastructures whose actuator cannot detect drift", func() {
+			infrastructure.Status.LastOperation = &gardencorev1alpha1.LastOperation{
+				Type:  gardencorev1alpha1.LastOperationTypeReconcile,
+				State: gardencorev1alpha1.LastOperationStateSucceeded,
+			}
+
+			expectGetInfrastructure()
+			expectGetCluster()
+			expectGetInfrastructure()
+			statusWriter.EXPECT().Update(gomock.Any(), gomock.AssignableToTypeOf(&extensionsv1alpha1.Infrastructure{})).
+				DoAndReturn(func(_ context.Context, obj *extensionsv1alpha1.Infrastructure) error {
+					Expect(obj.Status.Conditions).To(ConsistOf(matchCondition(ConditionTypeInfrastructureDrift, gardencorev1alpha1.ConditionUnknown, "DriftDetectionUnsupported", "Cannot check the infrastructure for drift as the actuator does not support drift detection")))
+					Expect(obj.Status.LastOperation.State).To(Equal(gardencorev1alpha1.LastOperationStateSucceeded))
+					return nil
+				})
+
+			result, err := newReconciler(ReconcilerOptions{ResyncPeriod: time.Hour, AutoRepairDrift: true}).Reconcile(request)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).To(Equal(reconcile.Result{RequeueAfter: time.Hour}))
+			Expect(actuator.reconciled).To(BeFalse())
+		})
+	})
+
+	Describe("#Reconcile in dry-run mode", func() {
+		It("should plan the infrastructure instead of reconciling it", func() {
+			actuator.plan = &terraformer.Plan{Add: 1, Resources: []terraformer.PlannedResource{{Address: "aws_vpc.vpc", Action: terraformer.PlanActionCreate}}}
+
+			expectGetInfrastructure()
+			expectGetCluster()
+			expectGetInfrastructure()
+			statusWriter.EXPECT().Update(gomock.Any(), gomock.AssignableToTypeOf(&extensionsv1alpha1.Infrastructure{})).
+				DoAndReturn(func(_ context.Context, obj *extensionsv1alpha1.Infrastructure) error {
+					Expect(obj.Status.Conditions).To(ConsistOf(matchCondition(ConditionTypeInfrastructurePlan, gardencorev1alpha1.ConditionTrue, "ChangesPlanned", "1 to add, 0 to change, 0 to destroy: aws_vpc.vpc (create)")))
+					Expect(obj.Status.LastOperation).To(BeNil())
+					return nil
+				})
+
+			result, err := newReconciler(ReconcilerOptions{DryRun: true}).Reconcile(request)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).To(Equal(reconcile.Result{}))
+			Expect(actuator.planned).To(BeTrue())
+			Expect(actuator.reconciled).To(BeFalse())
+		})
+
+		It("should plan infrastructures annotated for dry-run", func() {
+			infrastructure.Annotations = map[string]string{DryRunAnnotation: "true"}
+
+			expectGetInfrastructure()
+			expectGetCluster()
+			expectGetInfrastructure()
+			statusWriter.EXPECT().Update(gomock.Any(), gomock.AssignableToTypeOf(&extensionsv1alpha1.Infrastructure{})).
+				DoAndReturn(func(_ context.Context, obj *extensionsv1alpha1.Infrastructure) error {
+					Expect(obj.Status.Conditions).To(ConsistOf(matchCondition(ConditionTypeInfrastructurePlan, gardencorev1alpha1.ConditionFalse, "NoChangesPlanned", "0 to add, 0 to change, 0 to destroy")))
+					return nil
+				})
+			actuator.plan = &terraformer.Plan{}
+
+			_, err := newReconciler(ReconcilerOptions{}).Reconcile(request)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(actuator.planned).To(BeTrue())
+			Expect(actuator.reconciled).To(BeFalse())
+		})
+
+		It("should record failed plans", func() {
+			actuator.err = fmt.Errorf("foo")
+
+			expectGetInfrastructure()
+			expectGetCluster()
+			expectGetInfrastructure()
+			statusWriter.EXPECT().Update(gomock.Any(), gomock.AssignableToTypeOf(&extensionsv1alpha1.Infrastructure{})).
+				DoAndReturn(func(_ context.Context, obj *extensionsv1alpha1.Infrastructure) error {
+					Expect(obj.Status.Conditions).To(ConsistOf(matchCondition(ConditionTypeInfrastructurePlan, gardencorev1alpha1.ConditionUnknown, "PlanFailed", "Error planning infrastructure: foo")))
+					return nil
+				})
+
+			_, err := newReconciler(ReconcilerOptions{DryRun: true}).Reconcile(request)
+
+			Expect(err).To(MatchError("foo"))
+			Expect(actuator.reconciled).To(BeFalse())
+		})
+
+		It("should keep the finalizer of deleted infrastructures and plan their destruction", func() {
+			now := metav1.Now()
+			infrastructure.DeletionTimestamp = &now
+			actuator.plan = &terraformer.Plan{Destroy: 1, Resources: []terraformer.PlannedResource{{Address: "aws_vpc.vpc", Action: terraformer.PlanActionDestroy}}}
+
+			expectGetInfrastructure()
+			expectGetCluster()
+			expectGetInfrastructure()
+			statusWriter.EXPECT().Update(gomock.Any(), gomock.AssignableToTypeOf(&extensionsv1alpha1.Infrastructure{})).
+				DoAndReturn(func(_ context.Context, obj *extensionsv1alpha1.Infrastructure) error {
+					Expect(obj.Finalizers).To(ConsistOf(FinalizerName))
+					Expect(obj.Status.Conditions).To(ConsistOf(matchCondition(ConditionTypeInfrastructurePlan, gardencorev1alpha1.ConditionTrue, "ChangesPlanned", "0 to add, 0 to change, 1 to destroy: aws_vpc.vpc (destroy)")))
+					return nil
+				})
+
+			result, err := newReconciler(ReconcilerOptions{DryRun: true}).Reconcile(request)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).To(Equal(reconcile.Result{RequeueAfter: DryRunDeletionRequeueInterval}))
+			Expect(actuator.planned).To(BeTrue())
+			Expect(actuator.deleted).To(BeFalse())
+		})
+	})
+})
+
+func matchCondition(conditionType gardencorev1alpha1.ConditionType, status gardencorev1alpha1.ConditionStatus, reason, message string) types.GomegaMatcher {
+	return gstruct.MatchFields(gstruct.IgnoreExtras, gstruct.Fields{
+		"Type":    Equal(conditionType),
+		"Status":  Equal(status),
+		"Reason":  Equal(reason),
+		"Message": Equal(message),
+	})
+}
@@ -83,6 +83,7 @@ type clusterToObjectMapper struct {
 	client         client.Client
 	newObjListFunc func() runtime.Object
 	predicates     []predicate.Predicate
+	evalPredicates func(runtime.Object, ...predicate.Predicate) bool
 }
 
 func (m *clusterToObjectMapper) Map(obj handler.MapObject) []reconcile.Request {
@@ -110,7 +111,7 @@ func (m *clusterToObjectMapper) Map(obj handler.MapObject) []reconcile.Request {
 			return err
 		}
 
-		if !EvalGenericPredicate(obj, m.predicates...) {
+		if !m.evalPredicates(obj, m.predicates...) {
 			return nil
 		}
 
@@ -129,5 +130,13 @@ func (m *clusterToObjectMapper) Map(obj handler.MapObject) []reconcile.Request {
 // ClusterToObjectMapper returns a mapper that returns requests for objects whose
 // referenced clusters have been modified.
 func ClusterToObjectMapper(client client.Client, newObjListFunc func() runtime.Object, predicates []predicate.Predicate) handler.Mapper {
-	return &clusterToObjectMapper{client, newObjListFunc, predicates}
+	return &clusterToObjectMapper{client, newObjListFunc, predicates, EvalGenericPredicate}
+}
+
+// ClusterPauseToObjectMapper returns a mapper for watches of Cluster resources that only let updates adding or
+// removing the PauseReconciliationAnnotation pass, see PauseReconciliationAnnotationChangedPredicate. It returns
+// requests for the objects in the namespace of the cluster whose predicates match a change of their pause state,
+// see EvalPauseTransitionPredicate, so that pausing and resuming the cluster is reflected in their status.
+func ClusterPauseToObjectMapper(client client.Client, newObjListFunc func() runtime.Object, predicates []predicate.Predicate) handler.Mapper {
+	return &clusterToObjectMapper{client, newObjListFunc, predicates, EvalPauseTransitionPredicate}
 }
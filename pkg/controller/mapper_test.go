@@ -397,4 +397,90 @@ var _ = Describe("Controller Mapper", func() {
 			Expect(result).To(BeNil())
 		})
 	})
+	Describe("#ClusterPauseToObjectMapper", func() {
+		var (
+			resourceName = "infra"
+			namespace    = "shoot"
+
+			newObjListFunc = func() runtime.Object { return &extensionsv1alpha1.InfrastructureList{} }
+			pausedCluster  = handler.MapObject{
+				Object: &extensionsv1alpha1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:        namespace,
+						Annotations: map[string]string{PauseReconciliationAnnotation: "true"},
+					},
+				},
+			}
+
+			expectListInfrastructures = func(infrastructureType string) {
+				c.EXPECT().
+					List(
+						gomock.AssignableToTypeOf(context.TODO()),
+						gomock.Eq(client.InNamespace(namespace)),
+						gomock.AssignableToTypeOf(&extensionsv1alpha1.InfrastructureList{}),
+					).
+					DoAndReturn(func(_ context.Context, _ *client.ListOptions, actual *extensionsv1alpha1.InfrastructureList) error {
+						*actual = extensionsv1alpha1.InfrastructureList{
+							Items: []extensionsv1alpha1.Infrastructure{
+								{
+									ObjectMeta: metav1.ObjectMeta{
+										Name:      resourceName,
+										Namespace: namespace,
+									},
+									Spec: extensionsv1alpha1.InfrastructureSpec{
+										DefaultSpec: extensionsv1alpha1.DefaultSpec{
+											Type: infrastructureType,
+										},
+									},
+								},
+							},
+						}
+						return nil
+					})
+			}
+		)
+
+		It("should find the objects of a paused cluster although their reconciliation is paused", func() {
+			mapper := ClusterPauseToObjectMapper(c, newObjListFunc, []predicate.Predicate{
+				TypePredicate("aws"),
+				ReconciliationNotPausedPredicate(c),
+				OrPredicate(GenerationChangedPredicate(), PauseReconciliationAnnotationChangedPredicate()),
+			})
+			expectListInfrastructures("aws")
+
+			Expect(mapper.Map(pausedCluster)).To(ConsistOf(reconcile.Request{
+				NamespacedName: types.NamespacedName{
+					Name:      resourceName,
+					Namespace: namespace,
+				},
+			}))
+		})
+
+		It("should find the objects of a paused cluster if the operation annotation is respected", func() {
+			operationAnnotationPredicate := predicate.Funcs{
+				UpdateFunc: func(event event.UpdateEvent) bool {
+					return HasOperationAnnotation(event.MetaNew)
+				},
+			}
+			mapper := ClusterPauseToObjectMapper(c, newObjListFunc, []predicate.Predicate{
+				TypePredicate("aws"),
+				ReconciliationNotPausedPredicate(c),
+				OrPredicate(operationAnnotationPredicate, PauseReconciliationAnnotationChangedPredicate()),
+				OrPredicate(GenerationChangedPredicate(), AnnotationsChangedPredicate()),
+			})
+			expectListInfrastructures("aws")
+
+			Expect(mapper.Map(pausedCluster)).To(HaveLen(1))
+		})
+
+		It("should find no objects whose predicates do not match", func() {
+			mapper := ClusterPauseToObjectMapper(c, newObjListFunc, []predicate.Predicate{
+				TypePredicate("aws"),
+				ReconciliationNotPausedPredicate(c),
+			})
+			expectListInfrastructures("gcp")
+
+			Expect(mapper.Map(pausedCluster)).To(BeEmpty())
+		})
+	})
 })
@@ -0,0 +1,130 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"time"
+
+	controllererror "github.com/gardener/gardener-extensions/pkg/controller/error"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+
+	"github.com/prometheus/client_golang/prometheus"
+	ctrlmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+const (
+	namespace = "gardener_extension"
+
+	// LabelKind is the metric label for the kind of the extension resource.
+	LabelKind = "kind"
+	// LabelType is the metric label for the type of the extension resource.
+	LabelType = "type"
+	// LabelOperation is the metric label for the type of the operation.
+	LabelOperation = "operation"
+	// LabelErrorCode is the metric label for the error code of a failed operation.
+	LabelErrorCode = "code"
+
+	// ErrorCodeUnknown is the error code label value for errors that do not carry a Gardener error code.
+	ErrorCodeUnknown = "unknown"
+)
+
+var (
+	// ReconcileDuration is a histogram of the duration of the operations of the extension actuators.
+	ReconcileDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "reconcile_duration_seconds",
+		Help:      "Duration of the operations of the extension actuators in seconds.",
+		Buckets:   []float64{1, 5, 10, 30, 60, 120, 300, 600, 900, 1200, 1800, 2700, 3600},
+	}, []string{LabelKind, LabelType, LabelOperation})
+
+	// ReconcileErrors is a counter of the failed operations of the extension actuators, split by the Gardener error code.
+	ReconcileErrors = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "reconcile_errors_total",
+		Help:      "Total number of failed operations of the extension actuators per Gardener error code.",
+	}, []string{LabelKind, LabelType, LabelOperation, LabelErrorCode})
+
+	// ReconcileInFlight is a gauge of the currently running operations of the extension actuators.
+	ReconcileInFlight = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "reconcile_in_flight",
+		Help:      "Number of currently running operations of the extension actuators.",
+	}, []string{LabelKind, LabelType, LabelOperation})
+
+	// ReconcileLastSuccess is a gauge of the timestamp of the last successful operation of the extension actuators.
+	ReconcileLastSuccess = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "reconcile_last_success_timestamp_seconds",
+		Help:      "Unix timestamp of the last successful operation of the extension actuators.",
+	}, []string{LabelKind, LabelType, LabelOperation})
+)
+
+func init() {
+	ctrlmetrics.Registry.MustRegister(
+		ReconcileDuration,
+		ReconcileErrors,
+		ReconcileInFlight,
+		ReconcileLastSuccess,
+	)
+}
+
+// Now returns the current time. Exposed for testing.
+var Now = time.Now
+
+// ObserveOperation records the start of an operation of the given extension kind, type and operation type.
+// It returns a function that has to be called with the result of the operation once it is done. The returned
+// function records the duration, the in-flight operations and, depending on the given error, either the
+// timestamp of the last success or the error codes. If no codes are passed, they are determined by the
+// default error classifier.
+func ObserveOperation(kind, extensionType string, operation gardencorev1alpha1.LastOperationType) func(error, ...gardencorev1alpha1.ErrorCode) {
+	var (
+		start  = Now()
+		labels = prometheus.Labels{LabelKind: kind, LabelType: extensionType, LabelOperation: string(operation)}
+	)
+
+	ReconcileInFlight.With(labels).Inc()
+
+	return func(err error, codes ...gardencorev1alpha1.ErrorCode) {
+		end := Now()
+
+		ReconcileInFlight.With(labels).Dec()
+		ReconcileDuration.With(labels).Observe(end.Sub(start).Seconds())
+
+		if err == nil {
+			ReconcileLastSuccess.With(labels).Set(float64(end.Unix()))
+			return
+		}
+
+		for _, code := range errorCodes(err, codes) {
+			ReconcileErrors.WithLabelValues(kind, extensionType, string(operation), code).Inc()
+		}
+	}
+}
+
+func errorCodes(err error, codes []gardencorev1alpha1.ErrorCode) []string {
+	if len(codes) == 0 {
+		codes = controllererror.Codes(nil, err)
+	}
+	if len(codes) == 0 {
+		return []string{ErrorCodeUnknown}
+	}
+
+	out := make([]string, 0, len(codes))
+	for _, code := range codes {
+		out = append(out, string(code))
+	}
+	return out
+}
@@ -0,0 +1,89 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics_test
+
+import (
+	"errors"
+	"time"
+
+	. "github.com/gardener/gardener-extensions/pkg/controller/metrics"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	gardencorev1alpha1helper "github.com/gardener/gardener/pkg/apis/core/v1alpha1/helper"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+)
+
+func gaugeValue(gauge *prometheus.GaugeVec, labels ...string) float64 {
+	m := &dto.Metric{}
+	Expect(gauge.WithLabelValues(labels...).Write(m)).To(Succeed())
+	return m.GetGauge().GetValue()
+}
+
+func counterValue(counter *prometheus.CounterVec, labels ...string) float64 {
+	m := &dto.Metric{}
+	Expect(counter.WithLabelValues(labels...).Write(m)).To(Succeed())
+	return m.GetCounter().GetValue()
+}
+
+var _ = Describe("Metrics", func() {
+	var (
+		oldNow func() time.Time
+		now    time.Time
+	)
+
+	BeforeEach(func() {
+		oldNow = Now
+		now = time.Unix(1000, 0)
+		Now = func() time.Time { return now }
+	})
+
+	AfterEach(func() {
+		Now = oldNow
+	})
+
+	Describe("#ObserveOperation", func() {
+		It("should track the in-flight operations and the last success", func() {
+			done := ObserveOperation("Infrastructure", "success", gardencorev1alpha1.LastOperationTypeReconcile)
+			Expect(gaugeValue(ReconcileInFlight, "Infrastructure", "success", "Reconcile")).To(Equal(1.0))
+
+			now = now.Add(10 * time.Second)
+			done(nil)
+
+			Expect(gaugeValue(ReconcileInFlight, "Infrastructure", "success", "Reconcile")).To(Equal(0.0))
+			Expect(gaugeValue(ReconcileLastSuccess, "Infrastructure", "success", "Reconcile")).To(Equal(1010.0))
+		})
+
+		It("should count errors by their error codes", func() {
+			done := ObserveOperation("Worker", "error", gardencorev1alpha1.LastOperationTypeDelete)
+			done(gardencorev1alpha1helper.NewErrorWithCode(gardencorev1alpha1.ErrorInfraQuotaExceeded, "quota exceeded"))
+
+			done = ObserveOperation("Worker", "error", gardencorev1alpha1.LastOperationTypeDelete)
+			done(errors.New("foo"))
+
+			done = ObserveOperation("Worker", "error", gardencorev1alpha1.LastOperationTypeDelete)
+			done(errors.New("Forbidden"), gardencorev1alpha1.ErrorInfraUnauthorized, gardencorev1alpha1.ErrorInfraDependencies)
+
+			Expect(counterValue(ReconcileErrors, "Worker", "error", "Delete", string(gardencorev1alpha1.ErrorInfraQuotaExceeded))).To(Equal(1.0))
+			Expect(counterValue(ReconcileErrors, "Worker", "error", "Delete", ErrorCodeUnknown)).To(Equal(1.0))
+			Expect(counterValue(ReconcileErrors, "Worker", "error", "Delete", string(gardencorev1alpha1.ErrorInfraUnauthorized))).To(Equal(1.0))
+			Expect(counterValue(ReconcileErrors, "Worker", "error", "Delete", string(gardencorev1alpha1.ErrorInfraDependencies))).To(Equal(1.0))
+			Expect(counterValue(ReconcileErrors, "Worker", "error", "Delete", string(gardencorev1alpha1.ErrorInfraInsufficientPrivileges))).To(Equal(0.0))
+			Expect(gaugeValue(ReconcileLastSuccess, "Worker", "error", "Delete")).To(Equal(0.0))
+		})
+	})
+})
@@ -0,0 +1,104 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+)
+
+const (
+	// GardenerOperationMigrate is the value of the Gardener operation annotation that requests the migration of an
+	// extension resource, i.e. the export of its state into its status before its control plane is moved to
+	// another seed.
+	GardenerOperationMigrate = "migrate"
+	// GardenerOperationRestore is the value of the Gardener operation annotation that requests the restoration of
+	// an extension resource from the state in its status after its control plane has been moved to another seed.
+	GardenerOperationRestore = "restore"
+
+	// LastOperationTypeMigrate indicates a migration operation.
+	LastOperationTypeMigrate gardencorev1alpha1.LastOperationType = "Migrate"
+	// LastOperationTypeRestore indicates a restore operation.
+	LastOperationTypeRestore gardencorev1alpha1.LastOperationType = "Restore"
+)
+
+// IsMigrateOperation returns true if the given object meta is annotated with the Gardener operation annotation
+// requesting a migration.
+func IsMigrateOperation(meta metav1.Object) bool {
+	return meta.GetAnnotations()[gardencorev1alpha1.GardenerOperation] == GardenerOperationMigrate
+}
+
+// IsRestoreOperation returns true if the given object meta is annotated with the Gardener operation annotation
+// requesting a restoration.
+func IsRestoreOperation(meta metav1.Object) bool {
+	return meta.GetAnnotations()[gardencorev1alpha1.GardenerOperation] == GardenerOperationRestore
+}
+
+// IsMigrated returns true if the state of an extension resource with the given status has been successfully
+// migrated. Migrated resources must not be reconciled anymore unless they are restored.
+func IsMigrated(status *extensionsv1alpha1.DefaultStatus) bool {
+	return status.LastOperation != nil &&
+		status.LastOperation.Type == LastOperationTypeMigrate &&
+		status.LastOperation.State == gardencorev1alpha1.LastOperationStateSucceeded
+}
+
+// RemoveMigrationOperationAnnotation removes the Gardener operation annotation from the given object if it
+// requests a migration or restoration and updates the object.
+func RemoveMigrationOperationAnnotation(ctx context.Context, c client.Client, obj runtime.Object) error {
+	accessor, err := meta.Accessor(obj)
+	if err != nil {
+		return err
+	}
+	if !IsMigrateOperation(accessor) && !IsRestoreOperation(accessor) {
+		return nil
+	}
+
+	annotations := accessor.GetAnnotations()
+	delete(annotations, gardencorev1alpha1.GardenerOperation)
+	accessor.SetAnnotations(annotations)
+	return c.Update(ctx, obj)
+}
+
+func hasMigrationOperationAnnotation(meta metav1.Object) bool {
+	return IsMigrateOperation(meta) || IsRestoreOperation(meta)
+}
+
+var migrationOperationAnnotationPredicate = predicate.Funcs{
+	CreateFunc: func(e event.CreateEvent) bool {
+		return hasMigrationOperationAnnotation(e.Meta)
+	},
+	UpdateFunc: func(e event.UpdateEvent) bool {
+		return hasMigrationOperationAnnotation(e.MetaNew)
+	},
+	GenericFunc: func(e event.GenericEvent) bool {
+		return hasMigrationOperationAnnotation(e.Meta)
+	},
+}
+
+// MigrationOperationAnnotationPredicate is a predicate for resources annotated with the Gardener operation
+// annotation requesting a migration or restoration.
+func MigrationOperationAnnotationPredicate() predicate.Predicate {
+	return migrationOperationAnnotationPredicate
+}
@@ -0,0 +1,99 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller_test
+
+import (
+	"github.com/gardener/gardener-extensions/pkg/controller"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+)
+
+var _ = Describe("Migration", func() {
+	var (
+		migrateMeta *metav1.ObjectMeta
+		restoreMeta *metav1.ObjectMeta
+		plainMeta   *metav1.ObjectMeta
+	)
+
+	BeforeEach(func() {
+		migrateMeta = &metav1.ObjectMeta{Annotations: map[string]string{gardencorev1alpha1.GardenerOperation: controller.GardenerOperationMigrate}}
+		restoreMeta = &metav1.ObjectMeta{Annotations: map[string]string{gardencorev1alpha1.GardenerOperation: controller.GardenerOperationRestore}}
+		plainMeta = &metav1.ObjectMeta{}
+	})
+
+	Describe("#IsMigrateOperation", func() {
+		It("should only match the migrate operation", func() {
+			Expect(controller.IsMigrateOperation(migrateMeta)).To(BeTrue())
+			Expect(controller.IsMigrateOperation(restoreMeta)).To(BeFalse())
+			Expect(controller.IsMigrateOperation(plainMeta)).To(BeFalse())
+		})
+	})
+
+	Describe("#IsRestoreOperation", func() {
+		It("should only match the restore operation", func() {
+			Expect(controller.IsRestoreOperation(restoreMeta)).To(BeTrue())
+			Expect(controller.IsRestoreOperation(migrateMeta)).To(BeFalse())
+			Expect(controller.IsRestoreOperation(plainMeta)).To(BeFalse())
+		})
+	})
+
+	Describe("#IsMigrated", func() {
+		It("should be migrated if the migration succeeded", func() {
+			status := &extensionsv1alpha1.DefaultStatus{
+				LastOperation: controller.LastOperation(controller.LastOperationTypeMigrate, gardencorev1alpha1.LastOperationStateSucceeded, 100, ""),
+			}
+			Expect(controller.IsMigrated(status)).To(BeTrue())
+		})
+
+		It("should not be migrated if the migration is still processing", func() {
+			status := &extensionsv1alpha1.DefaultStatus{
+				LastOperation: controller.LastOperation(controller.LastOperationTypeMigrate, gardencorev1alpha1.LastOperationStateProcessing, 1, ""),
+			}
+			Expect(controller.IsMigrated(status)).To(BeFalse())
+		})
+
+		It("should not be migrated without last operation", func() {
+			Expect(controller.IsMigrated(&extensionsv1alpha1.DefaultStatus{})).To(BeFalse())
+		})
+	})
+
+	Describe("#ReconcileRequired", func() {
+		It("should require a reconciliation for migrate and restore operations", func() {
+			status := &extensionsv1alpha1.DefaultStatus{
+				LastOperation: controller.LastOperation(gardencorev1alpha1.LastOperationTypeReconcile, gardencorev1alpha1.LastOperationStateSucceeded, 100, ""),
+			}
+			Expect(controller.ReconcileRequired(migrateMeta, status)).To(BeTrue())
+			Expect(controller.ReconcileRequired(restoreMeta, status)).To(BeTrue())
+			Expect(controller.ReconcileRequired(plainMeta, status)).To(BeFalse())
+		})
+	})
+
+	Describe("#MigrationOperationAnnotationPredicate", func() {
+		It("should match objects annotated with the migrate or restore operation", func() {
+			predicate := controller.MigrationOperationAnnotationPredicate()
+
+			Expect(predicate.Create(event.CreateEvent{Meta: migrateMeta})).To(BeTrue())
+			Expect(predicate.Update(event.UpdateEvent{MetaOld: plainMeta, MetaNew: restoreMeta})).To(BeTrue())
+			Expect(predicate.Update(event.UpdateEvent{MetaOld: migrateMeta, MetaNew: plainMeta})).To(BeFalse())
+			Expect(predicate.Generic(event.GenericEvent{Meta: plainMeta})).To(BeFalse())
+		})
+	})
+})
@@ -17,7 +17,12 @@ package operatingsystemconfig
 import (
 	"context"
 
+	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
+
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/runtime/inject"
 )
 
 // Actuator acts upon OperatingSystemConfig resources.
@@ -27,3 +32,36 @@ type Actuator interface {
 	// Delete the operating system config.
 	Delete(context.Context, *extensionsv1alpha1.OperatingSystemConfig) error
 }
+
+type operationAnnotationWrapper struct {
+	Actuator
+	client client.Client
+}
+
+// OperationAnnotationWrapper is a wrapper for an actuator that, after a successful reconcile,
+// removes the Gardener operation annotation.
+//
+// This is useful in conjunction with the OperationAnnotationPredicate.
+func OperationAnnotationWrapper(actuator Actuator) Actuator {
+	return &operationAnnotationWrapper{Actuator: actuator}
+}
+
+// InjectClient implements inject.Client.
+func (o *operationAnnotationWrapper) InjectClient(client client.Client) error {
+	o.client = client
+	return nil
+}
+
+// InjectFunc implements inject.Func.
+func (o *operationAnnotationWrapper) InjectFunc(f inject.Func) error {
+	return f(o.Actuator)
+}
+
+// Reconcile implements Actuator.
+func (o *operationAnnotationWrapper) Reconcile(ctx context.Context, osc *extensionsv1alpha1.OperatingSystemConfig) ([]byte, *string, []string, error) {
+	if err := extensionscontroller.RemoveOperationAnnotation(ctx, o.client, osc); err != nil {
+		return nil, nil, nil, err
+	}
+
+	return o.Actuator.Reconcile(ctx, osc)
+}
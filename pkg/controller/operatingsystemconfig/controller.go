@@ -18,6 +18,7 @@ import (
 	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
@@ -57,10 +58,29 @@ func Add(mgr manager.Manager, args AddArgs) error {
 }
 
 // DefaultPredicates returns the default predicates for an operatingsystemconfig reconciler.
-func DefaultPredicates(typeName string) []predicate.Predicate {
+func DefaultPredicates(client client.Client, typeName string, ignoreOperationAnnotation bool) []predicate.Predicate {
+	if ignoreOperationAnnotation {
+		return []predicate.Predicate{
+			extensionscontroller.TypePredicate(typeName),
+			extensionscontroller.ReconciliationNotPausedPredicate(client),
+			extensionscontroller.OrPredicate(
+				extensionscontroller.GenerationChangedPredicate(),
+				extensionscontroller.PauseReconciliationAnnotationChangedPredicate(),
+			),
+		}
+	}
+
 	return []predicate.Predicate{
 		extensionscontroller.TypePredicate(typeName),
-		extensionscontroller.GenerationChangedPredicate(),
+		extensionscontroller.ReconciliationNotPausedPredicate(client),
+		extensionscontroller.OrPredicate(
+			OperationAnnotationPredicate(),
+			extensionscontroller.PauseReconciliationAnnotationChangedPredicate(),
+		),
+		extensionscontroller.OrPredicate(
+			extensionscontroller.GenerationChangedPredicate(),
+			extensionscontroller.AnnotationsChangedPredicate(),
+		),
 	}
 }
 
@@ -78,5 +98,10 @@ func add(mgr manager.Manager, options controller.Options, predicates []predicate
 		return err
 	}
 
+	// Pause and resume the reconciliation of the operating system configs together with their cluster.
+	if err := ctrl.Watch(&source.Kind{Type: &extensionsv1alpha1.Cluster{}}, &handler.EnqueueRequestsFromMapFunc{ToRequests: ClusterPauseToOSCMapper(mgr.GetClient(), predicates)}, extensionscontroller.PauseReconciliationAnnotationChangedPredicate()); err != nil {
+		return err
+	}
+
 	return nil
 }
@@ -22,6 +22,7 @@ import (
 	extensions1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -75,3 +76,9 @@ func (m *secretToOSCMapper) Map(obj handler.MapObject) []reconcile.Request {
 func SecretToOSCMapper(client client.Client, predicates []predicate.Predicate) handler.Mapper {
 	return &secretToOSCMapper{client, predicates}
 }
+
+// ClusterPauseToOSCMapper returns a mapper that returns requests for OperatingSystemConfigs whose
+// referenced clusters have been paused or resumed.
+func ClusterPauseToOSCMapper(client client.Client, predicates []predicate.Predicate) handler.Mapper {
+	return extensionscontroller.ClusterPauseToObjectMapper(client, func() runtime.Object { return &extensions1alpha1.OperatingSystemConfigList{} }, predicates)
+}
@@ -23,20 +23,30 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 )
 
-// Default options
-var options = controller.Options{}
+var (
+	// DefaultAddOptions are the default AddOptions for AddToManager.
+	DefaultAddOptions = AddOptions{}
+)
+
+// AddOptions are the options for adding the controller to the manager.
+type AddOptions struct {
+	// Controller are the controller related options.
+	Controller controller.Options
+	// IgnoreOperationAnnotation specifies whether to ignore the operation annotation or not.
+	IgnoreOperationAnnotation bool
+}
 
 // AddToManagerWithOptions adds a controller with the given Options to the given manager.
 // The opts.Reconciler is being set with a newly instantiated actuator.
-func AddToManagerWithOptions(mgr manager.Manager, os string, generator generator.Generator, opts controller.Options) error {
+func AddToManagerWithOptions(mgr manager.Manager, os string, generator generator.Generator, opts AddOptions) error {
 	return operatingsystemconfig.Add(mgr, operatingsystemconfig.AddArgs{
-		Actuator:          actuator.NewActuator(os, generator),
-		Predicates:        operatingsystemconfig.DefaultPredicates(os),
-		ControllerOptions: opts,
+		Actuator:          operatingsystemconfig.OperationAnnotationWrapper(actuator.NewActuator(os, generator)),
+		Predicates:        operatingsystemconfig.DefaultPredicates(mgr.GetClient(), os, opts.IgnoreOperationAnnotation),
+		ControllerOptions: opts.Controller,
 	})
 }
 
 // AddToManager adds a controller with the default Options.
 func AddToManager(mgr manager.Manager, os string, generator generator.Generator) error {
-	return AddToManagerWithOptions(mgr, os, generator, options)
+	return AddToManagerWithOptions(mgr, os, generator, DefaultAddOptions)
 }
@@ -18,6 +18,7 @@ import (
 	"context"
 	extcontroller "github.com/gardener/gardener-extensions/pkg/controller"
 	controllercmd "github.com/gardener/gardener-extensions/pkg/controller/cmd"
+	"github.com/gardener/gardener-extensions/pkg/controller/operatingsystemconfig/oscommon"
 	oscommoncmd "github.com/gardener/gardener-extensions/pkg/controller/operatingsystemconfig/oscommon/cmd"
 	"github.com/gardener/gardener-extensions/pkg/controller/operatingsystemconfig/oscommon/generator"
 	"github.com/spf13/cobra"
@@ -37,6 +38,9 @@ func NewControllerCommand(ctx context.Context, osName string, generator generato
 		ctrlOpts = &controllercmd.ControllerOptions{
 			MaxConcurrentReconciles: 5,
 		}
+		reconcileOpts = &controllercmd.ReconcilerOptions{
+			IgnoreOperationAnnotation: true,
+		}
 
 		controllerSwitches = oscommoncmd.SwitchOptions(osName, generator)
 
@@ -44,6 +48,7 @@ func NewControllerCommand(ctx context.Context, osName string, generator generato
 			restOpts,
 			mgrOpts,
 			ctrlOpts,
+			reconcileOpts,
 			controllerSwitches,
 		)
 	)
@@ -65,6 +70,9 @@ func NewControllerCommand(ctx context.Context, osName string, generator generato
 				controllercmd.LogErrAndExit(err, "Could not update manager scheme")
 			}
 
+			ctrlOpts.Completed().Apply(&oscommon.DefaultAddOptions.Controller)
+			reconcileOpts.Completed().Apply(&oscommon.DefaultAddOptions.IgnoreOperationAnnotation)
+
 			if err := controllerSwitches.Completed().AddToManager(mgr); err != nil {
 				controllercmd.LogErrAndExit(err, "Could not add controller to manager")
 			}
@@ -21,6 +21,8 @@ import (
 
 	"github.com/gardener/gardener-extensions/pkg/controller"
 	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
+	controllererror "github.com/gardener/gardener-extensions/pkg/controller/error"
+	"github.com/gardener/gardener-extensions/pkg/controller/metrics"
 	"github.com/gardener/gardener-extensions/pkg/util"
 	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
 	gardencorev1alpha1helper "github.com/gardener/gardener/pkg/apis/core/v1alpha1/helper"
@@ -93,10 +95,26 @@ func (r *reconciler) Reconcile(request reconcile.Request) (reconcile.Result, err
 		return reconcile.Result{}, err
 	}
 
+	ctx := extensionscontroller.WithConditionRecorder(r.ctx, extensionscontroller.NewConditionRecorder())
+
 	if osc.DeletionTimestamp != nil {
-		return r.delete(r.ctx, osc)
+		return r.delete(ctx, osc)
 	}
-	return r.reconcile(r.ctx, osc)
+
+	paused, err := extensionscontroller.ReconciliationPaused(ctx, r.client, osc)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+	extensionscontroller.SetReconciliationPausedCondition(ctx, osc.Status.Conditions, paused)
+	if paused {
+		return r.pause(ctx, osc)
+	}
+	return r.reconcile(ctx, osc)
+}
+
+func (r *reconciler) pause(ctx context.Context, osc *extensionsv1alpha1.OperatingSystemConfig) (reconcile.Result, error) {
+	r.logger.Info("Reconciliation of the operating system config is paused", "osc", osc.Name)
+	return reconcile.Result{}, r.updateStatusConditions(ctx, osc)
 }
 
 func (r *reconciler) reconcile(ctx context.Context, osc *extensionsv1alpha1.OperatingSystemConfig) (reconcile.Result, error) {
@@ -110,8 +128,10 @@ func (r *reconciler) reconcile(ctx context.Context, osc *extensionsv1alpha1.Oper
 	}
 
 	r.logger.Info("Starting the reconciliation of operating system config", "osc", osc.Name)
+	observeOperation := metrics.ObserveOperation(extensionscontroller.UnsafeGuessKind(osc), osc.Spec.Type, operationType)
 	userData, command, units, err := r.actuator.Reconcile(ctx, osc)
 	if err != nil {
+		observeOperation(extensionscontroller.ReconcileErrCauseOrErr(err))
 		msg := "Error reconciling operating system config"
 		utilruntime.HandleError(r.updateStatusError(ctx, extensionscontroller.ReconcileErrCauseOrErr(err), osc, operationType, msg))
 		r.logger.Error(err, msg, "osc", osc.Name)
@@ -127,6 +147,7 @@ func (r *reconciler) reconcile(ctx context.Context, osc *extensionsv1alpha1.Oper
 
 		return controllerutil.SetControllerReference(osc, secret, r.scheme)
 	}); err != nil {
+		observeOperation(extensionscontroller.ReconcileErrCauseOrErr(err))
 		msg := "Could not apply secret for generated cloud config"
 		utilruntime.HandleError(r.updateStatusError(ctx, extensionscontroller.ReconcileErrCauseOrErr(err), osc, operationType, msg))
 		r.logger.Error(err, msg, "osc", osc.Name)
@@ -144,6 +165,8 @@ func (r *reconciler) reconcile(ctx context.Context, osc *extensionsv1alpha1.Oper
 		osc.Status.Command = command
 	}
 
+	observeOperation(nil)
+
 	msg := "Successfully reconciled operating system config"
 	r.logger.Info(msg, "osc", osc.Name)
 	if err := r.updateStatusSuccess(ctx, osc, operationType, msg); err != nil {
@@ -170,13 +193,17 @@ func (r *reconciler) delete(ctx context.Context, osc *extensionsv1alpha1.Operati
 	}
 
 	r.logger.Info("Starting the deletion of operating system config", "osc", osc.Name)
+	observeOperation := metrics.ObserveOperation(extensionscontroller.UnsafeGuessKind(osc), osc.Spec.Type, operationType)
 	if err := r.actuator.Delete(ctx, osc); err != nil {
+		observeOperation(extensionscontroller.ReconcileErrCauseOrErr(err))
 		msg := "Error deleting operating system config"
 		utilruntime.HandleError(r.updateStatusError(ctx, extensionscontroller.ReconcileErrCauseOrErr(err), osc, operationType, msg))
 		r.logger.Error(err, msg, "osc", osc.Name)
 		return extensionscontroller.ReconcileErr(err)
 	}
 
+	observeOperation(nil)
+
 	msg := "Successfully deleted operating system config"
 	r.logger.Info(msg, "osc", osc.Name)
 	if err := r.updateStatusSuccess(ctx, osc, operationType, msg); err != nil {
@@ -197,14 +224,21 @@ func (r *reconciler) updateStatusProcessing(ctx context.Context, osc *extensions
 	return r.client.Status().Update(ctx, osc)
 }
 
+func (r *reconciler) updateStatusConditions(ctx context.Context, osc *extensionsv1alpha1.OperatingSystemConfig) error {
+	osc.Status.Conditions = extensionscontroller.MergeRecordedConditions(ctx, osc.Status.Conditions)
+	return r.client.Status().Update(ctx, osc)
+}
+
 func (r *reconciler) updateStatusError(ctx context.Context, err error, osc *extensionsv1alpha1.OperatingSystemConfig, lastOperationType gardencorev1alpha1.LastOperationType, description string) error {
 	osc.Status.ObservedGeneration = osc.Generation
-	osc.Status.LastOperation, osc.Status.LastError = extensionscontroller.ReconcileError(lastOperationType, gardencorev1alpha1helper.FormatLastErrDescription(fmt.Errorf("%s: %v", description, err)), 50, gardencorev1alpha1helper.ExtractErrorCodes(err)...)
+	osc.Status.Conditions = extensionscontroller.MergeRecordedConditions(ctx, osc.Status.Conditions)
+	osc.Status.LastOperation, osc.Status.LastError = extensionscontroller.ReconcileError(lastOperationType, gardencorev1alpha1helper.FormatLastErrDescription(fmt.Errorf("%s: %v", description, err)), 50, controllererror.Codes(nil, err)...)
 	return r.client.Status().Update(ctx, osc)
 }
 
 func (r *reconciler) updateStatusSuccess(ctx context.Context, osc *extensionsv1alpha1.OperatingSystemConfig, lastOperationType gardencorev1alpha1.LastOperationType, description string) error {
 	osc.Status.ObservedGeneration = osc.Generation
+	osc.Status.Conditions = extensionscontroller.MergeRecordedConditions(ctx, osc.Status.Conditions)
 	osc.Status.LastOperation, osc.Status.LastError = extensionscontroller.ReconcileSucceeded(lastOperationType, description)
 	return r.client.Status().Update(ctx, osc)
 }
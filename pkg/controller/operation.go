@@ -0,0 +1,68 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// HasOperationAnnotation returns true if the given object meta is annotated with the Gardener operation
+// annotation requesting a reconciliation.
+func HasOperationAnnotation(meta metav1.Object) bool {
+	return meta.GetAnnotations()[gardencorev1alpha1.GardenerOperation] == gardencorev1alpha1.GardenerOperationReconcile
+}
+
+// ReconcileRequired returns true if an extension resource with the given object meta and status has to be
+// reconciled although the operation annotation is respected. This is the case if the resource is being
+// deleted, if its generation has not been observed yet, if its last operation was a creation or deletion
+// or did not succeed, or if it is annotated with the operation annotation requesting a reconciliation,
+// migration or restoration.
+func ReconcileRequired(meta metav1.Object, status *extensionsv1alpha1.DefaultStatus) bool {
+	return meta.GetDeletionTimestamp() != nil ||
+		meta.GetGeneration() != status.ObservedGeneration ||
+		status.LastOperation == nil ||
+		status.LastOperation.Type == gardencorev1alpha1.LastOperationTypeCreate ||
+		status.LastOperation.Type == gardencorev1alpha1.LastOperationTypeDelete ||
+		status.LastOperation.State != gardencorev1alpha1.LastOperationStateSucceeded ||
+		HasOperationAnnotation(meta) ||
+		IsMigrateOperation(meta) ||
+		IsRestoreOperation(meta)
+}
+
+// RemoveOperationAnnotation removes the Gardener operation annotation from the given object if it requests a
+// reconciliation and updates the object.
+func RemoveOperationAnnotation(ctx context.Context, c client.Client, obj runtime.Object) error {
+	accessor, err := meta.Accessor(obj)
+	if err != nil {
+		return err
+	}
+	if !HasOperationAnnotation(accessor) {
+		return nil
+	}
+
+	annotations := accessor.GetAnnotations()
+	delete(annotations, gardencorev1alpha1.GardenerOperation)
+	accessor.SetAnnotations(annotations)
+	return c.Update(ctx, obj)
+}
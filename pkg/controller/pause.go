@@ -0,0 +1,79 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	gardencorev1alpha1helper "github.com/gardener/gardener/pkg/apis/core/v1alpha1/helper"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// PauseReconciliationAnnotation is the annotation that pauses the reconciliation of an extension resource if it
+	// is set to "true" on the resource itself or on the Cluster resource of its namespace. Deletions are still processed.
+	PauseReconciliationAnnotation = "extensions.gardener.cloud/pause-reconciliation"
+
+	// ConditionTypeReconciliationPaused is a condition type indicating whether the reconciliation of an extension
+	// resource is paused.
+	ConditionTypeReconciliationPaused gardencorev1alpha1.ConditionType = "ReconciliationPaused"
+
+	// EventReconciliationPaused is the reason of events recorded for extension resources whose reconciliation is paused.
+	EventReconciliationPaused = "ReconciliationPaused"
+)
+
+// HasPauseReconciliationAnnotation returns true if the given object is annotated with the PauseReconciliationAnnotation.
+func HasPauseReconciliationAnnotation(meta metav1.Object) bool {
+	return meta.GetAnnotations()[PauseReconciliationAnnotation] == "true"
+}
+
+// ReconciliationPaused returns true if the reconciliation of the given object is paused, i.e. if either the object
+// itself or the Cluster resource of its namespace is annotated with the PauseReconciliationAnnotation.
+func ReconciliationPaused(ctx context.Context, c client.Client, meta metav1.Object) (bool, error) {
+	if HasPauseReconciliationAnnotation(meta) {
+		return true, nil
+	}
+
+	cluster := &extensionsv1alpha1.Cluster{}
+	if err := c.Get(ctx, kutil.Key(meta.GetNamespace()), cluster); err != nil {
+		if apierrors.IsNotFound(err) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	return HasPauseReconciliationAnnotation(cluster), nil
+}
+
+// SetReconciliationPausedCondition records the ReconciliationPaused condition in the ConditionRecorder carried by
+// the given context. If the reconciliation is not paused, the condition is only recorded if it is already part of
+// the given conditions, so that resources that were never paused do not carry the condition.
+func SetReconciliationPausedCondition(ctx context.Context, conditions []gardencorev1alpha1.Condition, paused bool) {
+	if paused {
+		SetCondition(ctx, ConditionTypeReconciliationPaused, gardencorev1alpha1.ConditionTrue, EventReconciliationPaused, "Reconciliation is paused by the '"+PauseReconciliationAnnotation+"' annotation.")
+		return
+	}
+
+	if gardencorev1alpha1helper.GetCondition(conditions, ConditionTypeReconciliationPaused) != nil {
+		SetCondition(ctx, ConditionTypeReconciliationPaused, gardencorev1alpha1.ConditionFalse, "ReconciliationResumed", "Reconciliation is not paused.")
+	}
+}
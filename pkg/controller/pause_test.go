@@ -0,0 +1,172 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller_test
+
+import (
+	"context"
+
+	"github.com/gardener/gardener-extensions/pkg/controller"
+	mockclient "github.com/gardener/gardener-extensions/pkg/mock/controller-runtime/client"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+)
+
+var _ = Describe("Pause", func() {
+	const namespace = "shoot--foo--bar"
+
+	var (
+		ctx  context.Context
+		ctrl *gomock.Controller
+		c    *mockclient.MockClient
+
+		pausedMeta    *metav1.ObjectMeta
+		notPausedMeta *metav1.ObjectMeta
+	)
+
+	BeforeEach(func() {
+		ctx = context.TODO()
+		ctrl = gomock.NewController(GinkgoT())
+		c = mockclient.NewMockClient(ctrl)
+
+		pausedMeta = &metav1.ObjectMeta{
+			Namespace:   namespace,
+			Annotations: map[string]string{controller.PauseReconciliationAnnotation: "true"},
+		}
+		notPausedMeta = &metav1.ObjectMeta{Namespace: namespace}
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	expectGetCluster := func(annotations map[string]string) {
+		c.EXPECT().Get(ctx, kutil.Key(namespace), gomock.AssignableToTypeOf(&extensionsv1alpha1.Cluster{})).
+			DoAndReturn(func(_ context.Context, _ interface{}, cluster *extensionsv1alpha1.Cluster) error {
+				cluster.Annotations = annotations
+				return nil
+			})
+	}
+
+	Describe("#ReconciliationPaused", func() {
+		It("should be paused if the object is annotated", func() {
+			Expect(controller.ReconciliationPaused(ctx, c, pausedMeta)).To(BeTrue())
+		})
+
+		It("should be paused if the cluster is annotated", func() {
+			expectGetCluster(map[string]string{controller.PauseReconciliationAnnotation: "true"})
+
+			Expect(controller.ReconciliationPaused(ctx, c, notPausedMeta)).To(BeTrue())
+		})
+
+		It("should not be paused if neither the object nor the cluster is annotated", func() {
+			expectGetCluster(nil)
+
+			Expect(controller.ReconciliationPaused(ctx, c, notPausedMeta)).To(BeFalse())
+		})
+
+		It("should not be paused if the cluster does not exist", func() {
+			c.EXPECT().Get(ctx, kutil.Key(namespace), gomock.AssignableToTypeOf(&extensionsv1alpha1.Cluster{})).
+				Return(apierrors.NewNotFound(schema.GroupResource{}, namespace))
+
+			Expect(controller.ReconciliationPaused(ctx, c, notPausedMeta)).To(BeFalse())
+		})
+	})
+
+	Describe("#SetReconciliationPausedCondition", func() {
+		BeforeEach(func() {
+			ctx = controller.WithConditionRecorder(ctx, controller.NewConditionRecorder())
+		})
+
+		It("should record a true condition if paused", func() {
+			controller.SetReconciliationPausedCondition(ctx, nil, true)
+
+			conditions := controller.MergeRecordedConditions(ctx, nil)
+			Expect(conditions).To(HaveLen(1))
+			Expect(conditions[0].Type).To(Equal(controller.ConditionTypeReconciliationPaused))
+			Expect(conditions[0].Status).To(Equal(gardencorev1alpha1.ConditionTrue))
+		})
+
+		It("should not record a condition if not paused and never paused before", func() {
+			controller.SetReconciliationPausedCondition(ctx, nil, false)
+
+			Expect(controller.MergeRecordedConditions(ctx, nil)).To(BeEmpty())
+		})
+
+		It("should record a false condition if resumed", func() {
+			existing := []gardencorev1alpha1.Condition{{Type: controller.ConditionTypeReconciliationPaused, Status: gardencorev1alpha1.ConditionTrue}}
+			controller.SetReconciliationPausedCondition(ctx, existing, false)
+
+			conditions := controller.MergeRecordedConditions(ctx, existing)
+			Expect(conditions).To(HaveLen(1))
+			Expect(conditions[0].Status).To(Equal(gardencorev1alpha1.ConditionFalse))
+		})
+	})
+
+	Describe("#ReconciliationNotPausedPredicate", func() {
+		It("should not match paused objects", func() {
+			predicate := controller.ReconciliationNotPausedPredicate(c)
+
+			Expect(predicate.Create(event.CreateEvent{Meta: pausedMeta})).To(BeFalse())
+			Expect(predicate.Update(event.UpdateEvent{MetaOld: pausedMeta, MetaNew: pausedMeta})).To(BeFalse())
+			Expect(predicate.Generic(event.GenericEvent{Meta: pausedMeta})).To(BeFalse())
+			Expect(predicate.Delete(event.DeleteEvent{Meta: pausedMeta})).To(BeTrue())
+		})
+
+		It("should match paused objects that are being deleted", func() {
+			now := metav1.Now()
+			pausedMeta.DeletionTimestamp = &now
+			predicate := controller.ReconciliationNotPausedPredicate(c)
+
+			Expect(predicate.Update(event.UpdateEvent{MetaOld: pausedMeta, MetaNew: pausedMeta})).To(BeTrue())
+		})
+
+		It("should match updates that add or remove the annotation", func() {
+			predicate := controller.ReconciliationNotPausedPredicate(c)
+
+			Expect(predicate.Update(event.UpdateEvent{MetaOld: notPausedMeta, MetaNew: pausedMeta})).To(BeTrue())
+			Expect(predicate.Update(event.UpdateEvent{MetaOld: pausedMeta, MetaNew: notPausedMeta})).To(BeTrue())
+		})
+
+		It("should match objects that are not paused", func() {
+			expectGetCluster(nil)
+			predicate := controller.ReconciliationNotPausedPredicate(c)
+
+			Expect(predicate.Create(event.CreateEvent{Meta: notPausedMeta})).To(BeTrue())
+		})
+	})
+
+	Describe("#PauseReconciliationAnnotationChangedPredicate", func() {
+		It("should only match updates that add or remove the annotation", func() {
+			predicate := controller.PauseReconciliationAnnotationChangedPredicate()
+
+			Expect(predicate.Update(event.UpdateEvent{MetaOld: notPausedMeta, MetaNew: pausedMeta})).To(BeTrue())
+			Expect(predicate.Update(event.UpdateEvent{MetaOld: pausedMeta, MetaNew: notPausedMeta})).To(BeTrue())
+			Expect(predicate.Update(event.UpdateEvent{MetaOld: pausedMeta, MetaNew: pausedMeta})).To(BeFalse())
+			Expect(predicate.Create(event.CreateEvent{Meta: pausedMeta})).To(BeFalse())
+			Expect(predicate.Delete(event.DeleteEvent{Meta: pausedMeta})).To(BeFalse())
+			Expect(predicate.Generic(event.GenericEvent{Meta: pausedMeta})).To(BeFalse())
+		})
+	})
+})
@@ -22,6 +22,7 @@ import (
 	"k8s.io/apimachinery/pkg/api/equality"
 
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"sigs.k8s.io/controller-runtime/pkg/runtime/log"
@@ -47,6 +48,49 @@ func EvalGenericPredicate(obj runtime.Object, predicates ...predicate.Predicate)
 	return true
 }
 
+// EvalPauseTransitionPredicate returns true if all predicates match an update of the given object that adds or
+// removes the PauseReconciliationAnnotation. It is used to let the pause state changes that are inherited from the
+// Cluster resource pass the predicates of the objects, which would otherwise drop them like any other event of a
+// paused object.
+func EvalPauseTransitionPredicate(obj runtime.Object, predicates ...predicate.Predicate) bool {
+	accessor, err := meta.Accessor(obj)
+	if err != nil {
+		return false
+	}
+
+	oldObj := obj.DeepCopyObject()
+	oldAccessor, err := meta.Accessor(oldObj)
+	if err != nil {
+		return false
+	}
+
+	annotations := make(map[string]string, len(accessor.GetAnnotations())+1)
+	for key, value := range accessor.GetAnnotations() {
+		annotations[key] = value
+	}
+	if HasPauseReconciliationAnnotation(accessor) {
+		delete(annotations, PauseReconciliationAnnotation)
+	} else {
+		annotations[PauseReconciliationAnnotation] = "true"
+	}
+	oldAccessor.SetAnnotations(annotations)
+
+	e := event.UpdateEvent{
+		MetaOld:   oldAccessor,
+		ObjectOld: oldObj,
+		MetaNew:   accessor,
+		ObjectNew: obj,
+	}
+
+	for _, p := range predicates {
+		if !p.Update(e) {
+			return false
+		}
+	}
+
+	return true
+}
+
 // ShootFailedPredicate is a predicate for failed shoots.
 func ShootFailedPredicate(c client.Client) predicate.Predicate {
 	ctx := context.TODO()
@@ -78,6 +122,48 @@ func ShootFailedPredicate(c client.Client) predicate.Predicate {
 	}
 }
 
+// ReconciliationNotPausedPredicate is a predicate for resources whose reconciliation is not paused, see
+// PauseReconciliationAnnotation. Events for resources that are being deleted and updates that add or remove
+// the annotation always match. Controllers using this predicate have to watch the Cluster resources, so that the
+// events that were dropped while the Cluster was paused are caught up on once it is resumed.
+func ReconciliationNotPausedPredicate(c client.Client) predicate.Predicate {
+	ctx := context.TODO()
+	log := PredicateLog.WithName("reconciliation-not-paused")
+
+	notPaused := func(log logr.Logger, meta metav1.Object) bool {
+		if meta.GetDeletionTimestamp() != nil {
+			return true
+		}
+
+		paused, err := ReconciliationPaused(ctx, c, meta)
+		if err != nil {
+			log.Info("Could not determine whether reconciliation is paused", "error", err.Error())
+			return false
+		}
+
+		return !paused
+	}
+
+	return predicate.Funcs{
+		CreateFunc: func(event event.CreateEvent) bool {
+			return notPaused(CreateEventLogger(log, event), event.Meta)
+		},
+		UpdateFunc: func(event event.UpdateEvent) bool {
+			// Let changes of the annotation itself pass so that pausing and resuming is reflected in the status.
+			if pauseReconciliationAnnotationChangedPredicate.Update(event) {
+				return true
+			}
+			return notPaused(UpdateEventLogger(log, event), event.MetaNew)
+		},
+		DeleteFunc: func(event event.DeleteEvent) bool {
+			return true
+		},
+		GenericFunc: func(event event.GenericEvent) bool {
+			return notPaused(GenericEventLogger(log, event), event.Meta)
+		},
+	}
+}
+
 var generationChangedPredicate = predicate.Funcs{
 	UpdateFunc: func(e event.UpdateEvent) bool {
 		return e.MetaOld.GetGeneration() != e.MetaNew.GetGeneration()
@@ -100,6 +186,28 @@ func AnnotationsChangedPredicate() predicate.Predicate {
 	return annotationsChangedPredicate
 }
 
+var pauseReconciliationAnnotationChangedPredicate = predicate.Funcs{
+	CreateFunc: func(e event.CreateEvent) bool {
+		return false
+	},
+	UpdateFunc: func(e event.UpdateEvent) bool {
+		return HasPauseReconciliationAnnotation(e.MetaOld) != HasPauseReconciliationAnnotation(e.MetaNew)
+	},
+	DeleteFunc: func(e event.DeleteEvent) bool {
+		return false
+	},
+	GenericFunc: func(e event.GenericEvent) bool {
+		return false
+	},
+}
+
+// PauseReconciliationAnnotationChangedPredicate is a predicate for updates that add or remove the
+// PauseReconciliationAnnotation. All other events don't match, so that it can also be used for the watches of
+// Cluster resources that resume the reconciliation of the extension resources in their namespace.
+func PauseReconciliationAnnotationChangedPredicate() predicate.Predicate {
+	return pauseReconciliationAnnotationChangedPredicate
+}
+
 // OrPredicate builds a logical OR gate of passed predicates.
 func OrPredicate(predicates ...predicate.Predicate) predicate.Predicate {
 	orRange := func(f func(predicate.Predicate) bool) bool {
@@ -0,0 +1,161 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+)
+
+// DefaultProgressReportInterval is the default minimum interval between two progress reports that are written
+// into the status of an extension resource.
+const DefaultProgressReportInterval = 10 * time.Second
+
+// Now returns the current time. It is exposed as a variable so that it can be overwritten in tests.
+var Now = time.Now
+
+// ProgressReporter reports the progress of a long-running operation.
+type ProgressReporter interface {
+	// Report reports the given progress (in percent) together with a description of the current step.
+	Report(ctx context.Context, progress int, description string)
+}
+
+// ProgressReporterFunc is a function that satisfies ProgressReporter.
+type ProgressReporterFunc func(ctx context.Context, progress int, description string)
+
+// Report reports the given progress (in percent) together with a description of the current step.
+func (f ProgressReporterFunc) Report(ctx context.Context, progress int, description string) {
+	f(ctx, progress, description)
+}
+
+// ProgressWriter persists the given progress and description, e.g. into the last operation of an extension resource.
+type ProgressWriter func(ctx context.Context, progress int, description string) error
+
+// ProgressTracker is implemented by ProgressReporters that keep track of the progress reported to them.
+type ProgressTracker interface {
+	// LastProgress returns the progress (in percent) that has been reported last.
+	LastProgress() int
+}
+
+// NewThrottledProgressReporter creates a new ProgressReporter that calls the given writer at most once per
+// given interval. Reports that do not change the progress or the description are dropped, and so are reports
+// that arrive before the interval has passed, except for those that complete the operation.
+// The reported progress starts with the given initial progress and never decreases, i.e. lower progress values
+// are raised to the last reported one. The returned ProgressReporter implements ProgressTracker.
+// Errors returned by the writer are handled by utilruntime.HandleError.
+func NewThrottledProgressReporter(interval time.Duration, initialProgress int, writer ProgressWriter) ProgressReporter {
+	return &throttledProgressReporter{
+		interval:     interval,
+		writer:       writer,
+		progress:     initialProgress,
+		lastProgress: initialProgress,
+	}
+}
+
+type throttledProgressReporter struct {
+	interval time.Duration
+	writer   ProgressWriter
+
+	lock            sync.Mutex
+	progress        int
+	lastReport      time.Time
+	lastProgress    int
+	lastDescription string
+}
+
+// Report implements ProgressReporter.
+func (r *throttledProgressReporter) Report(ctx context.Context, progress int, description string) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if progress < r.progress {
+		progress = r.progress
+	}
+	r.progress = progress
+
+	if progress == r.lastProgress && description == r.lastDescription {
+		return
+	}
+	now := Now()
+	if progress < 100 && now.Sub(r.lastReport) < r.interval {
+		return
+	}
+
+	if err := r.writer(ctx, progress, description); err != nil {
+		utilruntime.HandleError(err)
+		return
+	}
+
+	r.lastReport = now
+	r.lastProgress = progress
+	r.lastDescription = description
+}
+
+// LastProgress implements ProgressTracker. It also returns the progress of reports that have been throttled.
+func (r *throttledProgressReporter) LastProgress() int {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	return r.progress
+}
+
+// InitialProgress returns the progress an operation of the given type starts with. An operation that is retried
+// after the last operation of the same type did not succeed resumes with the progress the last operation reached,
+// any other operation starts with 1.
+func InitialProgress(lastOperation *gardencorev1alpha1.LastOperation, lastOperationType gardencorev1alpha1.LastOperationType) int {
+	if lastOperation == nil || lastOperation.Type != lastOperationType || lastOperation.State == gardencorev1alpha1.LastOperationStateSucceeded {
+		return 1
+	}
+	if lastOperation.Progress < 1 || lastOperation.Progress >= 100 {
+		return 1
+	}
+	return lastOperation.Progress
+}
+
+type progressReporterContextKey struct{}
+
+// WithProgressReporter returns a copy of the given context that carries the given ProgressReporter.
+func WithProgressReporter(ctx context.Context, reporter ProgressReporter) context.Context {
+	return context.WithValue(ctx, progressReporterContextKey{}, reporter)
+}
+
+// ProgressReporterFromContext returns the ProgressReporter carried by the given context, or nil if there is none.
+func ProgressReporterFromContext(ctx context.Context) ProgressReporter {
+	reporter, _ := ctx.Value(progressReporterContextKey{}).(ProgressReporter)
+	return reporter
+}
+
+// LastReportedProgress returns the progress last reported with the ProgressReporter carried by the given context.
+// It returns the given default progress if the context does not carry a ProgressReporter that implements
+// ProgressTracker.
+func LastReportedProgress(ctx context.Context, defaultProgress int) int {
+	if tracker, ok := ProgressReporterFromContext(ctx).(ProgressTracker); ok {
+		return tracker.LastProgress()
+	}
+	return defaultProgress
+}
+
+// ReportProgress reports the given progress with the ProgressReporter carried by the given context.
+// It does nothing if the context does not carry a ProgressReporter.
+func ReportProgress(ctx context.Context, progress int, description string) {
+	if reporter := ProgressReporterFromContext(ctx); reporter != nil {
+		reporter.Report(ctx, progress, description)
+	}
+}
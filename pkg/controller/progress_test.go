@@ -0,0 +1,148 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/gardener/gardener-extensions/pkg/controller"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Progress", func() {
+	type report struct {
+		progress    int
+		description string
+	}
+
+	var (
+		ctx      context.Context
+		now      time.Time
+		oldNow   func() time.Time
+		reports  []report
+		reporter controller.ProgressReporter
+	)
+
+	BeforeEach(func() {
+		reports = nil
+		now = time.Unix(100, 0)
+		oldNow = controller.Now
+		controller.Now = func() time.Time { return now }
+
+		reporter = controller.NewThrottledProgressReporter(10*time.Second, 1, func(_ context.Context, progress int, description string) error {
+			reports = append(reports, report{progress, description})
+			return nil
+		})
+		ctx = controller.WithProgressReporter(context.TODO(), reporter)
+	})
+
+	AfterEach(func() {
+		controller.Now = oldNow
+	})
+
+	Describe("#ReportProgress", func() {
+		It("should do nothing if the context carries no reporter", func() {
+			controller.ReportProgress(context.TODO(), 10, "step")
+			Expect(reports).To(BeEmpty())
+		})
+
+		It("should report with the reporter of the context", func() {
+			controller.ReportProgress(ctx, 10, "step")
+			Expect(reports).To(Equal([]report{{10, "step"}}))
+		})
+	})
+
+	Describe("#NewThrottledProgressReporter", func() {
+		It("should throttle reports within the interval", func() {
+			reporter.Report(ctx, 10, "step 1")
+			now = now.Add(5 * time.Second)
+			reporter.Report(ctx, 20, "step 2")
+			now = now.Add(5 * time.Second)
+			reporter.Report(ctx, 30, "step 3")
+
+			Expect(reports).To(Equal([]report{{10, "step 1"}, {30, "step 3"}}))
+		})
+
+		It("should drop unchanged reports", func() {
+			reporter.Report(ctx, 10, "step 1")
+			now = now.Add(time.Minute)
+			reporter.Report(ctx, 10, "step 1")
+
+			Expect(reports).To(Equal([]report{{10, "step 1"}}))
+		})
+
+		It("should not throttle completing reports", func() {
+			reporter.Report(ctx, 10, "step 1")
+			reporter.Report(ctx, 100, "done")
+
+			Expect(reports).To(Equal([]report{{10, "step 1"}, {100, "done"}}))
+		})
+
+		It("should never decrease the reported progress", func() {
+			reporter.Report(ctx, 20, "step 2")
+			now = now.Add(time.Minute)
+			reporter.Report(ctx, 10, "step 1")
+
+			Expect(reports).To(Equal([]report{{20, "step 2"}, {20, "step 1"}}))
+		})
+
+		It("should track the last progress including throttled reports", func() {
+			Expect(reporter.(controller.ProgressTracker).LastProgress()).To(Equal(1))
+
+			reporter.Report(ctx, 10, "step 1")
+			reporter.Report(ctx, 20, "step 2")
+
+			Expect(reports).To(Equal([]report{{10, "step 1"}}))
+			Expect(reporter.(controller.ProgressTracker).LastProgress()).To(Equal(20))
+		})
+	})
+
+	Describe("#LastReportedProgress", func() {
+		It("should return the default progress if the context carries no reporter", func() {
+			Expect(controller.LastReportedProgress(context.TODO(), 1)).To(Equal(1))
+		})
+
+		It("should return the last progress of the reporter of the context", func() {
+			controller.ReportProgress(ctx, 30, "step")
+			Expect(controller.LastReportedProgress(ctx, 1)).To(Equal(30))
+		})
+	})
+
+	Describe("#InitialProgress", func() {
+		It("should start new operations with 1", func() {
+			Expect(controller.InitialProgress(nil, gardencorev1alpha1.LastOperationTypeCreate)).To(Equal(1))
+		})
+
+		It("should start operations of another type with 1", func() {
+			lastOperation := &gardencorev1alpha1.LastOperation{Type: gardencorev1alpha1.LastOperationTypeCreate, State: gardencorev1alpha1.LastOperationStateError, Progress: 40}
+			Expect(controller.InitialProgress(lastOperation, gardencorev1alpha1.LastOperationTypeDelete)).To(Equal(1))
+		})
+
+		It("should start operations after a succeeded one with 1", func() {
+			lastOperation := &gardencorev1alpha1.LastOperation{Type: gardencorev1alpha1.LastOperationTypeReconcile, State: gardencorev1alpha1.LastOperationStateSucceeded, Progress: 100}
+			Expect(controller.InitialProgress(lastOperation, gardencorev1alpha1.LastOperationTypeReconcile)).To(Equal(1))
+		})
+
+		It("should resume retried operations with the progress they reached", func() {
+			lastOperation := &gardencorev1alpha1.LastOperation{Type: gardencorev1alpha1.LastOperationTypeReconcile, State: gardencorev1alpha1.LastOperationStateError, Progress: 40}
+			Expect(controller.InitialProgress(lastOperation, gardencorev1alpha1.LastOperationTypeReconcile)).To(Equal(40))
+		})
+	})
+})
@@ -16,10 +16,14 @@ package worker
 
 import (
 	"context"
+	"fmt"
 
 	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
 
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/runtime/inject"
 )
 
 // Actuator acts upon Worker resources.
@@ -29,3 +33,56 @@ type Actuator interface {
 	// Delete deletes the Worker.
 	Delete(context.Context, *extensionsv1alpha1.Worker, *extensionscontroller.Cluster) error
 }
+
+type operationAnnotationWrapper struct {
+	Actuator
+	client client.Client
+}
+
+// OperationAnnotationWrapper is a wrapper for an actuator that, after a successful reconcile,
+// removes the Gardener operation annotation.
+//
+// This is useful in conjunction with the OperationAnnotationPredicate.
+func OperationAnnotationWrapper(actuator Actuator) Actuator {
+	return &operationAnnotationWrapper{Actuator: actuator}
+}
+
+// InjectClient implements inject.Client.
+func (o *operationAnnotationWrapper) InjectClient(client client.Client) error {
+	o.client = client
+	return nil
+}
+
+// InjectFunc implements inject.Func.
+func (o *operationAnnotationWrapper) InjectFunc(f inject.Func) error {
+	return f(o.Actuator)
+}
+
+// Reconcile implements Actuator.
+func (o *operationAnnotationWrapper) Reconcile(ctx context.Context, worker *extensionsv1alpha1.Worker, cluster *extensionscontroller.Cluster) error {
+	if err := extensionscontroller.RemoveOperationAnnotation(ctx, o.client, worker); err != nil {
+		return err
+	}
+
+	return o.Actuator.Reconcile(ctx, worker, cluster)
+}
+
+// Migrate implements Migrator.
+func (o *operationAnnotationWrapper) Migrate(ctx context.Context, worker *extensionsv1alpha1.Worker, cluster *extensionscontroller.Cluster) (string, error) {
+	migrator, ok := o.Actuator.(Migrator)
+	if !ok {
+		return "", fmt.Errorf("actuator for worker type %q does not support migration", worker.Spec.Type)
+	}
+
+	return migrator.Migrate(ctx, worker, cluster)
+}
+
+// Restore implements Migrator.
+func (o *operationAnnotationWrapper) Restore(ctx context.Context, worker *extensionsv1alpha1.Worker, cluster *extensionscontroller.Cluster, state string) error {
+	migrator, ok := o.Actuator.(Migrator)
+	if !ok {
+		return fmt.Errorf("actuator for worker type %q does not support migration", worker.Spec.Type)
+	}
+
+	return migrator.Restore(ctx, worker, cluster, state)
+}
@@ -0,0 +1,174 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package worker
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	apisworker "github.com/gardener/gardener-extensions/pkg/apis/worker"
+
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+)
+
+const (
+	// AnnotationScaleDownDisabled is the node annotation that prevents the cluster-autoscaler from scaling down a node.
+	AnnotationScaleDownDisabled = "cluster-autoscaler.kubernetes.io/scale-down-disabled"
+	// AnnotationNodeGroupMinSize is the machine deployment annotation containing the minimum size of the node group.
+	AnnotationNodeGroupMinSize = "cluster.k8s.io/cluster-api-autoscaler-node-group-min-size"
+	// AnnotationNodeGroupMaxSize is the machine deployment annotation containing the maximum size of the node group.
+	AnnotationNodeGroupMaxSize = "cluster.k8s.io/cluster-api-autoscaler-node-group-max-size"
+	// AnnotationNodeGroupPriority is the machine deployment annotation containing the weight of the node group for
+	// the priority expander of the cluster-autoscaler.
+	AnnotationNodeGroupPriority = "autoscaler.extensions.gardener.cloud/priority"
+
+	// AnnotationCapacityCPU is the machine deployment annotation containing the CPU capacity of the nodes.
+	AnnotationCapacityCPU = "capacity.cluster-autoscaler.kubernetes.io/cpu"
+	// AnnotationCapacityMemory is the machine deployment annotation containing the memory capacity of the nodes.
+	AnnotationCapacityMemory = "capacity.cluster-autoscaler.kubernetes.io/memory"
+	// AnnotationCapacityGPUCount is the machine deployment annotation containing the number of GPUs of the nodes.
+	AnnotationCapacityGPUCount = "capacity.cluster-autoscaler.kubernetes.io/gpu-count"
+	// AnnotationCapacityLabels is the machine deployment annotation containing the labels of the nodes.
+	AnnotationCapacityLabels = "capacity.cluster-autoscaler.kubernetes.io/labels"
+	// AnnotationCapacityTaints is the machine deployment annotation containing the taints of the nodes.
+	AnnotationCapacityTaints = "capacity.cluster-autoscaler.kubernetes.io/taints"
+
+	// ResourceGPU is the name of the resource for GPUs in the node template capacity.
+	ResourceGPU corev1.ResourceName = "nvidia.com/gpu"
+)
+
+// AutoscalerAnnotationKeys are the keys of all annotations for the cluster-autoscaler on MachineDeployment objects.
+var AutoscalerAnnotationKeys = []string{
+	AnnotationNodeGroupMinSize,
+	AnnotationNodeGroupMaxSize,
+	AnnotationNodeGroupPriority,
+	AnnotationCapacityCPU,
+	AnnotationCapacityMemory,
+	AnnotationCapacityGPUCount,
+	AnnotationCapacityLabels,
+	AnnotationCapacityTaints,
+}
+
+// AutoscalerOptions contains hints for the cluster-autoscaler about a machine deployment.
+type AutoscalerOptions = apisworker.AutoscalerOptions
+
+// AutoscalerOptionsFromConfig returns the AutoscalerOptions of the given WorkerConfig of a pool. If they are not set,
+// empty options are returned.
+func AutoscalerOptionsFromConfig(config *apisworker.WorkerConfig) AutoscalerOptions {
+	if config == nil || config.Autoscaler == nil {
+		return AutoscalerOptions{}
+	}
+	return *config.Autoscaler
+}
+
+// NodeTemplate describes the nodes of a machine deployment. It allows the cluster-autoscaler to scale up a machine
+// deployment that has currently no nodes.
+type NodeTemplate struct {
+	// Capacity is the capacity of the nodes.
+	Capacity corev1.ResourceList
+	// Labels are the labels of the nodes.
+	Labels map[string]string
+	// Taints are the taints of the nodes.
+	Taints []corev1.Taint
+}
+
+// NodeTemplateForMachineType returns the NodeTemplate for nodes of the machine type with the given name out of the
+// given machine types of a CloudProfile. If no machine type with the given name exists, nil is returned.
+func NodeTemplateForMachineType(machineTypes []gardenv1beta1.MachineType, name string, labels map[string]string, taints []corev1.Taint) *NodeTemplate {
+	for _, machineType := range machineTypes {
+		if machineType.Name != name {
+			continue
+		}
+
+		return &NodeTemplate{
+			Capacity: corev1.ResourceList{
+				corev1.ResourceCPU:    machineType.CPU,
+				corev1.ResourceMemory: machineType.Memory,
+				ResourceGPU:           machineType.GPU,
+			},
+			Labels: labels,
+			Taints: taints,
+		}
+	}
+	return nil
+}
+
+// AutoscalerAnnotations returns the annotations of the MachineDeployment object for the machine deployment. They
+// contain the size of the node group, its priority and the node template for the cluster-autoscaler.
+func (deployment MachineDeployment) AutoscalerAnnotations() map[string]string {
+	annotations := map[string]string{
+		AnnotationNodeGroupMinSize: strconv.Itoa(deployment.Minimum),
+		AnnotationNodeGroupMaxSize: strconv.Itoa(deployment.Maximum),
+	}
+
+	if deployment.Autoscaler.Priority != nil {
+		annotations[AnnotationNodeGroupPriority] = strconv.Itoa(int(*deployment.Autoscaler.Priority))
+	}
+
+	if template := deployment.NodeTemplate; template != nil {
+		if cpu, ok := template.Capacity[corev1.ResourceCPU]; ok {
+			annotations[AnnotationCapacityCPU] = cpu.String()
+		}
+		if memory, ok := template.Capacity[corev1.ResourceMemory]; ok {
+			annotations[AnnotationCapacityMemory] = memory.String()
+		}
+		if gpu, ok := template.Capacity[ResourceGPU]; ok {
+			annotations[AnnotationCapacityGPUCount] = gpu.String()
+		}
+		if len(template.Labels) > 0 {
+			annotations[AnnotationCapacityLabels] = formatLabels(template.Labels)
+		}
+		if len(template.Taints) > 0 {
+			annotations[AnnotationCapacityTaints] = formatTaints(template.Taints)
+		}
+	}
+
+	return annotations
+}
+
+// NodeAnnotations returns the annotations of the nodes of the machine deployment, i.e. its annotations amended by
+// the hints for the cluster-autoscaler.
+func (deployment MachineDeployment) NodeAnnotations() map[string]string {
+	if !deployment.Autoscaler.ScaleDownDisabled {
+		return deployment.Annotations
+	}
+
+	annotations := make(map[string]string, len(deployment.Annotations)+1)
+	for key, value := range deployment.Annotations {
+		annotations[key] = value
+	}
+	annotations[AnnotationScaleDownDisabled] = "true"
+	return annotations
+}
+
+func formatLabels(labels map[string]string) string {
+	out := make([]string, 0, len(labels))
+	for key, value := range labels {
+		out = append(out, fmt.Sprintf("%s=%s", key, value))
+	}
+	sort.Strings(out)
+	return strings.Join(out, ",")
+}
+
+func formatTaints(taints []corev1.Taint) string {
+	out := make([]string, 0, len(taints))
+	for _, taint := range taints {
+		out = append(out, fmt.Sprintf("%s=%s:%s", taint.Key, taint.Value, taint.Effect))
+	}
+	return strings.Join(out, ",")
+}
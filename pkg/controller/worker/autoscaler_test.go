@@ -0,0 +1,140 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package worker_test
+
+import (
+	apisworker "github.com/gardener/gardener-extensions/pkg/apis/worker"
+	"github.com/gardener/gardener-extensions/pkg/controller/worker"
+
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/utils/pointer"
+)
+
+var _ = Describe("Autoscaler", func() {
+	Describe("#AutoscalerOptionsFromConfig", func() {
+		It("should return empty options if the config does not contain any", func() {
+			Expect(worker.AutoscalerOptionsFromConfig(nil)).To(Equal(worker.AutoscalerOptions{}))
+			Expect(worker.AutoscalerOptionsFromConfig(&apisworker.WorkerConfig{})).To(Equal(worker.AutoscalerOptions{}))
+		})
+
+		It("should return the options of the config", func() {
+			options := worker.AutoscalerOptionsFromConfig(&apisworker.WorkerConfig{
+				Autoscaler: &apisworker.AutoscalerOptions{ScaleDownDisabled: true, Priority: pointer.Int32Ptr(5)},
+			})
+
+			Expect(options.ScaleDownDisabled).To(BeTrue())
+			Expect(options.Priority).To(PointTo(Equal(int32(5))))
+		})
+	})
+
+	Describe("#NodeTemplateForMachineType", func() {
+		var machineTypes = []gardenv1beta1.MachineType{
+			{Name: "small", CPU: resource.MustParse("1"), GPU: resource.MustParse("0"), Memory: resource.MustParse("2Gi")},
+			{Name: "gpu", CPU: resource.MustParse("8"), GPU: resource.MustParse("2"), Memory: resource.MustParse("64Gi")},
+		}
+
+		It("should return the node template for the machine type", func() {
+			var (
+				labels = map[string]string{"foo": "bar"}
+				taints = []corev1.Taint{{Key: "gpu", Effect: corev1.TaintEffectNoSchedule}}
+			)
+
+			Expect(worker.NodeTemplateForMachineType(machineTypes, "gpu", labels, taints)).To(Equal(&worker.NodeTemplate{
+				Capacity: corev1.ResourceList{
+					corev1.ResourceCPU:    machineTypes[1].CPU,
+					corev1.ResourceMemory: machineTypes[1].Memory,
+					worker.ResourceGPU:    machineTypes[1].GPU,
+				},
+				Labels: labels,
+				Taints: taints,
+			}))
+		})
+
+		It("should return nil if the machine type is unknown", func() {
+			Expect(worker.NodeTemplateForMachineType(machineTypes, "large", nil, nil)).To(BeNil())
+		})
+	})
+
+	Describe("#AutoscalerAnnotations", func() {
+		It("should only contain the node group size if there are no further hints", func() {
+			deployment := worker.MachineDeployment{Minimum: 1, Maximum: 3}
+
+			Expect(deployment.AutoscalerAnnotations()).To(Equal(map[string]string{
+				worker.AnnotationNodeGroupMinSize: "1",
+				worker.AnnotationNodeGroupMaxSize: "3",
+			}))
+		})
+
+		It("should contain the priority and the node template", func() {
+			var (
+				priority   int32 = 10
+				deployment       = worker.MachineDeployment{
+					Minimum:    0,
+					Maximum:    2,
+					Autoscaler: worker.AutoscalerOptions{Priority: &priority},
+					NodeTemplate: &worker.NodeTemplate{
+						Capacity: corev1.ResourceList{
+							corev1.ResourceCPU:    resource.MustParse("4"),
+							corev1.ResourceMemory: resource.MustParse("16Gi"),
+							worker.ResourceGPU:    resource.MustParse("1"),
+						},
+						Labels: map[string]string{"b": "2", "a": "1"},
+						Taints: []corev1.Taint{
+							{Key: "foo", Value: "bar", Effect: corev1.TaintEffectNoSchedule},
+							{Key: "baz", Effect: corev1.TaintEffectNoExecute},
+						},
+					},
+				}
+			)
+
+			Expect(deployment.AutoscalerAnnotations()).To(Equal(map[string]string{
+				worker.AnnotationNodeGroupMinSize:  "0",
+				worker.AnnotationNodeGroupMaxSize:  "2",
+				worker.AnnotationNodeGroupPriority: "10",
+				worker.AnnotationCapacityCPU:       "4",
+				worker.AnnotationCapacityMemory:    "16Gi",
+				worker.AnnotationCapacityGPUCount:  "1",
+				worker.AnnotationCapacityLabels:    "a=1,b=2",
+				worker.AnnotationCapacityTaints:    "foo=bar:NoSchedule,baz=:NoExecute",
+			}))
+		})
+	})
+
+	Describe("#NodeAnnotations", func() {
+		It("should return the annotations of the machine deployment", func() {
+			deployment := worker.MachineDeployment{Annotations: map[string]string{"foo": "bar"}}
+
+			Expect(deployment.NodeAnnotations()).To(Equal(map[string]string{"foo": "bar"}))
+		})
+
+		It("should add the scale-down-disabled annotation without modifying the machine deployment", func() {
+			deployment := worker.MachineDeployment{
+				Annotations: map[string]string{"foo": "bar"},
+				Autoscaler:  worker.AutoscalerOptions{ScaleDownDisabled: true},
+			}
+
+			Expect(deployment.NodeAnnotations()).To(Equal(map[string]string{
+				"foo":                              "bar",
+				worker.AnnotationScaleDownDisabled: "true",
+			}))
+			Expect(deployment.Annotations).To(Equal(map[string]string{"foo": "bar"}))
+		})
+	})
+})
@@ -16,6 +16,7 @@ package worker
 
 import (
 	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
+	controllererror "github.com/gardener/gardener-extensions/pkg/controller/error"
 
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	corev1 "k8s.io/api/core/v1"
@@ -45,21 +46,45 @@ type AddArgs struct {
 	// Predicates are the predicates to use.
 	// If unset, GenerationChangedPredicate will be used.
 	Predicates []predicate.Predicate
+	// ErrorClassifier determines the error codes of failed operations.
+	// If unset, the default classifier will be used.
+	ErrorClassifier controllererror.Classifier
 }
 
 // DefaultPredicates returns the default predicates for a Worker reconciler.
-func DefaultPredicates(client client.Client, typeName string) []predicate.Predicate {
+func DefaultPredicates(client client.Client, typeName string, ignoreOperationAnnotation bool) []predicate.Predicate {
+	if ignoreOperationAnnotation {
+		return []predicate.Predicate{
+			extensionscontroller.TypePredicate(typeName),
+			extensionscontroller.ShootFailedPredicate(client),
+			extensionscontroller.ReconciliationNotPausedPredicate(client),
+			extensionscontroller.OrPredicate(
+				extensionscontroller.GenerationChangedPredicate(),
+				extensionscontroller.PauseReconciliationAnnotationChangedPredicate(),
+				extensionscontroller.MigrationOperationAnnotationPredicate(),
+			),
+		}
+	}
+
 	return []predicate.Predicate{
 		extensionscontroller.TypePredicate(typeName),
 		extensionscontroller.ShootFailedPredicate(client),
-		extensionscontroller.GenerationChangedPredicate(),
+		extensionscontroller.ReconciliationNotPausedPredicate(client),
+		extensionscontroller.OrPredicate(
+			OperationAnnotationPredicate(),
+			extensionscontroller.PauseReconciliationAnnotationChangedPredicate(),
+		),
+		extensionscontroller.OrPredicate(
+			extensionscontroller.GenerationChangedPredicate(),
+			extensionscontroller.AnnotationsChangedPredicate(),
+		),
 	}
 }
 
 // Add creates a new Worker Controller and adds it to the Manager.
 // and Start it when the Manager is Started.
 func Add(mgr manager.Manager, args AddArgs) error {
-	args.ControllerOptions.Reconciler = NewReconciler(mgr, args.Actuator)
+	args.ControllerOptions.Reconciler = NewReconciler(mgr, args.Actuator, args.ErrorClassifier)
 	return add(mgr, args.ControllerOptions, args.Predicates)
 }
 
@@ -79,6 +104,10 @@ func add(mgr manager.Manager, options controller.Options, predicates []predicate
 	if err := ctrl.Watch(&source.Kind{Type: &extensionsv1alpha1.Cluster{}}, &handler.EnqueueRequestsFromMapFunc{ToRequests: ClusterToWorkerMapper(mgr.GetClient(), predicates)}); err != nil {
 		return err
 	}
+	// Pause and resume the reconciliation of the workers together with their cluster.
+	if err := ctrl.Watch(&source.Kind{Type: &extensionsv1alpha1.Cluster{}}, &handler.EnqueueRequestsFromMapFunc{ToRequests: ClusterPauseToWorkerMapper(mgr.GetClient(), predicates)}, extensionscontroller.PauseReconciliationAnnotationChangedPredicate()); err != nil {
+		return err
+	}
 
 	return nil
 }
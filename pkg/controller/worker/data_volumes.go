@@ -0,0 +1,31 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package worker
+
+import (
+	apisworker "github.com/gardener/gardener-extensions/pkg/apis/worker"
+)
+
+// DataVolume is an additional volume that is attached to the machines of a worker pool besides the root disk.
+type DataVolume = apisworker.DataVolume
+
+// DataVolumesFromConfig returns the data volumes of the given WorkerConfig of a pool. They are validated when the
+// Worker is admitted.
+func DataVolumesFromConfig(config *apisworker.WorkerConfig) []DataVolume {
+	if config == nil {
+		return nil
+	}
+	return config.DataVolumes
+}
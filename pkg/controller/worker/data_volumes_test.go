@@ -0,0 +1,51 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package worker_test
+
+import (
+	apisworker "github.com/gardener/gardener-extensions/pkg/apis/worker"
+	"github.com/gardener/gardener-extensions/pkg/controller/worker"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"k8s.io/utils/pointer"
+)
+
+var _ = Describe("DataVolumes", func() {
+	Describe("#DataVolumesFromConfig", func() {
+		It("should return nil if the config does not contain any", func() {
+			Expect(worker.DataVolumesFromConfig(nil)).To(BeNil())
+			Expect(worker.DataVolumesFromConfig(&apisworker.WorkerConfig{})).To(BeNil())
+		})
+
+		It("should return the data volumes of the config", func() {
+			volumes := []worker.DataVolume{
+				{
+					Name:      "cache",
+					Size:      "50Gi",
+					Type:      pointer.StringPtr("gp2"),
+					Encrypted: pointer.BoolPtr(true),
+					MountPath: pointer.StringPtr("/var/lib/cache"),
+				},
+				{
+					Name: "raw",
+					Size: "100Gi",
+				},
+			}
+
+			Expect(worker.DataVolumesFromConfig(&apisworker.WorkerConfig{DataVolumes: volumes})).To(Equal(volumes))
+		})
+	})
+})
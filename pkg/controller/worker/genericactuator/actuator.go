@@ -32,6 +32,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/runtime/inject"
 )
@@ -44,8 +45,12 @@ type genericActuator struct {
 	mcmSeedChart    util.Chart
 	mcmShootChart   util.Chart
 	imageVector     imagevector.ImageVector
+	rolloutSettings worker.RolloutSettings
 
 	client            client.Client
+	cache             cache.Cache
+	scheme            *runtime.Scheme
+	notifier          *namespaceNotifier
 	clientset         kubernetes.Interface
 	gardenerClientset gardenerkubernetes.Interface
 	chartApplier      gardenerkubernetes.ChartApplier
@@ -54,7 +59,15 @@ type genericActuator struct {
 // NewActuator creates a new Actuator that reconciles
 // Worker resources of Gardener's `extensions.gardener.cloud` API group.
 // It provides a default implementation that allows easier integration of providers.
-func NewActuator(logger logr.Logger, delegateFactory DelegateFactory, mcmName string, mcmSeedChart, mcmShootChart util.Chart, imageVector imagevector.ImageVector) worker.Actuator {
+// Unset timeouts in the given rollout settings are defaulted.
+func NewActuator(logger logr.Logger, delegateFactory DelegateFactory, mcmName string, mcmSeedChart, mcmShootChart util.Chart, imageVector imagevector.ImageVector, rolloutSettings worker.RolloutSettings) worker.Actuator {
+	if rolloutSettings.MachineDeploymentsTimeout == 0 {
+		rolloutSettings.MachineDeploymentsTimeout = worker.DefaultMachineDeploymentsTimeout
+	}
+	if rolloutSettings.MachineResourcesDeletionTimeout == 0 {
+		rolloutSettings.MachineResourcesDeletionTimeout = worker.DefaultMachineResourcesDeletionTimeout
+	}
+
 	return &genericActuator{
 		logger: logger.WithName("worker-actuator"),
 
@@ -63,6 +76,8 @@ func NewActuator(logger logr.Logger, delegateFactory DelegateFactory, mcmName st
 		mcmSeedChart:    mcmSeedChart,
 		mcmShootChart:   mcmShootChart,
 		imageVector:     imageVector,
+		rolloutSettings: rolloutSettings,
+		notifier:        newNamespaceNotifier(),
 	}
 }
 
@@ -75,6 +90,16 @@ func (a *genericActuator) InjectClient(client client.Client) error {
 	return nil
 }
 
+func (a *genericActuator) InjectCache(cache cache.Cache) error {
+	a.cache = cache
+	return nil
+}
+
+func (a *genericActuator) InjectScheme(scheme *runtime.Scheme) error {
+	a.scheme = scheme
+	return nil
+}
+
 func (a *genericActuator) InjectConfig(config *rest.Config) error {
 	var err error
 
@@ -18,27 +18,41 @@ import (
 	"context"
 	"fmt"
 	"strings"
-	"sync"
 	"time"
 
 	"github.com/gardener/gardener-extensions/pkg/controller"
+	"github.com/gardener/gardener-extensions/pkg/controller/worker"
 	"github.com/gardener/gardener-extensions/pkg/util"
 
-	gardencorev1alpha1helper "github.com/gardener/gardener/pkg/apis/core/v1alpha1/helper"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/utils/flow"
 	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
 	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
 	"github.com/pkg/errors"
 	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
 )
 
 const (
 	forceDeletionLabelKey   = "force-deletion"
 	forceDeletionLabelValue = "True"
+
+	// machineResourcesResyncPeriod is the maximum interval between two checks whether all machine resources
+	// have been deleted, if no change has been observed in between.
+	machineResourcesResyncPeriod = 30 * time.Second
+
+	// progressMachineResourcesDeletionStarted is the progress reported once the deletion of the machine resources
+	// has been triggered.
+	progressMachineResourcesDeletionStarted = 20
+	// progressMachineResourcesDeleted is the progress reported once all machine resources have been deleted.
+	progressMachineResourcesDeleted = 90
 )
 
 func (a *genericActuator) Delete(ctx context.Context, worker *extensionsv1alpha1.Worker, cluster *controller.Cluster) error {
@@ -90,11 +104,15 @@ func (a *genericActuator) Delete(ctx context.Context, worker *extensionsv1alpha1
 	}
 
 	// Wait until all machine resources have been properly deleted.
-	timeoutCtx, cancel := context.WithTimeout(ctx, 30*time.Minute)
+	poolSettings, err := poolRolloutSettings(worker.Spec.Pools)
+	if err != nil {
+		return err
+	}
+	timeoutCtx, cancel := context.WithTimeout(ctx, a.machineResourcesDeletionTimeout(worker.Spec.Pools, poolSettings))
 	defer cancel()
 
 	if err := a.waitUntilMachineResourcesDeleted(timeoutCtx, worker, workerDelegate); err != nil {
-		return gardencorev1alpha1helper.DetermineError(fmt.Sprintf("Failed while waiting for all machine resources to be deleted: '%s'", err.Error()))
+		return waitError(err, "Failed while waiting for all machine resources to be deleted")
 	}
 
 	// Delete the machine-controller-manager.
@@ -113,6 +131,18 @@ func (a *genericActuator) Delete(ctx context.Context, worker *extensionsv1alpha1
 	return nil
 }
 
+// machineResourcesDeletionTimeout returns the maximum time to wait until the machine resources of the given pools are
+// deleted, i.e. the longest timeout of the pools. Pools without an overridden timeout use the default one.
+func (a *genericActuator) machineResourcesDeletionTimeout(pools []extensionsv1alpha1.WorkerPool, poolSettings map[string]*worker.PoolRolloutSettings) time.Duration {
+	timeout := a.rolloutSettings.MachineResourcesDeletionTimeout
+	for _, pool := range pools {
+		if poolTimeout := poolSettings[pool.Name].GetMachineResourcesDeletionTimeout(a.rolloutSettings.MachineResourcesDeletionTimeout); poolTimeout > timeout {
+			timeout = poolTimeout
+		}
+	}
+	return timeout
+}
+
 // Mark all existing machines to become forcefully deleted.
 func (a *genericActuator) markAllMachinesForcefulDeletion(ctx context.Context, namespace string) error {
 	// Mark all existing machines to become forcefully deleted.
@@ -121,27 +151,17 @@ func (a *genericActuator) markAllMachinesForcefulDeletion(ctx context.Context, n
 		return err
 	}
 
-	var (
-		errorList []error
-		wg        sync.WaitGroup
-	)
-
-	// TODO: Use github.com/gardener/gardener/pkg/utils/flow.Parallel as soon as we can vendor a new Gardener version again.
+	fns := make([]flow.TaskFn, 0, len(existingMachines.Items))
 	for _, machine := range existingMachines.Items {
-		wg.Add(1)
-		go func(m machinev1alpha1.Machine) {
-			defer wg.Done()
-			if err := a.markMachineForcefulDeletion(ctx, &m); err != nil {
-				errorList = append(errorList, err)
-			}
-		}(machine)
+		m := machine
+		fns = append(fns, func(ctx context.Context) error {
+			return a.markMachineForcefulDeletion(ctx, &m)
+		})
 	}
 
-	wg.Wait()
-	if len(errorList) > 0 {
-		return fmt.Errorf("labelling machines (to become forcefully deleted) failed: %v", errorList)
+	if err := runParallel(ctx, "Machine forceful deletion marking", fns...); err != nil {
+		return errors.Wrap(err, "labelling machines (to become forcefully deleted) failed")
 	}
-
 	return nil
 }
 
@@ -159,92 +179,198 @@ func (a *genericActuator) markMachineForcefulDeletion(ctx context.Context, machi
 	return a.client.Update(ctx, machine)
 }
 
-// waitUntilMachineResourcesDeleted waits for a maximum of 30 minutes until all machine resources have been properly
-// deleted by the machine-controller-manager. It polls the status every 5 seconds.
-// TODO: Parallelise this?
+// waitUntilMachineResourcesDeleted waits until all machine resources have been properly deleted by the
+// machine-controller-manager. The remaining machine resources are counted whenever the shared informers observe
+// a change in the worker's namespace, and at least every machineResourcesResyncPeriod. If no cache has been
+// injected, they are counted every 5 seconds.
 func (a *genericActuator) waitUntilMachineResourcesDeleted(ctx context.Context, worker *extensionsv1alpha1.Worker, workerDelegate WorkerDelegate) error {
 	var (
-		countMachines            = -1
-		countMachineSets         = -1
-		countMachineDeployments  = -1
-		countMachineClasses      = -1
-		countMachineClassSecrets = -1
+		notifications <-chan struct{}
+		interval      = 5 * time.Second
+		initialCount  = 0
+		lastMessage   string
 	)
 
-	return wait.PollUntil(5*time.Second, func() (bool, error) {
-		msg := ""
+	if a.cache != nil {
+		if err := a.registerMachineResourceInformers(workerDelegate); err != nil {
+			return errors.Wrapf(err, "could not watch machine resources")
+		}
+
+		var unsubscribe func()
+		notifications, unsubscribe = a.notifier.subscribe(worker.Namespace)
+		defer unsubscribe()
+		interval = machineResourcesResyncPeriod
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
 
-		// Check whether all machines have been deleted.
-		if countMachines != 0 {
-			existingMachines := &machinev1alpha1.MachineList{}
-			if err := a.client.List(ctx, client.InNamespace(worker.Namespace), existingMachines); err != nil {
-				return false, err
+	for {
+		counts, err := a.countMachineResources(ctx, worker.Namespace, workerDelegate)
+		if err != nil {
+			if ctx.Err() != nil {
+				return wait.ErrWaitTimeout
 			}
-			countMachines = len(existingMachines.Items)
-			msg += fmt.Sprintf("%d machines, ", countMachines)
+			return err
 		}
 
-		// Check whether all machine sets have been deleted.
-		if countMachineSets != 0 {
-			existingMachineSets := &machinev1alpha1.MachineSetList{}
-			if err := a.client.List(ctx, client.InNamespace(worker.Namespace), existingMachineSets); err != nil {
-				return false, err
-			}
-			countMachineSets = len(existingMachineSets.Items)
-			msg += fmt.Sprintf("%d machine sets, ", countMachineSets)
+		remaining := counts.total()
+		if remaining == 0 {
+			return nil
+		}
+		if remaining > initialCount {
+			initialCount = remaining
+		}
+
+		msg := fmt.Sprintf("Waiting until the following machine resources have been processed: %s", counts)
+		if msg != lastMessage {
+			a.logger.Info(msg, "worker", fmt.Sprintf("%s/%s", worker.Namespace, worker.Name))
+			lastMessage = msg
+		}
+		controller.ReportProgress(ctx, deletionProgress(initialCount, remaining), msg)
+
+		select {
+		case <-ctx.Done():
+			return wait.ErrWaitTimeout
+		case <-notifications:
+		case <-ticker.C:
+		}
+	}
+}
+
+// registerMachineResourceInformers ensures that the notifier of the actuator receives the events of the informers
+// of all machine resources.
+func (a *genericActuator) registerMachineResourceInformers(workerDelegate WorkerDelegate) error {
+	var gvks []schema.GroupVersionKind
+	for _, list := range []runtime.Object{
+		&machinev1alpha1.MachineList{},
+		&machinev1alpha1.MachineSetList{},
+		&machinev1alpha1.MachineDeploymentList{},
+		workerDelegate.MachineClassList(),
+		&corev1.SecretList{},
+	} {
+		gvk, err := apiutil.GVKForObject(list, a.scheme)
+		if err != nil {
+			return err
 		}
+		gvks = append(gvks, gvk.GroupVersion().WithKind(strings.TrimSuffix(gvk.Kind, "List")))
+	}
+
+	return a.notifier.register(a.cache, gvks...)
+}
+
+// machineResourceCounts are the numbers of machine resources that have not been deleted yet.
+type machineResourceCounts struct {
+	machines            int
+	machineSets         int
+	machineDeployments  int
+	machineClasses      int
+	machineClassSecrets int
+}
+
+func (c machineResourceCounts) total() int {
+	return c.machines + c.machineSets + c.machineDeployments + c.machineClasses + c.machineClassSecrets
+}
 
-		// Check whether all machine deployments have been deleted.
-		if countMachineDeployments != 0 {
+func (c machineResourceCounts) String() string {
+	return fmt.Sprintf("%d machines, %d machine sets, %d machine deployments, %d machine classes, %d machine class secrets",
+		c.machines, c.machineSets, c.machineDeployments, c.machineClasses, c.machineClassSecrets)
+}
+
+// countMachineResources counts the machine resources in the given namespace that have not been deleted yet. The
+// different kinds of machine resources are counted in parallel. An error is returned if the machine-controller-manager
+// reports failed machines.
+func (a *genericActuator) countMachineResources(ctx context.Context, namespace string, workerDelegate WorkerDelegate) (machineResourceCounts, error) {
+	var counts machineResourceCounts
+
+	err := runParallel(ctx, "Machine resources counting",
+		// Count the machines that have not been deleted.
+		func(ctx context.Context) error {
+			existingMachines := &machinev1alpha1.MachineList{}
+			if err := a.client.List(ctx, client.InNamespace(namespace), existingMachines); err != nil {
+				return err
+			}
+			counts.machines = len(existingMachines.Items)
+			return nil
+		},
+		// Count the machine sets that have not been deleted.
+		func(ctx context.Context) error {
+			existingMachineSets := &machinev1alpha1.MachineSetList{}
+			if err := a.client.List(ctx, client.InNamespace(namespace), existingMachineSets); err != nil {
+				return err
+			}
+			counts.machineSets = len(existingMachineSets.Items)
+			return nil
+		},
+		// Count the machine deployments that have not been deleted, and check whether an operation failed during
+		// the deletion process.
+		func(ctx context.Context) error {
 			existingMachineDeployments := &machinev1alpha1.MachineDeploymentList{}
-			if err := a.client.List(ctx, client.InNamespace(worker.Namespace), existingMachineDeployments); err != nil {
-				return false, err
+			if err := a.client.List(ctx, client.InNamespace(namespace), existingMachineDeployments); err != nil {
+				return err
 			}
-			countMachineDeployments = len(existingMachineDeployments.Items)
-			msg += fmt.Sprintf("%d machine deployments, ", countMachineDeployments)
+			counts.machineDeployments = len(existingMachineDeployments.Items)
 
-			// Check whether an operation failed during the deletion process.
 			for _, existingMachineDeployment := range existingMachineDeployments.Items {
 				for _, failedMachine := range existingMachineDeployment.Status.FailedMachines {
-					return false, fmt.Errorf("Machine %s failed: %s", failedMachine.Name, failedMachine.LastOperation.Description)
+					return fmt.Errorf("Machine %s failed: %s", failedMachine.Name, failedMachine.LastOperation.Description)
 				}
 			}
-		}
-
-		// Check whether all machine classes have been deleted.
-		if countMachineClasses != 0 {
+			return nil
+		},
+		// Count the machine classes that have not been deleted.
+		func(ctx context.Context) error {
 			machineClassList := workerDelegate.MachineClassList()
-			if err := a.client.List(ctx, client.InNamespace(worker.Namespace), machineClassList); err != nil {
-				return false, err
+			if err := a.client.List(ctx, client.InNamespace(namespace), machineClassList); err != nil {
+				return err
 			}
 			machineClasses, err := meta.ExtractList(machineClassList)
 			if err != nil {
-				return false, err
+				return err
 			}
-			countMachineClasses = len(machineClasses)
-			msg += fmt.Sprintf("%d machine classes, ", countMachineClasses)
-		}
-
-		// Check whether all machine class secrets have been deleted.
-		if countMachineClassSecrets != 0 {
-			count := 0
-			existingMachineClassSecrets, err := a.listMachineClassSecrets(ctx, worker.Namespace)
+			counts.machineClasses = len(machineClasses)
+			return nil
+		},
+		// Count the machine class secrets whose finalizers have not been removed.
+		func(ctx context.Context) error {
+			existingMachineClassSecrets, err := a.listMachineClassSecrets(ctx, namespace)
 			if err != nil {
-				return false, err
+				return err
 			}
 			for _, machineClassSecret := range existingMachineClassSecrets.Items {
 				if len(machineClassSecret.Finalizers) != 0 {
-					count++
+					counts.machineClassSecrets++
 				}
 			}
-			countMachineClassSecrets = count
-			msg += fmt.Sprintf("%d machine class secrets, ", countMachineClassSecrets)
-		}
+			return nil
+		},
+	)
+
+	return counts, err
+}
 
-		if countMachines != 0 || countMachineSets != 0 || countMachineDeployments != 0 || countMachineClasses != 0 || countMachineClassSecrets != 0 {
-			a.logger.Info(fmt.Sprintf("Waiting until the following machine resources have been processed: %s", strings.TrimSuffix(msg, ", ")), "worker", fmt.Sprintf("%s/%s", worker.Namespace, worker.Name))
-			return false, nil
+// deletionProgress computes the progress of the machine resources deletion from the initial and the remaining number
+// of machine resources, within the range between progressMachineResourcesDeletionStarted and progressMachineResourcesDeleted.
+func deletionProgress(initialCount, remaining int) int {
+	if initialCount <= 0 {
+		return progressMachineResourcesDeleted
+	}
+	return progressMachineResourcesDeletionStarted + (progressMachineResourcesDeleted-progressMachineResourcesDeletionStarted)*(initialCount-remaining)/initialCount
+}
+
+// runParallel runs the given functions in parallel and returns the causes of their errors, if any.
+// TODO: Use github.com/gardener/gardener/pkg/utils/flow.Parallel as soon as we can vendor a new Gardener version again.
+func runParallel(ctx context.Context, name string, fns ...flow.TaskFn) error {
+	g := flow.NewGraph(name)
+	for i, fn := range fns {
+		g.Add(flow.Task{Name: fmt.Sprintf("%s #%d", name, i), Fn: fn})
+	}
+
+	if err := g.Compile().Run(flow.Opts{Context: ctx}); err != nil {
+		if causes := flow.Causes(err); len(causes.Errors) > 0 {
+			return causes
 		}
-		return true, nil
-	}, ctx.Done())
+		return err
+	}
+	return nil
 }
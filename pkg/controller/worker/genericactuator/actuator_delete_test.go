@@ -0,0 +1,67 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package genericactuator
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+
+	"github.com/gardener/gardener/pkg/utils/flow"
+	multierror "github.com/hashicorp/go-multierror"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Actuator", func() {
+	Describe("#runParallel", func() {
+		It("should run all functions and return the causes of their errors", func() {
+			var (
+				count int32
+				fns   []flow.TaskFn
+			)
+			for i := 0; i < 10; i++ {
+				i := i
+				fns = append(fns, func(ctx context.Context) error {
+					atomic.AddInt32(&count, 1)
+					if i%5 == 0 {
+						return fmt.Errorf("error %d", i)
+					}
+					return nil
+				})
+			}
+
+			err := runParallel(context.TODO(), "test", fns...)
+
+			Expect(err).To(BeAssignableToTypeOf(&multierror.Error{}))
+			Expect(err.(*multierror.Error).Errors).To(ConsistOf(MatchError("error 0"), MatchError("error 5")))
+			Expect(atomic.LoadInt32(&count)).To(Equal(int32(10)))
+		})
+
+		It("should not start functions once the context is done", func() {
+			ctx, cancel := context.WithCancel(context.TODO())
+			cancel()
+
+			var count int32
+			err := runParallel(ctx, "test", func(ctx context.Context) error {
+				atomic.AddInt32(&count, 1)
+				return nil
+			})
+
+			Expect(err).To(HaveOccurred())
+			Expect(atomic.LoadInt32(&count)).To(BeZero())
+		})
+	})
+})
@@ -0,0 +1,320 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package genericactuator
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	apisworker "github.com/gardener/gardener-extensions/pkg/apis/worker"
+	workerhelper "github.com/gardener/gardener-extensions/pkg/apis/worker/helper"
+	"github.com/gardener/gardener-extensions/pkg/controller"
+	"github.com/gardener/gardener-extensions/pkg/util"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
+	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	"github.com/pkg/errors"
+	appsv1 "k8s.io/api/apps/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// MachineState is the state of the machine objects of a Worker that is exported when the Worker is migrated
+// to another seed. It is persisted as the machine state of the WorkerStatus in the state of the Worker status.
+// The machine classes and their secrets are not part of the state as they are generated again when the Worker
+// is reconciled.
+type MachineState struct {
+	// MachineDeployments are the machine deployments of the Worker.
+	MachineDeployments []machinev1alpha1.MachineDeployment `json:"machineDeployments,omitempty"`
+	// MachineSets are the machine sets of the Worker.
+	MachineSets []machinev1alpha1.MachineSet `json:"machineSets,omitempty"`
+	// Machines are the machines of the Worker.
+	Machines []machinev1alpha1.Machine `json:"machines,omitempty"`
+}
+
+// Migrate implements worker.Migrator. It stops the machine-controller-manager, exports the machine objects and
+// removes the finalizers of all machine resources so that they can be deleted without deleting the machines.
+// If the migration fails then the machine-controller-manager is scaled up again so that it keeps managing the
+// machines until the migration is retried.
+func (a *genericActuator) Migrate(ctx context.Context, worker *extensionsv1alpha1.Worker, cluster *controller.Cluster) (string, error) {
+	workerDelegate, err := a.delegateFactory.WorkerDelegate(ctx, worker, cluster)
+	if err != nil {
+		return "", errors.Wrapf(err, "could not instantiate actuator context")
+	}
+
+	// Make sure the machine-controller-manager does not act on the machine resources anymore.
+	a.logger.Info("Scaling down the machine-controller-manager", "worker", fmt.Sprintf("%s/%s", worker.Namespace, worker.Name))
+	deployment := &appsv1.Deployment{}
+	if err := a.client.Get(ctx, kutil.Key(worker.Namespace, a.mcmName), deployment); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return "", err
+		}
+		deployment = nil
+	}
+
+	var replicas int32 = 1
+	if deployment != nil {
+		if deployment.Spec.Replicas != nil {
+			replicas = *deployment.Spec.Replicas
+		}
+		if err := util.ScaleDeployment(ctx, a.client, deployment, 0); err != nil {
+			return "", err
+		}
+	}
+
+	state, err := a.migrateMachineResources(ctx, worker.Namespace, workerDelegate)
+	if err != nil {
+		if deployment != nil {
+			a.logger.Info("Scaling up the machine-controller-manager again", "worker", fmt.Sprintf("%s/%s", worker.Namespace, worker.Name))
+			if scaleErr := util.ScaleDeployment(ctx, a.client, deployment, replicas); scaleErr != nil {
+				return "", errors.Wrapf(err, "could not scale up the machine-controller-manager again (%v)", scaleErr)
+			}
+		}
+		return "", err
+	}
+
+	return state, nil
+}
+
+// migrateMachineResources exports the machine objects and removes the finalizers of all machine resources.
+func (a *genericActuator) migrateMachineResources(ctx context.Context, namespace string, workerDelegate WorkerDelegate) (string, error) {
+	state, err := a.exportMachineState(ctx, namespace)
+	if err != nil {
+		return "", errors.Wrapf(err, "exporting the machine state failed")
+	}
+
+	machineClassSecrets, err := a.listMachineClassSecrets(ctx, namespace)
+	if err != nil {
+		return "", err
+	}
+	lists := []runtime.Object{machineClassSecrets}
+	for _, list := range []runtime.Object{&machinev1alpha1.MachineDeploymentList{}, &machinev1alpha1.MachineSetList{}, &machinev1alpha1.MachineList{}, workerDelegate.MachineClassList()} {
+		if err := a.client.List(ctx, client.InNamespace(namespace), list); err != nil {
+			return "", err
+		}
+		lists = append(lists, list)
+	}
+
+	for _, list := range lists {
+		if err := a.removeFinalizers(ctx, list); err != nil {
+			return "", errors.Wrapf(err, "removing the finalizers of the machine resources failed")
+		}
+	}
+
+	return state, nil
+}
+
+// Restore implements worker.Migrator. It creates the machine objects of the machine state carried by the given
+// state that do not exist yet and restores their status. The owner references are resolved by the names of the owners, hence the machine deployments
+// are restored before the machine sets and the machine sets before the machines. The objects are adopted by the
+// machine-controller-manager once it is deployed by the reconciliation of the Worker.
+func (a *genericActuator) Restore(ctx context.Context, worker *extensionsv1alpha1.Worker, cluster *controller.Cluster, state string) error {
+	status, err := workerhelper.DecodeWorkerStatus(state)
+	if err != nil {
+		return errors.Wrapf(err, "could not decode the worker state")
+	}
+	if status == nil || status.MachineState == nil {
+		return nil
+	}
+
+	machineState := &MachineState{}
+	if err := json.Unmarshal(status.MachineState.Raw, machineState); err != nil {
+		return errors.Wrapf(err, "could not decode the machine state")
+	}
+
+	var objects []machineObject
+	for i := range machineState.MachineDeployments {
+		objects = append(objects, machineObject{kindMachineDeployment, &machineState.MachineDeployments[i]})
+	}
+	for i := range machineState.MachineSets {
+		objects = append(objects, machineObject{kindMachineSet, &machineState.MachineSets[i]})
+	}
+	for i := range machineState.Machines {
+		objects = append(objects, machineObject{kindMachine, &machineState.Machines[i]})
+	}
+
+	a.logger.Info("Restoring the machine objects", "worker", fmt.Sprintf("%s/%s", worker.Namespace, worker.Name))
+	uids := make(map[string]types.UID)
+	for _, object := range objects {
+		accessor, err := meta.Accessor(object.obj)
+		if err != nil {
+			return err
+		}
+		name := accessor.GetName()
+
+		uid, err := a.restoreObject(ctx, worker.Namespace, object.obj, uids)
+		if err != nil {
+			return errors.Wrapf(err, "could not restore %s %s", object.kind, name)
+		}
+		uids[ownerKey(object.kind, name)] = uid
+	}
+
+	return nil
+}
+
+const (
+	kindMachineDeployment = "MachineDeployment"
+	kindMachineSet        = "MachineSet"
+	kindMachine           = "Machine"
+)
+
+type machineObject struct {
+	kind string
+	obj  runtime.Object
+}
+
+// restoreObject creates the given object (or reads it if it already exists) and restores its exported status.
+// It returns the UID of the object.
+func (a *genericActuator) restoreObject(ctx context.Context, namespace string, obj runtime.Object, uids map[string]types.UID) (types.UID, error) {
+	accessor, err := meta.Accessor(obj)
+	if err != nil {
+		return "", err
+	}
+	accessor.SetNamespace(namespace)
+	accessor.SetOwnerReferences(resolveOwnerReferences(accessor.GetOwnerReferences(), uids))
+
+	exported := obj.DeepCopyObject()
+	if err := a.client.Create(ctx, obj); err != nil {
+		if !apierrors.IsAlreadyExists(err) {
+			return "", err
+		}
+		if err := a.client.Get(ctx, kutil.Key(namespace, accessor.GetName()), obj); err != nil {
+			return "", err
+		}
+	}
+
+	if err := copyMachineStatus(exported, obj); err != nil {
+		return "", err
+	}
+	if err := a.client.Status().Update(ctx, obj); err != nil {
+		return "", err
+	}
+	return accessor.GetUID(), nil
+}
+
+func (a *genericActuator) exportMachineState(ctx context.Context, namespace string) (string, error) {
+	var (
+		machineDeployments = &machinev1alpha1.MachineDeploymentList{}
+		machineSets        = &machinev1alpha1.MachineSetList{}
+		machines           = &machinev1alpha1.MachineList{}
+	)
+
+	for _, list := range []runtime.Object{machineDeployments, machineSets, machines} {
+		if err := a.client.List(ctx, client.InNamespace(namespace), list); err != nil {
+			return "", err
+		}
+	}
+
+	machineState := &MachineState{}
+	for _, machineDeployment := range machineDeployments.Items {
+		machineDeployment.ObjectMeta = exportObjectMeta(machineDeployment.ObjectMeta)
+		machineState.MachineDeployments = append(machineState.MachineDeployments, machineDeployment)
+	}
+	for _, machineSet := range machineSets.Items {
+		machineSet.ObjectMeta = exportObjectMeta(machineSet.ObjectMeta)
+		machineState.MachineSets = append(machineState.MachineSets, machineSet)
+	}
+	for _, machine := range machines.Items {
+		machine.ObjectMeta = exportObjectMeta(machine.ObjectMeta)
+		machineState.Machines = append(machineState.Machines, machine)
+	}
+
+	data, err := json.Marshal(machineState)
+	if err != nil {
+		return "", err
+	}
+	return workerhelper.EncodeWorkerStatus(&apisworker.WorkerStatus{MachineState: &runtime.RawExtension{Raw: data}})
+}
+
+// exportObjectMeta returns a copy of the given object meta without the fields that are specific to the seed
+// the object has been created in. The UIDs of the owner references are dropped as they are resolved again by
+// the names of the owners when the object is restored.
+func exportObjectMeta(objectMeta metav1.ObjectMeta) metav1.ObjectMeta {
+	var ownerReferences []metav1.OwnerReference
+	for _, ownerReference := range objectMeta.OwnerReferences {
+		ownerReference.UID = ""
+		ownerReferences = append(ownerReferences, ownerReference)
+	}
+
+	return metav1.ObjectMeta{
+		Name:            objectMeta.Name,
+		Labels:          objectMeta.Labels,
+		Annotations:     objectMeta.Annotations,
+		OwnerReferences: ownerReferences,
+	}
+}
+
+// resolveOwnerReferences sets the UIDs of the given owner references to the UIDs of the restored owners with the
+// same kind and name. Owner references whose owners have not been restored are dropped.
+func resolveOwnerReferences(ownerReferences []metav1.OwnerReference, uids map[string]types.UID) []metav1.OwnerReference {
+	var out []metav1.OwnerReference
+	for _, ownerReference := range ownerReferences {
+		uid, ok := uids[ownerKey(ownerReference.Kind, ownerReference.Name)]
+		if !ok {
+			continue
+		}
+		ownerReference.UID = uid
+		out = append(out, ownerReference)
+	}
+	return out
+}
+
+func ownerKey(kind, name string) string {
+	return kind + "/" + name
+}
+
+// copyMachineStatus copies the status of the given machine object to the other one of the same type.
+func copyMachineStatus(from, to runtime.Object) error {
+	switch f := from.(type) {
+	case *machinev1alpha1.MachineDeployment:
+		if t, ok := to.(*machinev1alpha1.MachineDeployment); ok {
+			t.Status = f.Status
+			return nil
+		}
+	case *machinev1alpha1.MachineSet:
+		if t, ok := to.(*machinev1alpha1.MachineSet); ok {
+			t.Status = f.Status
+			return nil
+		}
+	case *machinev1alpha1.Machine:
+		if t, ok := to.(*machinev1alpha1.Machine); ok {
+			t.Status = f.Status
+			return nil
+		}
+	}
+	return fmt.Errorf("cannot copy status from %T to %T", from, to)
+}
+
+// removeFinalizers removes the finalizers of all objects of the given list.
+func (a *genericActuator) removeFinalizers(ctx context.Context, list runtime.Object) error {
+	return meta.EachListItem(list, func(obj runtime.Object) error {
+		accessor, err := meta.Accessor(obj)
+		if err != nil {
+			return err
+		}
+		if len(accessor.GetFinalizers()) == 0 {
+			return nil
+		}
+
+		accessor.SetFinalizers(nil)
+		return a.client.Update(ctx, obj)
+	})
+}
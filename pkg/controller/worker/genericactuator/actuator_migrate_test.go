@@ -0,0 +1,344 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package genericactuator
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	apisworker "github.com/gardener/gardener-extensions/pkg/apis/worker"
+	workerhelper "github.com/gardener/gardener-extensions/pkg/apis/worker/helper"
+	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
+	extensionsworkercontroller "github.com/gardener/gardener-extensions/pkg/controller/worker"
+	mockclient "github.com/gardener/gardener-extensions/pkg/mock/controller-runtime/client"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/runtime/log"
+)
+
+type fakeWorkerDelegate struct {
+	WorkerDelegate
+}
+
+func (fakeWorkerDelegate) MachineClassList() runtime.Object {
+	return &machinev1alpha1.AWSMachineClassList{}
+}
+
+type fakeDelegateFactory struct{}
+
+func (fakeDelegateFactory) WorkerDelegate(context.Context, *extensionsv1alpha1.Worker, *extensionscontroller.Cluster) (WorkerDelegate, error) {
+	return fakeWorkerDelegate{}, nil
+}
+
+var _ = Describe("Migration", func() {
+	const namespace = "shoot--foo--bar"
+
+	var (
+		ctrl         *gomock.Controller
+		c            *mockclient.MockClient
+		statusWriter *mockclient.MockStatusWriter
+		ctx          = context.TODO()
+
+		a      *genericActuator
+		worker *extensionsv1alpha1.Worker
+
+		controllerRef = func(kind, name string, uid types.UID) []metav1.OwnerReference {
+			isController := true
+			return []metav1.OwnerReference{{APIVersion: machinev1alpha1.SchemeGroupVersion.String(), Kind: kind, Name: name, UID: uid, Controller: &isController}}
+		}
+
+		machineDeployment machinev1alpha1.MachineDeployment
+		machineSet        machinev1alpha1.MachineSet
+		machine           machinev1alpha1.Machine
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		c = mockclient.NewMockClient(ctrl)
+		statusWriter = mockclient.NewMockStatusWriter(ctrl)
+
+		a = &genericActuator{
+			logger:          log.Log.WithName("test"),
+			delegateFactory: fakeDelegateFactory{},
+			mcmName:         "machine-controller-manager",
+			client:          c,
+		}
+		worker = &extensionsv1alpha1.Worker{ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: "worker"}}
+
+		machineDeployment = machinev1alpha1.MachineDeployment{
+			ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: "pool", UID: "md-uid", ResourceVersion: "1", Finalizers: []string{"machine.sapcloud.io/machine-controller-manager"}},
+			Status:     machinev1alpha1.MachineDeploymentStatus{Replicas: 1, AvailableReplicas: 1},
+		}
+		machineSet = machinev1alpha1.MachineSet{
+			ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: "pool-1", UID: "ms-uid", ResourceVersion: "1", OwnerReferences: controllerRef("MachineDeployment", "pool", "md-uid")},
+			Status:     machinev1alpha1.MachineSetStatus{Replicas: 1, AvailableReplicas: 1},
+		}
+		machine = machinev1alpha1.Machine{
+			ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: "pool-1-a", UID: "machine-uid", ResourceVersion: "1", OwnerReferences: controllerRef("MachineSet", "pool-1", "ms-uid")},
+			Status:     machinev1alpha1.MachineStatus{Node: "node-a"},
+		}
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	expectMachineControllerManagerGet := func(replicas int32) {
+		c.EXPECT().Get(ctx, client.ObjectKey{Namespace: namespace, Name: "machine-controller-manager"}, &appsv1.Deployment{}).DoAndReturn(
+			func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+				deployment := obj.(*appsv1.Deployment)
+				deployment.Namespace, deployment.Name, deployment.Spec.Replicas = key.Namespace, key.Name, &replicas
+				return nil
+			})
+	}
+
+	expectMachineControllerManagerScaled := func(replicas int32) *gomock.Call {
+		return c.EXPECT().Update(ctx, gomock.AssignableToTypeOf(&appsv1.Deployment{})).DoAndReturn(
+			func(_ context.Context, obj runtime.Object) error {
+				Expect(*obj.(*appsv1.Deployment).Spec.Replicas).To(Equal(replicas))
+				return nil
+			})
+	}
+
+	listMachineResources := func(_ context.Context, _ *client.ListOptions, list runtime.Object) error {
+		switch l := list.(type) {
+		case *machinev1alpha1.MachineDeploymentList:
+			l.Items = []machinev1alpha1.MachineDeployment{*machineDeployment.DeepCopy()}
+		case *machinev1alpha1.MachineSetList:
+			l.Items = []machinev1alpha1.MachineSet{*machineSet.DeepCopy()}
+		case *machinev1alpha1.MachineList:
+			l.Items = []machinev1alpha1.Machine{*machine.DeepCopy()}
+		}
+		return nil
+	}
+
+	Describe("#Migrate", func() {
+		It("should scale down the machine-controller-manager, export the machine state and remove the finalizers", func() {
+			expectMachineControllerManagerGet(2)
+			expectMachineControllerManagerScaled(0)
+			c.EXPECT().List(ctx, gomock.Any(), gomock.Any()).DoAndReturn(listMachineResources).AnyTimes()
+			c.EXPECT().Update(ctx, gomock.AssignableToTypeOf(&machinev1alpha1.MachineDeployment{})).DoAndReturn(
+				func(_ context.Context, obj runtime.Object) error {
+					Expect(obj.(*machinev1alpha1.MachineDeployment).Finalizers).To(BeEmpty())
+					return nil
+				})
+
+			state, err := a.Migrate(ctx, worker, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			status, err := workerhelper.DecodeWorkerStatus(state)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(status.MachineState).NotTo(BeNil())
+
+			machineState := &MachineState{}
+			Expect(json.Unmarshal(status.MachineState.Raw, machineState)).To(Succeed())
+			Expect(machineState.MachineDeployments).To(HaveLen(1))
+			Expect(machineState.MachineDeployments[0].ObjectMeta).To(Equal(metav1.ObjectMeta{Name: "pool"}))
+			Expect(machineState.MachineDeployments[0].Status).To(Equal(machineDeployment.Status))
+			Expect(machineState.MachineSets).To(HaveLen(1))
+			Expect(machineState.MachineSets[0].OwnerReferences).To(Equal(controllerRef("MachineDeployment", "pool", "")))
+			Expect(machineState.Machines).To(HaveLen(1))
+			Expect(machineState.Machines[0].OwnerReferences).To(Equal(controllerRef("MachineSet", "pool-1", "")))
+			Expect(machineState.Machines[0].Status).To(Equal(machine.Status))
+		})
+
+		It("should scale up the machine-controller-manager again if the migration fails", func() {
+			expectMachineControllerManagerGet(2)
+			scaledDown := expectMachineControllerManagerScaled(0)
+			c.EXPECT().List(ctx, gomock.Any(), gomock.Any()).Return(fmt.Errorf("fake"))
+			expectMachineControllerManagerScaled(2).After(scaledDown)
+
+			_, err := a.Migrate(ctx, worker, nil)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("#Restore", func() {
+		var state string
+
+		BeforeEach(func() {
+			data, err := json.Marshal(&MachineState{
+				MachineDeployments: []machinev1alpha1.MachineDeployment{{ObjectMeta: metav1.ObjectMeta{Name: "pool"}, Status: machineDeployment.Status}},
+				MachineSets:        []machinev1alpha1.MachineSet{{ObjectMeta: metav1.ObjectMeta{Name: "pool-1", OwnerReferences: controllerRef("MachineDeployment", "pool", "")}, Status: machineSet.Status}},
+				Machines:           []machinev1alpha1.Machine{{ObjectMeta: metav1.ObjectMeta{Name: "pool-1-a", OwnerReferences: controllerRef("MachineSet", "pool-1", "")}, Status: machine.Status}},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			state, err = workerhelper.EncodeWorkerStatus(&apisworker.WorkerStatus{MachineState: &runtime.RawExtension{Raw: data}})
+			Expect(err).NotTo(HaveOccurred())
+
+			c.EXPECT().Status().Return(statusWriter).AnyTimes()
+		})
+
+		It("should do nothing for an empty state", func() {
+			Expect(a.Restore(ctx, worker, nil, "")).To(Succeed())
+		})
+
+		It("should do nothing for a state without machine state", func() {
+			state, err := workerhelper.EncodeWorkerStatus(&apisworker.WorkerStatus{MachineDeployments: []apisworker.MachineDeploymentStatus{{Name: "pool"}}})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(a.Restore(ctx, worker, nil, state)).To(Succeed())
+		})
+
+		It("should create the machine objects with resolved owner references and restore their status", func() {
+			createMachineDeployment := c.EXPECT().Create(ctx, gomock.AssignableToTypeOf(&machinev1alpha1.MachineDeployment{})).DoAndReturn(
+				func(_ context.Context, obj runtime.Object) error {
+					md := obj.(*machinev1alpha1.MachineDeployment)
+					Expect(md.Namespace).To(Equal(namespace))
+					md.UID, md.Status = "new-md-uid", machinev1alpha1.MachineDeploymentStatus{}
+					return nil
+				})
+			createMachineSet := c.EXPECT().Create(ctx, gomock.AssignableToTypeOf(&machinev1alpha1.MachineSet{})).DoAndReturn(
+				func(_ context.Context, obj runtime.Object) error {
+					ms := obj.(*machinev1alpha1.MachineSet)
+					Expect(ms.OwnerReferences).To(Equal(controllerRef("MachineDeployment", "pool", "new-md-uid")))
+					ms.UID, ms.Status = "new-ms-uid", machinev1alpha1.MachineSetStatus{}
+					return nil
+				}).After(createMachineDeployment)
+			c.EXPECT().Create(ctx, gomock.AssignableToTypeOf(&machinev1alpha1.Machine{})).DoAndReturn(
+				func(_ context.Context, obj runtime.Object) error {
+					Expect(obj.(*machinev1alpha1.Machine).OwnerReferences).To(Equal(controllerRef("MachineSet", "pool-1", "new-ms-uid")))
+					obj.(*machinev1alpha1.Machine).Status = machinev1alpha1.MachineStatus{}
+					return nil
+				}).After(createMachineSet)
+
+			statusWriter.EXPECT().Update(ctx, gomock.AssignableToTypeOf(&machinev1alpha1.MachineDeployment{})).DoAndReturn(
+				func(_ context.Context, obj runtime.Object) error {
+					Expect(obj.(*machinev1alpha1.MachineDeployment).Status).To(Equal(machineDeployment.Status))
+					return nil
+				})
+			statusWriter.EXPECT().Update(ctx, gomock.AssignableToTypeOf(&machinev1alpha1.MachineSet{})).DoAndReturn(
+				func(_ context.Context, obj runtime.Object) error {
+					Expect(obj.(*machinev1alpha1.MachineSet).Status).To(Equal(machineSet.Status))
+					return nil
+				})
+			statusWriter.EXPECT().Update(ctx, gomock.AssignableToTypeOf(&machinev1alpha1.Machine{})).DoAndReturn(
+				func(_ context.Context, obj runtime.Object) error {
+					Expect(obj.(*machinev1alpha1.Machine).Status).To(Equal(machine.Status))
+					return nil
+				})
+
+			Expect(a.Restore(ctx, worker, nil, state)).To(Succeed())
+		})
+
+		It("should adopt already existing machine objects when the restoration is retried", func() {
+			alreadyExists := apierrors.NewAlreadyExists(schema.GroupResource{Group: machinev1alpha1.SchemeGroupVersion.Group}, "")
+			c.EXPECT().Create(ctx, gomock.Any()).Return(alreadyExists).Times(3)
+			c.EXPECT().Get(ctx, client.ObjectKey{Namespace: namespace, Name: "pool"}, gomock.AssignableToTypeOf(&machinev1alpha1.MachineDeployment{})).DoAndReturn(
+				func(_ context.Context, _ client.ObjectKey, obj runtime.Object) error {
+					*obj.(*machinev1alpha1.MachineDeployment) = machineDeployment
+					return nil
+				})
+			c.EXPECT().Get(ctx, client.ObjectKey{Namespace: namespace, Name: "pool-1"}, gomock.AssignableToTypeOf(&machinev1alpha1.MachineSet{})).DoAndReturn(
+				func(_ context.Context, _ client.ObjectKey, obj runtime.Object) error {
+					*obj.(*machinev1alpha1.MachineSet) = machineSet
+					return nil
+				})
+			c.EXPECT().Get(ctx, client.ObjectKey{Namespace: namespace, Name: "pool-1-a"}, gomock.AssignableToTypeOf(&machinev1alpha1.Machine{})).DoAndReturn(
+				func(_ context.Context, _ client.ObjectKey, obj runtime.Object) error {
+					*obj.(*machinev1alpha1.Machine) = machine
+					return nil
+				})
+			statusWriter.EXPECT().Update(ctx, gomock.Any()).Times(3)
+
+			Expect(a.Restore(ctx, worker, nil, state)).To(Succeed())
+		})
+
+		It("should fail if a machine object cannot be created", func() {
+			c.EXPECT().Create(ctx, gomock.Any()).Return(fmt.Errorf("fake"))
+
+			Expect(a.Restore(ctx, worker, nil, state)).NotTo(Succeed())
+		})
+
+		Context("after the rollout status has been updated", func() {
+			var statuses extensionsworkercontroller.MachineDeploymentStatuses
+
+			BeforeEach(func() {
+				worker.Status.State = state
+				statuses = extensionsworkercontroller.MachineDeploymentStatuses{{Name: "pool", PoolName: "pool", Phase: extensionsworkercontroller.MachineDeploymentPhaseAvailable}}
+
+				c.EXPECT().Get(ctx, client.ObjectKey{Namespace: namespace, Name: "worker"}, gomock.AssignableToTypeOf(&extensionsv1alpha1.Worker{})).DoAndReturn(
+					func(_ context.Context, _ client.ObjectKey, obj *extensionsv1alpha1.Worker) error {
+						worker.DeepCopy().DeepCopyInto(obj)
+						return nil
+					})
+			})
+
+			It("should keep the machine state while the worker is restored", func() {
+				worker.Annotations = map[string]string{gardencorev1alpha1.GardenerOperation: extensionscontroller.GardenerOperationRestore}
+
+				Expect(a.updateMachineDeploymentStatuses(ctx, worker, statuses)).To(Succeed())
+				Expect(worker.Status.State).To(Equal(state))
+
+				c.EXPECT().Create(ctx, gomock.Any()).Return(fmt.Errorf("fake"))
+				Expect(a.Restore(ctx, worker, nil, worker.Status.State)).To(MatchError(ContainSubstring("could not restore MachineDeployment pool")))
+			})
+
+			It("should replace the machine state once the worker has been restored", func() {
+				statusWriter.EXPECT().Update(ctx, gomock.AssignableToTypeOf(&extensionsv1alpha1.Worker{})).Return(nil)
+
+				Expect(a.updateMachineDeploymentStatuses(ctx, worker, statuses)).To(Succeed())
+
+				status, err := workerhelper.WorkerStatusFromWorker(worker)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(status.MachineDeployments).To(HaveLen(1))
+				Expect(status.MachineState).To(BeNil())
+				Expect(a.Restore(ctx, worker, nil, worker.Status.State)).To(Succeed())
+			})
+		})
+	})
+})
+
+var _ = Describe("#exportObjectMeta", func() {
+	It("should drop the seed specific fields", func() {
+		Expect(exportObjectMeta(metav1.ObjectMeta{
+			Namespace:       "foo",
+			Name:            "bar",
+			UID:             "uid",
+			ResourceVersion: "1",
+			Labels:          map[string]string{"a": "b"},
+			Finalizers:      []string{"f"},
+			OwnerReferences: []metav1.OwnerReference{{Kind: "MachineSet", Name: "baz", UID: "owner-uid"}},
+		})).To(Equal(metav1.ObjectMeta{
+			Name:            "bar",
+			Labels:          map[string]string{"a": "b"},
+			OwnerReferences: []metav1.OwnerReference{{Kind: "MachineSet", Name: "baz"}},
+		}))
+	})
+})
+
+var _ = Describe("#resolveOwnerReferences", func() {
+	It("should resolve the UIDs by kind and name and drop unknown owners", func() {
+		Expect(resolveOwnerReferences([]metav1.OwnerReference{
+			{Kind: "MachineSet", Name: "foo"},
+			{Kind: "MachineSet", Name: "bar"},
+		}, map[string]types.UID{"MachineSet/foo": "uid"})).To(Equal([]metav1.OwnerReference{
+			{Kind: "MachineSet", Name: "foo", UID: "uid"},
+		}))
+	})
+})
@@ -19,8 +19,10 @@ import (
 	"fmt"
 	"time"
 
+	workerhelper "github.com/gardener/gardener-extensions/pkg/apis/worker/helper"
 	"github.com/gardener/gardener-extensions/pkg/controller"
 	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
+	controllererror "github.com/gardener/gardener-extensions/pkg/controller/error"
 	"github.com/gardener/gardener-extensions/pkg/controller/worker"
 	"github.com/gardener/gardener-extensions/pkg/util"
 	"github.com/pkg/errors"
@@ -30,33 +32,49 @@ import (
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	extensionsv1alpha1helper "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1/helper"
 	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
-	"github.com/gardener/gardener/pkg/utils/kubernetes/health"
 	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/util/retry"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
+const (
+	// progressMachineDeploymentsDeployed is the progress reported once the machine deployments have been deployed.
+	progressMachineDeploymentsDeployed = 30
+	// progressMachineDeploymentsAvailable is the progress reported once all machine deployments are available.
+	progressMachineDeploymentsAvailable = 90
+)
+
 func (a *genericActuator) Reconcile(ctx context.Context, worker *extensionsv1alpha1.Worker, cluster *controller.Cluster) error {
 	workerDelegate, err := a.delegateFactory.WorkerDelegate(ctx, worker, cluster)
 	if err != nil {
 		return errors.Wrapf(err, "could not instantiate actuator context")
 	}
 
+	// Generate the desired machine deployments.
+	wantedMachineDeployments, err := workerDelegate.GenerateMachineDeployments(ctx)
+	if err != nil {
+		return errors.Wrapf(err, "failed to generate the machine deployments")
+	}
+	reportMachineImages(ctx, wantedMachineDeployments)
+
 	// Deploy the machine-controller-manager into the cluster.
 	a.logger.Info("Deploying the machine-controller-manager", "worker", fmt.Sprintf("%s/%s", worker.Namespace, worker.Name))
+	extensionscontroller.ReportProgress(ctx, 10, "Deploying the machine-controller-manager")
 	if err := a.deployMachineControllerManager(ctx, worker, cluster, workerDelegate); err != nil {
 		return err
 	}
 
-	// Generate the desired machine deployments.
-	wantedMachineDeployments, err := workerDelegate.GenerateMachineDeployments(ctx)
+	// Read the rollout settings overridden per worker pool.
+	poolSettings, err := poolRolloutSettings(worker.Spec.Pools)
 	if err != nil {
-		return errors.Wrapf(err, "failed to generate the machine deployments")
+		return err
 	}
 
 	// Get list of existing machine class names and list of used machine class secrets.
@@ -93,6 +111,7 @@ func (a *genericActuator) Reconcile(ctx context.Context, worker *extensionsv1alp
 
 	// Deploy generated machine classes.
 	a.logger.Info("Deploying the machine classes", "worker", fmt.Sprintf("%s/%s", worker.Namespace, worker.Name))
+	extensionscontroller.ReportProgress(ctx, 20, "Deploying the machine classes")
 	if err := workerDelegate.DeployMachineClasses(ctx); err != nil {
 		return errors.Wrapf(err, "failed to deploy the machine classes")
 	}
@@ -105,19 +124,41 @@ func (a *genericActuator) Reconcile(ctx context.Context, worker *extensionsv1alp
 
 	// Generate machine deployment configuration based on previously computed list of deployments and deploy them.
 	a.logger.Info("Deploying the machine deployments", "worker", fmt.Sprintf("%s/%s", worker.Namespace, worker.Name))
-	if err := a.deployMachineDeployments(ctx, cluster, worker, existingMachineDeployments, wantedMachineDeployments, workerDelegate.MachineClassKind(), clusterAutoscalerRequired); err != nil {
+	extensionscontroller.ReportProgress(ctx, progressMachineDeploymentsDeployed, "Deploying the machine deployments")
+	if err := a.deployMachineDeployments(ctx, cluster, worker, existingMachineDeployments, wantedMachineDeployments, poolSettings, workerDelegate.MachineClassKind(), clusterAutoscalerRequired); err != nil {
 		return errors.Wrapf(err, "failed to generate the machine deployment config")
 	}
 
 	// Wait until all generated machine deployments are healthy/available.
-	timeoutCtx, cancel := context.WithTimeout(ctx, 30*time.Minute)
+	timeoutCtx, cancel := context.WithTimeout(ctx, a.machineDeploymentsTimeout(wantedMachineDeployments, poolSettings))
 	defer cancel()
 
-	if err := a.waitUntilMachineDeploymentsAvailable(timeoutCtx, cluster, worker, wantedMachineDeployments); err != nil {
-		return gardencorev1alpha1helper.DetermineError(fmt.Sprintf("Failed while waiting for all machine deployments to be ready: '%s'", err.Error()))
+	statuses, err := a.waitUntilMachineDeploymentsAvailable(timeoutCtx, cluster, worker, wantedMachineDeployments)
+	if err != nil {
+		message := err.Error()
+		if notAvailable := statuses.NotAvailable(); len(notAvailable) > 0 {
+			message = fmt.Sprintf("%s\n%s", message, notAvailable)
+		}
+		extensionscontroller.SetCondition(ctx, extensionscontroller.ConditionTypeMachineDeploymentsHealthy, gardencorev1alpha1.ConditionFalse, "MachineDeploymentsUnavailable", message)
+		if statuses != nil {
+			if err := a.updateMachineDeploymentStatuses(ctx, worker, statuses); err != nil {
+				a.logger.Error(err, "Could not persist the machine deployment statuses", "worker", fmt.Sprintf("%s/%s", worker.Namespace, worker.Name))
+			}
+		}
+		return waitError(err, "Failed while waiting for all machine deployments to be ready")
+	}
+
+	if controller.IsHibernated(cluster.Shoot) {
+		extensionscontroller.SetCondition(ctx, extensionscontroller.ConditionTypeMachineDeploymentsHealthy, gardencorev1alpha1.ConditionTrue, "MachineDeploymentsHibernated", "All machines have been hibernated.")
+	} else {
+		extensionscontroller.SetCondition(ctx, extensionscontroller.ConditionTypeMachineDeploymentsHealthy, gardencorev1alpha1.ConditionTrue, "MachineDeploymentsAvailable", fmt.Sprintf("All machine deployments are available.\n%s", statuses))
+		if err := a.updateMachineDeploymentStatuses(ctx, worker, statuses); err != nil {
+			return errors.Wrapf(err, "failed to persist the machine deployment statuses")
+		}
 	}
 
 	// Delete all old machine deployments (i.e. those which were not previously computed but exist in the cluster).
+	extensionscontroller.ReportProgress(ctx, progressMachineDeploymentsAvailable, "Cleaning up orphaned machine deployments, classes and secrets")
 	if err := a.cleanupMachineDeployments(ctx, existingMachineDeployments, wantedMachineDeployments); err != nil {
 		return errors.Wrapf(err, "failed to cleanup the machine deployments")
 	}
@@ -150,11 +191,12 @@ func (a *genericActuator) Reconcile(ctx context.Context, worker *extensionsv1alp
 	return nil
 }
 
-func (a *genericActuator) deployMachineDeployments(ctx context.Context, cluster *controller.Cluster, worker *extensionsv1alpha1.Worker, existingMachineDeployments *machinev1alpha1.MachineDeploymentList, wantedMachineDeployments worker.MachineDeployments, classKind string, clusterAutoscalerRequired bool) error {
+func (a *genericActuator) deployMachineDeployments(ctx context.Context, cluster *controller.Cluster, worker *extensionsv1alpha1.Worker, existingMachineDeployments *machinev1alpha1.MachineDeploymentList, wantedMachineDeployments worker.MachineDeployments, poolSettings map[string]*worker.PoolRolloutSettings, classKind string, clusterAutoscalerRequired bool) error {
 	for _, deployment := range wantedMachineDeployments {
 		var (
 			labels                    = map[string]string{"name": deployment.Name}
 			existingMachineDeployment = getExistingMachineDeployment(existingMachineDeployments, deployment.Name)
+			minReadySeconds           = poolSettings[deployment.PoolName].GetMinReadySeconds(a.rolloutSettings.MinReadySeconds)
 			replicas                  int
 		)
 
@@ -192,49 +234,54 @@ func (a *genericActuator) deployMachineDeployments(ctx context.Context, cluster
 			}
 		}
 
-		machineDeployment := &machinev1alpha1.MachineDeployment{
-			ObjectMeta: metav1.ObjectMeta{
-				Name:      deployment.Name,
-				Namespace: worker.Namespace,
-			},
-		}
-
-		if err := controller.CreateOrUpdate(ctx, a.client, machineDeployment, func() error {
-			machineDeployment.Spec = machinev1alpha1.MachineDeploymentSpec{
-				Replicas:        int32(replicas),
-				MinReadySeconds: 500,
-				Strategy: machinev1alpha1.MachineDeploymentStrategy{
-					Type: machinev1alpha1.RollingUpdateMachineDeploymentStrategyType,
-					RollingUpdate: &machinev1alpha1.RollingUpdateMachineDeployment{
-						MaxSurge:       &deployment.MaxSurge,
-						MaxUnavailable: &deployment.MaxUnavailable,
-					},
+		spec, err := machineDeploymentSpec(machinev1alpha1.MachineDeploymentSpec{
+			Replicas:        int32(replicas),
+			MinReadySeconds: minReadySeconds,
+			Strategy: machinev1alpha1.MachineDeploymentStrategy{
+				Type: machinev1alpha1.RollingUpdateMachineDeploymentStrategyType,
+				RollingUpdate: &machinev1alpha1.RollingUpdateMachineDeployment{
+					MaxSurge:       &deployment.MaxSurge,
+					MaxUnavailable: &deployment.MaxUnavailable,
 				},
-				Selector: &metav1.LabelSelector{
-					MatchLabels: labels,
+			},
+			Selector: &metav1.LabelSelector{
+				MatchLabels: labels,
+			},
+			Template: machinev1alpha1.MachineTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: labels,
 				},
-				Template: machinev1alpha1.MachineTemplateSpec{
-					ObjectMeta: metav1.ObjectMeta{
-						Labels: labels,
+				Spec: machinev1alpha1.MachineSpec{
+					Class: machinev1alpha1.ClassSpec{
+						Kind: classKind,
+						Name: deployment.ClassName,
 					},
-					Spec: machinev1alpha1.MachineSpec{
-						Class: machinev1alpha1.ClassSpec{
-							Kind: classKind,
-							Name: deployment.ClassName,
+					NodeTemplateSpec: machinev1alpha1.NodeTemplateSpec{
+						ObjectMeta: metav1.ObjectMeta{
+							Annotations: deployment.NodeAnnotations(),
+							Labels:      deployment.Labels,
 						},
-						NodeTemplateSpec: machinev1alpha1.NodeTemplateSpec{
-							ObjectMeta: metav1.ObjectMeta{
-								Annotations: deployment.Annotations,
-								Labels:      deployment.Labels,
-							},
-							Spec: corev1.NodeSpec{
-								Taints: deployment.Taints,
-							},
+						Spec: corev1.NodeSpec{
+							Taints: deployment.Taints,
 						},
 					},
 				},
-			}
+			},
+		}, deployment.MachineSettings)
+		if err != nil {
+			return errors.Wrapf(err, "could not compute the spec of machine deployment %q", deployment.Name)
+		}
+
+		// The MachineDeployment is written as unstructured object as the vendored machine-controller-manager API
+		// does not yet contain the machine configuration fields, i.e. the machine settings of the worker pool.
+		machineDeployment := &unstructured.Unstructured{}
+		machineDeployment.SetGroupVersionKind(machinev1alpha1.SchemeGroupVersion.WithKind("MachineDeployment"))
+		machineDeployment.SetNamespace(worker.Namespace)
+		machineDeployment.SetName(deployment.Name)
 
+		if err := controller.CreateOrUpdate(ctx, a.client, machineDeployment, func() error {
+			machineDeployment.SetAnnotations(autoscalerAnnotations(machineDeployment.GetAnnotations(), deployment))
+			machineDeployment.Object["spec"] = spec
 			return nil
 		}); err != nil {
 			return err
@@ -244,10 +291,43 @@ func (a *genericActuator) deployMachineDeployments(ctx context.Context, cluster
 	return nil
 }
 
-// waitUntilMachineDeploymentsAvailable waits for a maximum of 30 minutes until all the desired <machineDeployments>
-// were marked as healthy/available by the machine-controller-manager. It polls the status every 5 seconds.
-func (a *genericActuator) waitUntilMachineDeploymentsAvailable(ctx context.Context, cluster *controller.Cluster, worker *extensionsv1alpha1.Worker, wantedMachineDeployments worker.MachineDeployments) error {
-	return wait.PollUntil(5*time.Second, func() (bool, error) {
+// machineDeploymentSpec returns the given MachineDeployment spec as unstructured content. The given machine settings
+// are set as the machine configuration in the spec of the machine template.
+func machineDeploymentSpec(spec machinev1alpha1.MachineDeploymentSpec, machineSettings worker.MachineSettings) (map[string]interface{}, error) {
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&spec)
+	if err != nil {
+		return nil, err
+	}
+	for field, value := range machineSettings.MachineConfiguration() {
+		if err := unstructured.SetNestedField(content, value, "template", "spec", field); err != nil {
+			return nil, err
+		}
+	}
+	return content, nil
+}
+
+// autoscalerAnnotations returns the given annotations of a MachineDeployment object with the annotations for the
+// cluster-autoscaler of the given machine deployment. Annotations for the cluster-autoscaler that are no longer wanted
+// are removed.
+func autoscalerAnnotations(annotations map[string]string, deployment worker.MachineDeployment) map[string]string {
+	if annotations == nil {
+		annotations = make(map[string]string)
+	}
+	for _, key := range worker.AutoscalerAnnotationKeys {
+		delete(annotations, key)
+	}
+	for key, value := range deployment.AutoscalerAnnotations() {
+		annotations[key] = value
+	}
+	return annotations
+}
+
+// waitUntilMachineDeploymentsAvailable waits until all the desired <machineDeployments> were marked as
+// healthy/available by the machine-controller-manager. It polls the status every 5 seconds. The rollout status
+// of each machine deployment is recorded in the MachineDeploymentsHealthy condition and persisted in the state of
+// the Worker status while waiting, and the last observed statuses are returned.
+func (a *genericActuator) waitUntilMachineDeploymentsAvailable(ctx context.Context, cluster *controller.Cluster, worker *extensionsv1alpha1.Worker, wantedMachineDeployments worker.MachineDeployments) (statuses worker.MachineDeploymentStatuses, err error) {
+	err = wait.PollUntil(5*time.Second, func() (bool, error) {
 		var numHealthyDeployments, numUpdated, numDesired, numberOfAwakeMachines int32
 
 		// Get the list of all existing machine deployments
@@ -256,49 +336,78 @@ func (a *genericActuator) waitUntilMachineDeploymentsAvailable(ctx context.Conte
 			return false, err
 		}
 
-		// Collect the numbers of ready and desired replicas.
-		for _, existingMachineDeployment := range existingMachineDeployments.Items {
-			// If the shoot get hibernated we want to wait until all machine deployments have been deleted entirely.
-			if controller.IsHibernated(cluster.Shoot) {
+		// If the shoot get hibernated we want to wait until all machine deployments have been deleted entirely.
+		if controller.IsHibernated(cluster.Shoot) {
+			for _, existingMachineDeployment := range existingMachineDeployments.Items {
 				numberOfAwakeMachines += existingMachineDeployment.Status.Replicas
-				continue
 			}
 
-			// If the Shoot is not hibernated we want to wait until all machine deployments have been as many ready
-			// replicas as desired (specified in the .spec.replicas). However, if we see any error in the status of
-			// the deployment then we return it.
-			for _, failedMachine := range existingMachineDeployment.Status.FailedMachines {
-				return false, fmt.Errorf("Machine %s failed: %s", failedMachine.Name, failedMachine.LastOperation.Description)
+			if numberOfAwakeMachines == 0 {
+				return true, nil
 			}
+			msg := fmt.Sprintf("Waiting until all machines have been hibernated (%d still awake)...", numberOfAwakeMachines)
+			a.logger.Info(msg, "worker", fmt.Sprintf("%s/%s", worker.Namespace, worker.Name))
+			extensionscontroller.ReportProgress(ctx, progressMachineDeploymentsDeployed, msg)
+			return false, nil
+		}
 
-			// If the Shoot is not hibernated we want to wait until all machine deployments have been as many ready
-			// replicas as desired (specified in the .spec.replicas).
-			for _, machineDeployment := range wantedMachineDeployments {
-				if machineDeployment.Name == existingMachineDeployment.Name {
-					if health.CheckMachineDeployment(&existingMachineDeployment) == nil {
-						numHealthyDeployments++
-					}
-					numDesired += existingMachineDeployment.Spec.Replicas
-					numUpdated += existingMachineDeployment.Status.UpdatedReplicas
-				}
+		// If the Shoot is not hibernated we want to wait until all machine deployments have been as many ready
+		// replicas as desired (specified in the .spec.replicas). The rollout status of each of them is recorded
+		// so that it is visible which machine deployment blocks the rollout.
+		statuses = machineDeploymentStatuses(wantedMachineDeployments, existingMachineDeployments)
+		for _, status := range statuses {
+			if status.IsAvailable() {
+				numHealthyDeployments++
 			}
+			numDesired += status.DesiredReplicas
+			numUpdated += status.UpdatedReplicas
 		}
 
-		switch {
-		case !controller.IsHibernated(cluster.Shoot):
-			a.logger.Info(fmt.Sprintf("Waiting until all desired machines are ready (%d/%d machine objects up-to-date, %d/%d machinedeployments available)...", numUpdated, numDesired, numHealthyDeployments, len(wantedMachineDeployments)), "worker", fmt.Sprintf("%s/%s", worker.Namespace, worker.Name))
-			if numUpdated >= numDesired && int(numHealthyDeployments) == len(wantedMachineDeployments) {
-				return true, nil
-			}
-		default:
-			if numberOfAwakeMachines == 0 {
-				return true, nil
+		if notAvailable := statuses.NotAvailable(); len(notAvailable) > 0 {
+			extensionscontroller.SetCondition(ctx, extensionscontroller.ConditionTypeMachineDeploymentsHealthy, gardencorev1alpha1.ConditionProgressing, "MachineDeploymentsRollingOut", notAvailable.String())
+		}
+		if err := a.updateMachineDeploymentStatuses(ctx, worker, statuses); err != nil {
+			a.logger.Error(err, "Could not persist the machine deployment statuses", "worker", fmt.Sprintf("%s/%s", worker.Namespace, worker.Name))
+		}
+
+		// If we see any error in the status of the deployments then we return it.
+		for _, existingMachineDeployment := range existingMachineDeployments.Items {
+			for _, failedMachine := range existingMachineDeployment.Status.FailedMachines {
+				return false, fmt.Errorf("Machine %s failed: %s", failedMachine.Name, failedMachine.LastOperation.Description)
 			}
-			a.logger.Info(fmt.Sprintf("Waiting until all machines have been hibernated (%d still awake)...", numberOfAwakeMachines), "worker", fmt.Sprintf("%s/%s", worker.Namespace, worker.Name))
 		}
 
+		msg := fmt.Sprintf("Waiting until all desired machines are ready (%d/%d machine objects up-to-date, %d/%d machinedeployments available)...", numUpdated, numDesired, numHealthyDeployments, len(wantedMachineDeployments))
+		a.logger.Info(msg, "worker", fmt.Sprintf("%s/%s", worker.Namespace, worker.Name))
+		if numUpdated >= numDesired && int(numHealthyDeployments) == len(wantedMachineDeployments) {
+			return true, nil
+		}
+		extensionscontroller.ReportProgress(ctx, rolloutProgress(numUpdated, numDesired, numHealthyDeployments, int32(len(wantedMachineDeployments))), msg)
+
 		return false, nil
 	}, ctx.Done())
+	return statuses, err
+}
+
+// rolloutProgress computes the progress of a machine deployment rollout. Half of the progress range between
+// progressMachineDeploymentsDeployed and progressMachineDeploymentsAvailable is driven by the up-to-date machines
+// and the other half by the available machine deployments.
+func rolloutProgress(numUpdated, numDesired, numHealthyDeployments, numWantedDeployments int32) int {
+	var (
+		progress = progressMachineDeploymentsDeployed
+		half     = (progressMachineDeploymentsAvailable - progressMachineDeploymentsDeployed) / 2
+	)
+
+	if numDesired > 0 {
+		if numUpdated > numDesired {
+			numUpdated = numDesired
+		}
+		progress += half * int(numUpdated) / int(numDesired)
+	}
+	if numWantedDeployments > 0 {
+		progress += half * int(numHealthyDeployments) / int(numWantedDeployments)
+	}
+	return progress
 }
 
 func (a *genericActuator) updateWorkerStatus(ctx context.Context, worker *extensionsv1alpha1.Worker, machineDeployments worker.MachineDeployments) error {
@@ -318,8 +427,80 @@ func (a *genericActuator) updateWorkerStatus(ctx context.Context, worker *extens
 	})
 }
 
+// updateMachineDeploymentStatuses persists the given rollout statuses of the machine deployments in the state of the
+// Worker status together with the conditions recorded so far. The state is left untouched while the Worker is
+// restored, as it still carries the machine state the restoration is retried with.
+func (a *genericActuator) updateMachineDeploymentStatuses(ctx context.Context, worker *extensionsv1alpha1.Worker, statuses worker.MachineDeploymentStatuses) error {
+	state, err := workerhelper.EncodeWorkerStatus(statuses.WorkerStatus())
+	if err != nil {
+		return err
+	}
+
+	return extensionscontroller.TryUpdateStatus(ctx, retry.DefaultBackoff, a.client, worker, func() error {
+		if !extensionscontroller.IsRestoreOperation(worker) {
+			worker.Status.State = state
+		}
+		worker.Status.Conditions = extensionscontroller.MergeRecordedConditions(ctx, worker.Status.Conditions)
+		return nil
+	})
+}
+
 // Helper functions
 
+// reportMachineImages records the machine images used by the given machine deployments and whether they are
+// deprecated or expired in the MachineImagesUpToDate condition.
+func reportMachineImages(ctx context.Context, machineDeployments worker.MachineDeployments) {
+	worker.SetMachineImagesCondition(ctx, machineDeployments, time.Now())
+}
+
+// machineDeploymentStatuses computes the rollout statuses of the wanted machine deployments from the existing ones.
+func machineDeploymentStatuses(wantedMachineDeployments worker.MachineDeployments, existingMachineDeployments *machinev1alpha1.MachineDeploymentList) worker.MachineDeploymentStatuses {
+	statuses := make(worker.MachineDeploymentStatuses, 0, len(wantedMachineDeployments))
+	for _, machineDeployment := range wantedMachineDeployments {
+		statuses = append(statuses, worker.NewMachineDeploymentStatus(machineDeployment, getExistingMachineDeployment(existingMachineDeployments, machineDeployment.Name)))
+	}
+	return statuses
+}
+
+// poolRolloutSettings reads the rollout settings overridden in the WorkerConfigs of the given pools, keyed by pool name.
+func poolRolloutSettings(pools []extensionsv1alpha1.WorkerPool) (map[string]*worker.PoolRolloutSettings, error) {
+	settings := make(map[string]*worker.PoolRolloutSettings, len(pools))
+	for _, pool := range pools {
+		config, err := workerhelper.WorkerConfigFromPool(pool)
+		if err != nil {
+			return nil, err
+		}
+		settings[pool.Name] = worker.PoolRolloutSettingsFromConfig(config)
+	}
+	return settings, nil
+}
+
+// machineDeploymentsTimeout returns the maximum time to wait until the given machine deployments are available,
+// i.e. the longest timeout of the pools they belong to. Pools without an overridden timeout use the default one.
+func (a *genericActuator) machineDeploymentsTimeout(machineDeployments worker.MachineDeployments, poolSettings map[string]*worker.PoolRolloutSettings) time.Duration {
+	if len(machineDeployments) == 0 {
+		return a.rolloutSettings.MachineDeploymentsTimeout
+	}
+
+	var timeout time.Duration
+	for _, machineDeployment := range machineDeployments {
+		if poolTimeout := poolSettings[machineDeployment.PoolName].GetMachineDeploymentsTimeout(a.rolloutSettings.MachineDeploymentsTimeout); poolTimeout > timeout {
+			timeout = poolTimeout
+		}
+	}
+	return timeout
+}
+
+// waitError returns an error for the given error that occurred while waiting. Errors caused by an exceeded timeout
+// are reported with the controllererror.ErrorTimeout code, for all others the code is determined from the message.
+func waitError(err error, description string) error {
+	message := fmt.Sprintf("%s: '%s'", description, err.Error())
+	if err == wait.ErrWaitTimeout {
+		return gardencorev1alpha1helper.NewErrorWithCode(controllererror.ErrorTimeout, message)
+	}
+	return gardencorev1alpha1helper.DetermineError(message)
+}
+
 func shootIsAwake(isHibernated bool, existingMachineDeployments *machinev1alpha1.MachineDeploymentList) bool {
 	if isHibernated {
 		return false
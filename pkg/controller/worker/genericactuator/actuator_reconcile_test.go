@@ -0,0 +1,85 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package genericactuator
+
+import (
+	"time"
+
+	"github.com/gardener/gardener-extensions/pkg/controller/worker"
+
+	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/utils/pointer"
+)
+
+var _ = Describe("Reconcile", func() {
+	field := func(content map[string]interface{}, fields ...string) interface{} {
+		value, _, err := unstructured.NestedFieldNoCopy(content, fields...)
+		Expect(err).NotTo(HaveOccurred())
+		return value
+	}
+
+	Describe("#machineDeploymentSpec", func() {
+		spec := machinev1alpha1.MachineDeploymentSpec{
+			Replicas: 3,
+			Template: machinev1alpha1.MachineTemplateSpec{
+				Spec: machinev1alpha1.MachineSpec{
+					Class: machinev1alpha1.ClassSpec{Kind: "AWSMachineClass", Name: "class"},
+				},
+			},
+		}
+
+		It("should set the machine settings as machine configuration of the template", func() {
+			content, err := machineDeploymentSpec(spec, worker.MachineSettings{
+				DrainTimeout:    &metav1.Duration{Duration: 2 * time.Hour},
+				CreationTimeout: &metav1.Duration{Duration: 30 * time.Minute},
+				MaxEvictRetries: pointer.Int32Ptr(20),
+			})
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(field(content, "replicas")).To(Equal(int64(3)))
+			Expect(field(content, "template", "spec", "class", "name")).To(Equal("class"))
+			Expect(field(content, "template", "spec", "drainTimeout")).To(Equal("2h0m0s"))
+			Expect(field(content, "template", "spec", "creationTimeout")).To(Equal("30m0s"))
+			Expect(field(content, "template", "spec", "maxEvictRetries")).To(Equal(int64(20)))
+			Expect(field(content, "template", "spec")).NotTo(HaveKey("healthTimeout"))
+		})
+
+		It("should not set a machine configuration if no machine settings are set", func() {
+			content, err := machineDeploymentSpec(spec, worker.MachineSettings{})
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(field(content, "template", "spec")).NotTo(HaveKey("drainTimeout"))
+		})
+	})
+
+	Describe("#autoscalerAnnotations", func() {
+		It("should replace the cluster-autoscaler annotations and keep the others", func() {
+			annotations := map[string]string{"foo": "bar"}
+			for _, key := range worker.AutoscalerAnnotationKeys {
+				annotations[key] = "stale"
+			}
+
+			Expect(autoscalerAnnotations(annotations, worker.MachineDeployment{Minimum: 1, Maximum: 3})).To(Equal(map[string]string{
+				"foo":                             "bar",
+				worker.AnnotationNodeGroupMinSize: "1",
+				worker.AnnotationNodeGroupMaxSize: "3",
+			}))
+		})
+	})
+})
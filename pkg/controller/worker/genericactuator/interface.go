@@ -38,6 +38,11 @@ type WorkerDelegate interface {
 	MachineClassKind() string
 	// MachineClassList yields a newly initialized machine class list object.
 	MachineClassList() runtime.Object
+	// MachineClassReplacementFields yields the top-level fields of the provider specific machine class specs whose
+	// changes require new machines, e.g. the image, machine type, volumes, subnet or user data. Only they are part of
+	// the hash in the machine class names (see worker.MachineClassName), so that changes to all other fields, e.g.
+	// tags or labels of the machines, are applied to the existing machine classes instead of rolling all nodes.
+	MachineClassReplacementFields() []string
 	// DeployMachineClasses generates and creates the provider specific machine classes.
 	DeployMachineClasses(context.Context) error
 
@@ -0,0 +1,108 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package genericactuator
+
+import (
+	"sync"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	toolscache "k8s.io/client-go/tools/cache"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+)
+
+// namespaceNotifier notifies subscribers about changes of objects in their namespace. It registers a single event
+// handler per informer of the shared cache, as event handlers of shared informers cannot be removed again.
+type namespaceNotifier struct {
+	lock        sync.Mutex
+	registered  map[string]struct{}
+	subscribers map[string]map[chan struct{}]struct{}
+}
+
+func newNamespaceNotifier() *namespaceNotifier {
+	return &namespaceNotifier{
+		registered:  make(map[string]struct{}),
+		subscribers: make(map[string]map[chan struct{}]struct{}),
+	}
+}
+
+// register ensures that the notifier receives the events of the informers for the given kinds.
+func (n *namespaceNotifier) register(informers cache.Informers, gvks ...schema.GroupVersionKind) error {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+
+	for _, gvk := range gvks {
+		key := gvk.String()
+		if _, ok := n.registered[key]; ok {
+			continue
+		}
+
+		informer, err := informers.GetInformerForKind(gvk)
+		if err != nil {
+			return err
+		}
+		informer.AddEventHandler(toolscache.ResourceEventHandlerFuncs{
+			AddFunc:    n.notify,
+			UpdateFunc: func(_, newObj interface{}) { n.notify(newObj) },
+			DeleteFunc: n.notify,
+		})
+		n.registered[key] = struct{}{}
+	}
+
+	return nil
+}
+
+// subscribe returns a channel that receives a notification whenever an object in the given namespace changes,
+// and a function that cancels the subscription. Notifications are coalesced if the subscriber is busy.
+func (n *namespaceNotifier) subscribe(namespace string) (<-chan struct{}, func()) {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+
+	ch := make(chan struct{}, 1)
+	if n.subscribers[namespace] == nil {
+		n.subscribers[namespace] = make(map[chan struct{}]struct{})
+	}
+	n.subscribers[namespace][ch] = struct{}{}
+
+	return ch, func() {
+		n.lock.Lock()
+		defer n.lock.Unlock()
+
+		delete(n.subscribers[namespace], ch)
+		if len(n.subscribers[namespace]) == 0 {
+			delete(n.subscribers, namespace)
+		}
+	}
+}
+
+func (n *namespaceNotifier) notify(obj interface{}) {
+	if tombstone, ok := obj.(toolscache.DeletedFinalStateUnknown); ok {
+		obj = tombstone.Obj
+	}
+	accessor, err := meta.Accessor(obj)
+	if err != nil {
+		return
+	}
+
+	n.lock.Lock()
+	defer n.lock.Unlock()
+
+	for ch := range n.subscribers[accessor.GetNamespace()] {
+		select {
+		case ch <- struct{}{}:
+		default:
+		}
+	}
+}
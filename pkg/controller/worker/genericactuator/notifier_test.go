@@ -0,0 +1,79 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package genericactuator
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	toolscache "k8s.io/client-go/tools/cache"
+)
+
+var _ = Describe("NamespaceNotifier", func() {
+	var (
+		notifier *namespaceNotifier
+
+		secretInNamespace = func(namespace string) *corev1.Secret {
+			return &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: "secret"}}
+		}
+	)
+
+	BeforeEach(func() {
+		notifier = newNamespaceNotifier()
+	})
+
+	It("should notify the subscribers of the namespace of the object", func() {
+		ch, unsubscribe := notifier.subscribe("foo")
+		defer unsubscribe()
+		otherCh, otherUnsubscribe := notifier.subscribe("bar")
+		defer otherUnsubscribe()
+
+		notifier.notify(secretInNamespace("foo"))
+
+		Expect(ch).To(Receive())
+		Expect(otherCh).NotTo(Receive())
+	})
+
+	It("should notify about deleted objects whose final state is unknown", func() {
+		ch, unsubscribe := notifier.subscribe("foo")
+		defer unsubscribe()
+
+		notifier.notify(toolscache.DeletedFinalStateUnknown{Key: "foo/secret", Obj: secretInNamespace("foo")})
+
+		Expect(ch).To(Receive())
+	})
+
+	It("should coalesce notifications if the subscriber is busy", func() {
+		ch, unsubscribe := notifier.subscribe("foo")
+		defer unsubscribe()
+
+		notifier.notify(secretInNamespace("foo"))
+		notifier.notify(secretInNamespace("foo"))
+
+		Expect(ch).To(Receive())
+		Expect(ch).NotTo(Receive())
+	})
+
+	It("should not notify cancelled subscriptions", func() {
+		ch, unsubscribe := notifier.subscribe("foo")
+		unsubscribe()
+
+		notifier.notify(secretInNamespace("foo"))
+
+		Expect(ch).NotTo(Receive())
+		Expect(notifier.subscribers).To(BeEmpty())
+	})
+})
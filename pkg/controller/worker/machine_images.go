@@ -0,0 +1,134 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package worker
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	apisworker "github.com/gardener/gardener-extensions/pkg/apis/worker"
+	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// ConditionTypeMachineImagesUpToDate is a condition type reported by worker actuators indicating whether the
+	// machine images used by the worker pools are neither deprecated nor expired. Its message lists the machine
+	// image resolved for each machine deployment, i.e. for each pool and zone.
+	ConditionTypeMachineImagesUpToDate gardencorev1alpha1.ConditionType = "MachineImagesUpToDate"
+
+	// EventMachineImageDeprecation is an event reason to describe worker pools using deprecated or expired machine images.
+	EventMachineImageDeprecation string = "MachineImageDeprecation"
+)
+
+// MachineImage is the machine image used by the machines of a machine deployment, i.e. its logical name and
+// version and the provider-specific identifier they have been resolved to.
+type MachineImage struct {
+	// Name is the logical name of the machine image.
+	Name string
+	// Version is the logical version of the machine image.
+	Version string
+	// ID is the provider-specific identifier of the machine image, e.g. an AMI.
+	ID string
+	// DeprecationDate is the date from which on the machine image is considered deprecated. If it is not set,
+	// machine images with an expiration date are considered deprecated.
+	DeprecationDate *metav1.Time
+	// ExpirationDate is the date after which the machine image must no longer be used.
+	ExpirationDate *metav1.Time
+}
+
+// Deprecated returns true if the deprecation date of the machine image has been reached at the given time. Machine
+// images without a deprecation date are deprecated if they have an expiration date.
+func (m *MachineImage) Deprecated(now time.Time) bool {
+	if m == nil {
+		return false
+	}
+	if m.DeprecationDate != nil {
+		return !now.Before(m.DeprecationDate.Time)
+	}
+	return m.ExpirationDate != nil
+}
+
+// Expired returns true if the expiration date of the machine image lies before the given time.
+func (m *MachineImage) Expired(now time.Time) bool {
+	return m != nil && m.ExpirationDate != nil && m.ExpirationDate.Time.Before(now)
+}
+
+// Status returns the status of the machine image used by the machines of the given pool in the given zone.
+func (m *MachineImage) Status(poolName, zone string) apisworker.MachineImageStatus {
+	return apisworker.MachineImageStatus{
+		PoolName:        poolName,
+		Zone:            zone,
+		Name:            m.Name,
+		Version:         m.Version,
+		ID:              m.ID,
+		DeprecationDate: m.DeprecationDate,
+		ExpirationDate:  m.ExpirationDate,
+	}
+}
+
+// String returns the logical name and version and the identifier of the machine image.
+func (m *MachineImage) String() string {
+	return fmt.Sprintf("%s %s (%s)", m.Name, m.Version, m.ID)
+}
+
+// SetMachineImagesCondition records the MachineImagesUpToDate condition for the machine images used by the given
+// machine deployments. Machine deployments that do not report their machine image are ignored, and the condition
+// is not recorded at all if none of them reports it.
+func SetMachineImagesCondition(ctx context.Context, machineDeployments MachineDeployments, now time.Time) {
+	var (
+		images              []string
+		deprecated, expired bool
+	)
+
+	for _, machineDeployment := range machineDeployments {
+		image := machineDeployment.MachineImage
+		if image == nil {
+			continue
+		}
+
+		description := fmt.Sprintf("%s: %s", machineDeployment.Name, image)
+		switch {
+		case image.Expired(now):
+			expired = true
+			description = fmt.Sprintf("%s, expired on %s", description, image.ExpirationDate.UTC().Format(time.RFC3339))
+		case image.Deprecated(now) && image.ExpirationDate != nil:
+			deprecated = true
+			description = fmt.Sprintf("%s, deprecated and expires on %s", description, image.ExpirationDate.UTC().Format(time.RFC3339))
+		case image.Deprecated(now):
+			deprecated = true
+			description = fmt.Sprintf("%s, deprecated since %s", description, image.DeprecationDate.UTC().Format(time.RFC3339))
+		}
+		images = append(images, description)
+	}
+
+	if len(images) == 0 {
+		return
+	}
+
+	message := strings.Join(images, "; ")
+	switch {
+	case expired:
+		extensionscontroller.SetCondition(ctx, ConditionTypeMachineImagesUpToDate, gardencorev1alpha1.ConditionFalse, "MachineImagesExpired", message)
+	case deprecated:
+		extensionscontroller.SetCondition(ctx, ConditionTypeMachineImagesUpToDate, gardencorev1alpha1.ConditionFalse, "MachineImagesDeprecated", message)
+	default:
+		extensionscontroller.SetCondition(ctx, ConditionTypeMachineImagesUpToDate, gardencorev1alpha1.ConditionTrue, "MachineImagesUpToDate", message)
+	}
+}
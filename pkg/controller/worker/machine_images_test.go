@@ -0,0 +1,132 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package worker_test
+
+import (
+	"context"
+	"time"
+
+	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
+	"github.com/gardener/gardener-extensions/pkg/controller/worker"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	gardencorev1alpha1helper "github.com/gardener/gardener/pkg/apis/core/v1alpha1/helper"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("MachineImage", func() {
+	var (
+		now       = time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC)
+		yesterday = metav1.NewTime(now.Add(-24 * time.Hour))
+		tomorrow  = metav1.NewTime(now.Add(24 * time.Hour))
+
+		recorder *extensionscontroller.ConditionRecorder
+		ctx      context.Context
+	)
+
+	BeforeEach(func() {
+		recorder = extensionscontroller.NewConditionRecorder()
+		ctx = extensionscontroller.WithConditionRecorder(context.TODO(), recorder)
+	})
+
+	machineImagesCondition := func() *gardencorev1alpha1.Condition {
+		return gardencorev1alpha1helper.GetCondition(recorder.Merge(nil), worker.ConditionTypeMachineImagesUpToDate)
+	}
+
+	Describe("#Deprecated", func() {
+		It("should consider machine images without a deprecation date deprecated if they have an expiration date", func() {
+			Expect((*worker.MachineImage)(nil).Deprecated(now)).To(BeFalse())
+			Expect((&worker.MachineImage{}).Deprecated(now)).To(BeFalse())
+			Expect((&worker.MachineImage{ExpirationDate: &tomorrow}).Deprecated(now)).To(BeTrue())
+		})
+
+		It("should only consider machine images whose deprecation date has been reached deprecated", func() {
+			Expect((&worker.MachineImage{DeprecationDate: &tomorrow}).Deprecated(now)).To(BeFalse())
+			Expect((&worker.MachineImage{DeprecationDate: &yesterday}).Deprecated(now)).To(BeTrue())
+			Expect((&worker.MachineImage{DeprecationDate: &tomorrow, ExpirationDate: &tomorrow}).Deprecated(now)).To(BeFalse())
+		})
+	})
+
+	Describe("#Expired", func() {
+		It("should only consider machine images whose expiration date has passed expired", func() {
+			Expect((&worker.MachineImage{}).Expired(now)).To(BeFalse())
+			Expect((&worker.MachineImage{ExpirationDate: &tomorrow}).Expired(now)).To(BeFalse())
+			Expect((&worker.MachineImage{ExpirationDate: &yesterday}).Expired(now)).To(BeTrue())
+		})
+	})
+
+	Describe("#SetMachineImagesCondition", func() {
+		It("should not record the condition if no machine deployment reports its machine image", func() {
+			worker.SetMachineImagesCondition(ctx, worker.MachineDeployments{{Name: "pool-z1"}}, now)
+
+			Expect(machineImagesCondition()).To(BeNil())
+		})
+
+		It("should list the machine images of all machine deployments", func() {
+			worker.SetMachineImagesCondition(ctx, worker.MachineDeployments{
+				{Name: "pool-z1", MachineImage: &worker.MachineImage{Name: "coreos", Version: "2023.5.0", ID: "ami-1"}},
+				{Name: "pool-z2", MachineImage: &worker.MachineImage{Name: "coreos", Version: "2023.5.0", ID: "ami-2"}},
+				{Name: "other-z1"},
+			}, now)
+
+			condition := machineImagesCondition()
+			Expect(condition).NotTo(BeNil())
+			Expect(condition.Status).To(Equal(gardencorev1alpha1.ConditionTrue))
+			Expect(condition.Reason).To(Equal("MachineImagesUpToDate"))
+			Expect(condition.Message).To(Equal("pool-z1: coreos 2023.5.0 (ami-1); pool-z2: coreos 2023.5.0 (ami-2)"))
+		})
+
+		It("should report deprecated machine images", func() {
+			worker.SetMachineImagesCondition(ctx, worker.MachineDeployments{
+				{Name: "pool-z1", MachineImage: &worker.MachineImage{Name: "coreos", Version: "2023.5.0", ID: "ami-1", ExpirationDate: &tomorrow}},
+				{Name: "other-z1", MachineImage: &worker.MachineImage{Name: "coreos", Version: "2135.4.0", ID: "ami-2"}},
+			}, now)
+
+			condition := machineImagesCondition()
+			Expect(condition).NotTo(BeNil())
+			Expect(condition.Status).To(Equal(gardencorev1alpha1.ConditionFalse))
+			Expect(condition.Reason).To(Equal("MachineImagesDeprecated"))
+			Expect(condition.Message).To(Equal("pool-z1: coreos 2023.5.0 (ami-1), deprecated and expires on 2020-01-02T00:00:00Z; other-z1: coreos 2135.4.0 (ami-2)"))
+		})
+
+		It("should report machine images deprecated without an expiration date", func() {
+			worker.SetMachineImagesCondition(ctx, worker.MachineDeployments{
+				{Name: "pool-z1", MachineImage: &worker.MachineImage{Name: "coreos", Version: "2023.5.0", ID: "ami-1", DeprecationDate: &yesterday}},
+				{Name: "other-z1", MachineImage: &worker.MachineImage{Name: "coreos", Version: "2135.4.0", ID: "ami-2", DeprecationDate: &tomorrow}},
+			}, now)
+
+			condition := machineImagesCondition()
+			Expect(condition).NotTo(BeNil())
+			Expect(condition.Status).To(Equal(gardencorev1alpha1.ConditionFalse))
+			Expect(condition.Reason).To(Equal("MachineImagesDeprecated"))
+			Expect(condition.Message).To(Equal("pool-z1: coreos 2023.5.0 (ami-1), deprecated since 2019-12-31T00:00:00Z; other-z1: coreos 2135.4.0 (ami-2)"))
+		})
+
+		It("should report expired machine images", func() {
+			worker.SetMachineImagesCondition(ctx, worker.MachineDeployments{
+				{Name: "pool-z1", MachineImage: &worker.MachineImage{Name: "coreos", Version: "2023.5.0", ID: "ami-1", ExpirationDate: &yesterday}},
+				{Name: "other-z1", MachineImage: &worker.MachineImage{Name: "coreos", Version: "2135.4.0", ID: "ami-2", ExpirationDate: &tomorrow}},
+			}, now)
+
+			condition := machineImagesCondition()
+			Expect(condition).NotTo(BeNil())
+			Expect(condition.Status).To(Equal(gardencorev1alpha1.ConditionFalse))
+			Expect(condition.Reason).To(Equal("MachineImagesExpired"))
+			Expect(condition.Message).To(Equal("pool-z1: coreos 2023.5.0 (ami-1), expired on 2019-12-31T00:00:00Z; other-z1: coreos 2135.4.0 (ami-2), deprecated and expires on 2020-01-02T00:00:00Z"))
+		})
+	})
+})
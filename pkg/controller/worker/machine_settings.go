@@ -0,0 +1,90 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package worker
+
+import (
+	apisworker "github.com/gardener/gardener-extensions/pkg/apis/worker"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// MachineSettings contains the settings of the machine-controller-manager for the machines of a worker pool.
+type MachineSettings struct {
+	// DrainTimeout is the maximum time to drain a machine before it is deleted.
+	DrainTimeout *metav1.Duration
+	// HealthTimeout is the maximum time a machine may be unhealthy before it is replaced.
+	HealthTimeout *metav1.Duration
+	// CreationTimeout is the maximum time to wait until a new machine has joined the cluster.
+	CreationTimeout *metav1.Duration
+	// MaxEvictRetries is the maximum number of times the eviction of a pod is retried while draining a machine.
+	MaxEvictRetries *int32
+}
+
+// MachineSettingsFromConfig returns the MachineSettings of the given WorkerConfig of a pool. Settings that are not
+// set in the WorkerConfig are not set.
+func MachineSettingsFromConfig(config *apisworker.WorkerConfig) *MachineSettings {
+	settings := &MachineSettings{}
+	if config == nil {
+		return settings
+	}
+
+	settings.DrainTimeout = config.MachineDrainTimeout
+	settings.HealthTimeout = config.MachineHealthTimeout
+	settings.CreationTimeout = config.MachineCreationTimeout
+	settings.MaxEvictRetries = config.MaxEvictRetries
+	return settings
+}
+
+// WithDefaults returns a copy of the settings in which all unset fields are taken from the given defaults.
+func (s *MachineSettings) WithDefaults(defaults MachineSettings) MachineSettings {
+	out := defaults
+	if s == nil {
+		return out
+	}
+
+	if s.DrainTimeout != nil {
+		out.DrainTimeout = s.DrainTimeout
+	}
+	if s.HealthTimeout != nil {
+		out.HealthTimeout = s.HealthTimeout
+	}
+	if s.CreationTimeout != nil {
+		out.CreationTimeout = s.CreationTimeout
+	}
+	if s.MaxEvictRetries != nil {
+		out.MaxEvictRetries = s.MaxEvictRetries
+	}
+	return out
+}
+
+// MachineConfiguration returns the machine settings as the machine configuration of the machine-controller-manager,
+// i.e. as the fields of the machine spec in the template of a machine deployment. The machine-controller-manager only
+// falls back to its own flags for fields that are not set, hence fields that are not set are omitted.
+func (s MachineSettings) MachineConfiguration() map[string]interface{} {
+	configuration := map[string]interface{}{}
+	if s.DrainTimeout != nil {
+		configuration["drainTimeout"] = s.DrainTimeout.Duration.String()
+	}
+	if s.HealthTimeout != nil {
+		configuration["healthTimeout"] = s.HealthTimeout.Duration.String()
+	}
+	if s.CreationTimeout != nil {
+		configuration["creationTimeout"] = s.CreationTimeout.Duration.String()
+	}
+	if s.MaxEvictRetries != nil {
+		configuration["maxEvictRetries"] = int64(*s.MaxEvictRetries)
+	}
+	return configuration
+}
@@ -0,0 +1,97 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package worker_test
+
+import (
+	"time"
+
+	apisworker "github.com/gardener/gardener-extensions/pkg/apis/worker"
+	"github.com/gardener/gardener-extensions/pkg/controller/worker"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+)
+
+var _ = Describe("MachineSettings", func() {
+	duration := func(d time.Duration) *metav1.Duration {
+		return &metav1.Duration{Duration: d}
+	}
+
+	Describe("#MachineSettingsFromConfig", func() {
+		It("should return empty settings if the config does not contain any", func() {
+			Expect(worker.MachineSettingsFromConfig(nil)).To(Equal(&worker.MachineSettings{}))
+			Expect(worker.MachineSettingsFromConfig(&apisworker.WorkerConfig{})).To(Equal(&worker.MachineSettings{}))
+		})
+
+		It("should return the settings of the config", func() {
+			settings := worker.MachineSettingsFromConfig(&apisworker.WorkerConfig{
+				MachineDrainTimeout:    duration(2 * time.Hour),
+				MachineCreationTimeout: duration(40 * time.Minute),
+				MaxEvictRetries:        pointer.Int32Ptr(30),
+			})
+
+			Expect(settings).To(Equal(&worker.MachineSettings{
+				DrainTimeout:    duration(2 * time.Hour),
+				CreationTimeout: duration(40 * time.Minute),
+				MaxEvictRetries: pointer.Int32Ptr(30),
+			}))
+		})
+	})
+
+	Describe("#WithDefaults", func() {
+		defaults := worker.MachineSettings{
+			DrainTimeout:  duration(time.Hour),
+			HealthTimeout: duration(10 * time.Minute),
+		}
+
+		It("should return the defaults for nil settings", func() {
+			var settings *worker.MachineSettings
+			Expect(settings.WithDefaults(defaults)).To(Equal(defaults))
+		})
+
+		It("should only default unset fields", func() {
+			settings := &worker.MachineSettings{
+				DrainTimeout:    duration(2 * time.Hour),
+				MaxEvictRetries: pointer.Int32Ptr(5),
+			}
+
+			Expect(settings.WithDefaults(defaults)).To(Equal(worker.MachineSettings{
+				DrainTimeout:    duration(2 * time.Hour),
+				HealthTimeout:   duration(10 * time.Minute),
+				MaxEvictRetries: pointer.Int32Ptr(5),
+			}))
+		})
+	})
+
+	Describe("#MachineConfiguration", func() {
+		It("should only contain the set fields", func() {
+			settings := worker.MachineSettings{
+				DrainTimeout:    duration(2 * time.Hour),
+				MaxEvictRetries: pointer.Int32Ptr(20),
+			}
+
+			Expect(settings.MachineConfiguration()).To(Equal(map[string]interface{}{
+				"drainTimeout":    "2h0m0s",
+				"maxEvictRetries": int64(20),
+			}))
+		})
+
+		It("should be empty if no field is set", func() {
+			Expect(worker.MachineSettings{}.MachineConfiguration()).To(BeEmpty())
+		})
+	})
+})
@@ -15,15 +15,19 @@
 package worker
 
 import (
+	"context"
 	"fmt"
 	"math"
 	"regexp"
 	"strconv"
 
 	"github.com/gardener/gardener/pkg/utils"
+	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 var diskSizeRegexp *regexp.Regexp
@@ -37,16 +41,22 @@ func init() {
 // MachineDeployment holds information about the name, class, replicas of a MachineDeployment
 // managed by the machine-controller-manager.
 type MachineDeployment struct {
-	Name           string
-	ClassName      string
-	SecretName     string
-	Minimum        int
-	Maximum        int
-	MaxSurge       intstr.IntOrString
-	MaxUnavailable intstr.IntOrString
-	Labels         map[string]string
-	Annotations    map[string]string
-	Taints         []corev1.Taint
+	Name            string
+	PoolName        string
+	Zone            string
+	ClassName       string
+	SecretName      string
+	Minimum         int
+	Maximum         int
+	MaxSurge        intstr.IntOrString
+	MaxUnavailable  intstr.IntOrString
+	Labels          map[string]string
+	Annotations     map[string]string
+	Taints          []corev1.Taint
+	Autoscaler      AutoscalerOptions
+	NodeTemplate    *NodeTemplate
+	MachineSettings MachineSettings
+	MachineImage    *MachineImage
 }
 
 // MachineDeployments is a list of machine deployments.
@@ -86,9 +96,47 @@ func (m MachineDeployments) HasSecret(secretName string) bool {
 }
 
 // MachineClassHash returns the SHA256-hash value of the <val> struct's representation concatenated with the
-// provided <version>.
-func MachineClassHash(machineClassSpec map[string]interface{}, version string) string {
-	return utils.ComputeSHA256Hex([]byte(fmt.Sprintf("%s-%s", utils.HashForMap(machineClassSpec), version)))[:5]
+// provided <version>. If <replacementFields> are given, only these fields of the machine class spec are part of the
+// hash, i.e. the fields whose changes require new machines. Changes to all other fields are applied to the existing
+// machine class.
+func MachineClassHash(machineClassSpec map[string]interface{}, version string, replacementFields ...string) string {
+	spec := machineClassSpec
+	if len(replacementFields) > 0 {
+		spec = make(map[string]interface{}, len(replacementFields))
+		for _, field := range replacementFields {
+			if value, ok := machineClassSpec[field]; ok {
+				spec[field] = value
+			}
+		}
+	}
+
+	return utils.ComputeSHA256Hex([]byte(fmt.Sprintf("%s-%s", utils.HashForMap(spec), version)))[:5]
+}
+
+// MachineClassName returns the name of the machine class for the machine deployment with the given name. It is
+// suffixed with the MachineClassHash of the <replacementFields> of the given machine class spec. Machine classes
+// used to be named after the hash of the whole spec, so if such a name of an unchanged spec is still in use by one
+// of the machine deployments, it is kept to not replace the machines of the deployment only because of the renaming.
+func MachineClassName(deploymentName string, machineClassSpec map[string]interface{}, version string, classNamesInUse sets.String, replacementFields ...string) string {
+	if legacyName := fmt.Sprintf("%s-%s", deploymentName, MachineClassHash(machineClassSpec, version)); classNamesInUse.Has(legacyName) {
+		return legacyName
+	}
+	return fmt.Sprintf("%s-%s", deploymentName, MachineClassHash(machineClassSpec, version, replacementFields...))
+}
+
+// MachineClassNamesInUse returns the names of the machine classes used by the machine deployments in the given
+// namespace.
+func MachineClassNamesInUse(ctx context.Context, c client.Client, namespace string) (sets.String, error) {
+	machineDeployments := &machinev1alpha1.MachineDeploymentList{}
+	if err := c.List(ctx, &client.ListOptions{Namespace: namespace}, machineDeployments); err != nil {
+		return nil, err
+	}
+
+	names := sets.NewString()
+	for _, machineDeployment := range machineDeployments.Items {
+		names.Insert(machineDeployment.Spec.Template.Spec.Class.Name)
+	}
+	return names, nil
 }
 
 // DistributeOverZones is a function which is used to determine how many nodes should be used
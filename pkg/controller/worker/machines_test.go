@@ -18,6 +18,7 @@ import (
 	"github.com/gardener/gardener-extensions/pkg/controller/worker"
 
 	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/sets"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/ginkgo/extensions/table"
@@ -62,14 +63,38 @@ var _ = Describe("Machines", func() {
 	})
 
 	DescribeTable("#MachineClassHash",
-		func(spec map[string]interface{}, version, expectedHash string) {
-			Expect(worker.MachineClassHash(spec, version)).To(Equal(expectedHash))
+		func(spec map[string]interface{}, version string, replacementFields []string, expectedHash string) {
+			Expect(worker.MachineClassHash(spec, version, replacementFields...)).To(Equal(expectedHash))
 		},
 
-		Entry("empty spec", nil, "", "aa969"),
-		Entry("non-empty spec", map[string]interface{}{"foo": "bar"}, "1.5", "5a88b"),
+		Entry("empty spec", nil, "", nil, "aa969"),
+		Entry("non-empty spec", map[string]interface{}{"foo": "bar"}, "1.5", nil, "5a88b"),
+		Entry("non-empty spec with replacement fields", map[string]interface{}{"foo": "bar", "tags": []string{"a"}}, "1.5", []string{"foo"}, "5a88b"),
+		Entry("non-empty spec with absent replacement fields", map[string]interface{}{"foo": "bar"}, "1.5", []string{"foo", "image"}, "5a88b"),
 	)
 
+	Describe("#MachineClassName", func() {
+		var (
+			spec       = map[string]interface{}{"foo": "bar", "tags": []string{"a"}}
+			legacyName = "shoot--foo--bar-pool-z1-" + worker.MachineClassHash(spec, "1.5")
+			name       = "shoot--foo--bar-pool-z1-" + worker.MachineClassHash(spec, "1.5", "foo")
+		)
+
+		It("should hash the replacement fields", func() {
+			Expect(worker.MachineClassName("shoot--foo--bar-pool-z1", spec, "1.5", sets.NewString(), "foo")).To(Equal(name))
+			Expect(worker.MachineClassName("shoot--foo--bar-pool-z1", map[string]interface{}{"foo": "bar"}, "1.5", sets.NewString(), "foo")).To(Equal(name))
+		})
+
+		It("should keep the name computed from the whole spec if it is still in use", func() {
+			Expect(worker.MachineClassName("shoot--foo--bar-pool-z1", spec, "1.5", sets.NewString(legacyName), "foo")).To(Equal(legacyName))
+		})
+
+		It("should not keep a name computed from a changed spec", func() {
+			changedSpec := map[string]interface{}{"foo": "bar", "tags": []string{"b"}}
+			Expect(worker.MachineClassName("shoot--foo--bar-pool-z1", changedSpec, "1.5", sets.NewString(legacyName), "foo")).To(Equal(name))
+		})
+	})
+
 	DescribeTable("#DistributeOverZones",
 		func(zoneIndex, size, zoneSize, expectation int) {
 			Expect(worker.DistributeOverZones(zoneIndex, size, zoneSize)).To(Equal(expectation))
@@ -84,3 +84,9 @@ func SecretToWorkerMapper(client client.Client, predicates []predicate.Predicate
 func ClusterToWorkerMapper(client client.Client, predicates []predicate.Predicate) handler.Mapper {
 	return extensionscontroller.ClusterToObjectMapper(client, func() runtime.Object { return &extensions1alpha1.WorkerList{} }, predicates)
 }
+
+// ClusterPauseToWorkerMapper returns a mapper that returns requests for Workers whose
+// referenced clusters have been paused or resumed.
+func ClusterPauseToWorkerMapper(client client.Client, predicates []predicate.Predicate) handler.Mapper {
+	return extensionscontroller.ClusterPauseToObjectMapper(client, func() runtime.Object { return &extensions1alpha1.WorkerList{} }, predicates)
+}
@@ -0,0 +1,33 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package worker
+
+import (
+	"context"
+
+	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+)
+
+// Migrator migrates Worker resources between seeds without recreating the machines.
+type Migrator interface {
+	// Migrate exports the state of the Worker, e.g. its machine objects, so that it can be stored in the status
+	// of the Worker and restored on another seed.
+	Migrate(context.Context, *extensionsv1alpha1.Worker, *extensionscontroller.Cluster) (string, error)
+	// Restore restores the given state of the Worker that has been exported by Migrate. It is called before
+	// the Worker is reconciled on the new seed.
+	Restore(context.Context, *extensionsv1alpha1.Worker, *extensionscontroller.Cluster, string) error
+}
@@ -15,6 +15,8 @@
 package worker
 
 import (
+	"time"
+
 	"github.com/spf13/pflag"
 )
 
@@ -22,24 +24,63 @@ const (
 	// DeployCRDsFlag is the name of the command line flag to specify whether the worker CRDs
 	// should be deployed or not.
 	DeployCRDsFlag = "deploy-crds"
+	// MachineDeploymentsTimeoutFlag is the name of the command line flag to specify the maximum time to wait
+	// until all machine deployments are available.
+	MachineDeploymentsTimeoutFlag = "machine-deployments-timeout"
+	// MachineResourcesDeletionTimeoutFlag is the name of the command line flag to specify the maximum time to wait
+	// until all machine resources are deleted.
+	MachineResourcesDeletionTimeoutFlag = "machine-resources-deletion-timeout"
+	// MinReadySecondsFlag is the name of the command line flag to specify the minimum number of seconds a new
+	// machine must be ready before it is considered available.
+	MinReadySecondsFlag = "min-ready-seconds"
 )
 
 // Options are command line options that can be set for controller.Options.
 type Options struct {
 	// DeployCRDs defines whether to ignore the operation annotation or not.
 	DeployCRDs bool
+	// MachineDeploymentsTimeout is the maximum time to wait until all machine deployments are available.
+	// If unset, DefaultMachineDeploymentsTimeout is used as flag default.
+	MachineDeploymentsTimeout time.Duration
+	// MachineResourcesDeletionTimeout is the maximum time to wait until all machine resources are deleted.
+	// If unset, DefaultMachineResourcesDeletionTimeout is used as flag default.
+	MachineResourcesDeletionTimeout time.Duration
+	// MinReadySeconds is the minimum number of seconds a new machine must be ready before it is considered available.
+	// If unset, DefaultMinReadySeconds is used as flag default.
+	MinReadySeconds int32
 
 	config *Config
 }
 
 // AddFlags implements Flagger.AddFlags.
 func (c *Options) AddFlags(fs *pflag.FlagSet) {
+	defaults := DefaultRolloutSettings()
+	if c.MachineDeploymentsTimeout != 0 {
+		defaults.MachineDeploymentsTimeout = c.MachineDeploymentsTimeout
+	}
+	if c.MachineResourcesDeletionTimeout != 0 {
+		defaults.MachineResourcesDeletionTimeout = c.MachineResourcesDeletionTimeout
+	}
+	if c.MinReadySeconds != 0 {
+		defaults.MinReadySeconds = c.MinReadySeconds
+	}
+
 	fs.BoolVar(&c.DeployCRDs, DeployCRDsFlag, c.DeployCRDs, "Deploy the required worker CRDs.")
+	fs.DurationVar(&c.MachineDeploymentsTimeout, MachineDeploymentsTimeoutFlag, defaults.MachineDeploymentsTimeout, "Maximum time to wait until all machine deployments are available.")
+	fs.DurationVar(&c.MachineResourcesDeletionTimeout, MachineResourcesDeletionTimeoutFlag, defaults.MachineResourcesDeletionTimeout, "Maximum time to wait until all machine resources are deleted.")
+	fs.Int32Var(&c.MinReadySeconds, MinReadySecondsFlag, defaults.MinReadySeconds, "Minimum number of seconds a new machine must be ready before it is considered available.")
 }
 
 // Complete implements Completer.Complete.
 func (c *Options) Complete() error {
-	c.config = &Config{c.DeployCRDs}
+	c.config = &Config{
+		DeployCRDs: c.DeployCRDs,
+		RolloutSettings: RolloutSettings{
+			MachineDeploymentsTimeout:       c.MachineDeploymentsTimeout,
+			MachineResourcesDeletionTimeout: c.MachineResourcesDeletionTimeout,
+			MinReadySeconds:                 c.MinReadySeconds,
+		},
+	}
 	return nil
 }
 
@@ -52,9 +93,16 @@ func (c *Options) Completed() *Config {
 type Config struct {
 	// DeployCRDs defines whether to ignore the operation annotation or not.
 	DeployCRDs bool
+	// RolloutSettings are the settings used when rolling out or deleting the machines of a worker.
+	RolloutSettings RolloutSettings
 }
 
 // Apply sets the values of this Config in the given controller.Options.
 func (c *Config) Apply(ignore *bool) {
 	*ignore = c.DeployCRDs
 }
+
+// ApplyRolloutSettings sets the given rollout settings to those of this Config.
+func (c *Config) ApplyRolloutSettings(settings *RolloutSettings) {
+	*settings = c.RolloutSettings
+}
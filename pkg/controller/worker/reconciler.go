@@ -20,6 +20,8 @@ import (
 
 	"github.com/gardener/gardener-extensions/pkg/controller"
 	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
+	controllererror "github.com/gardener/gardener-extensions/pkg/controller/error"
+	"github.com/gardener/gardener-extensions/pkg/controller/metrics"
 	"github.com/gardener/gardener-extensions/pkg/util"
 
 	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
@@ -28,8 +30,10 @@ import (
 
 	"github.com/go-logr/logr"
 
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/client-go/tools/record"
 	"k8s.io/client-go/util/retry"
 
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -40,19 +44,25 @@ import (
 )
 
 type reconciler struct {
-	logger   logr.Logger
-	actuator Actuator
+	logger          logr.Logger
+	actuator        Actuator
+	errorClassifier controllererror.Classifier
 
-	ctx    context.Context
-	client client.Client
+	ctx      context.Context
+	client   client.Client
+	recorder record.EventRecorder
 }
 
 // NewReconciler creates a new reconcile.Reconciler that reconciles
 // Worker resources of Gardener's `extensions.gardener.cloud` API group.
-func NewReconciler(mgr manager.Manager, actuator Actuator) reconcile.Reconciler {
+// The given error classifier determines the error codes of failed operations. If it is nil or does not determine
+// any code, the default classifier is used.
+func NewReconciler(mgr manager.Manager, actuator Actuator, errorClassifier controllererror.Classifier) reconcile.Reconciler {
 	return &reconciler{
-		logger:   log.Log.WithName(ControllerName),
-		actuator: actuator,
+		logger:          log.Log.WithName(ControllerName),
+		actuator:        actuator,
+		errorClassifier: errorClassifier,
+		recorder:        mgr.GetRecorder(ControllerName),
 	}
 }
 
@@ -84,6 +94,8 @@ func (r *reconciler) Reconcile(request reconcile.Request) (reconcile.Result, err
 		return reconcile.Result{}, err
 	}
 
+	ctx := extensionscontroller.WithConditionRecorder(r.ctx, extensionscontroller.NewConditionRecorder())
+
 	// Deletion flow
 	if worker.DeletionTimestamp != nil {
 		hasFinalizer, err := extensionscontroller.HasFinalizer(worker, FinalizerName)
@@ -97,26 +109,31 @@ func (r *reconciler) Reconcile(request reconcile.Request) (reconcile.Result, err
 		}
 
 		operationType := gardencorev1alpha1helper.ComputeOperationType(worker.ObjectMeta, worker.Status.LastOperation)
-		if err := r.updateStatusProcessing(r.ctx, worker, operationType, "Deleting the worker"); err != nil {
+		ctx = extensionscontroller.WithProgressReporter(ctx, r.newProgressReporter(worker, operationType))
+		if err := r.updateStatusProcessing(ctx, worker, operationType, "Deleting the worker"); err != nil {
 			return reconcile.Result{}, err
 		}
 
 		r.logger.Info("Starting the deletion of worker", "worker", fmt.Sprintf("%s/%s", worker.Namespace, worker.Name))
-		if err := r.actuator.Delete(r.ctx, worker, cluster); err != nil {
+		observeOperation := metrics.ObserveOperation(extensionscontroller.UnsafeGuessKind(worker), worker.Spec.Type, operationType)
+		if err := r.actuator.Delete(ctx, worker, cluster); err != nil {
+			observeOperation(extensionscontroller.ReconcileErrCauseOrErr(err), controllererror.Codes(r.errorClassifier, extensionscontroller.ReconcileErrCauseOrErr(err))...)
 			msg := "Error deleting worker"
-			utilruntime.HandleError(r.updateStatusError(r.ctx, extensionscontroller.ReconcileErrCauseOrErr(err), worker, operationType, msg))
+			utilruntime.HandleError(r.updateStatusError(ctx, extensionscontroller.ReconcileErrCauseOrErr(err), worker, operationType, msg))
 			r.logger.Error(err, msg, "worker", fmt.Sprintf("%s/%s", worker.Namespace, worker.Name))
 			return extensionscontroller.ReconcileErr(err)
 		}
 
+		observeOperation(nil)
+
 		msg := "Successfully deleted worker"
 		r.logger.Info(msg, "worker", fmt.Sprintf("%s/%s", worker.Namespace, worker.Name))
-		if err := r.updateStatusSuccess(r.ctx, worker, operationType, msg); err != nil {
+		if err := r.updateStatusSuccess(ctx, worker, operationType, msg); err != nil {
 			return reconcile.Result{}, err
 		}
 
 		r.logger.Info("Removing finalizer.", "worker", fmt.Sprintf("%s/%s", worker.Namespace, worker.Name))
-		if err := extensionscontroller.DeleteFinalizer(r.ctx, r.client, FinalizerName, worker); err != nil {
+		if err := extensionscontroller.DeleteFinalizer(ctx, r.client, FinalizerName, worker); err != nil {
 			r.logger.Error(err, "Error removing finalizer from Worker", "worker", fmt.Sprintf("%s/%s", worker.Namespace, worker.Name))
 			return reconcile.Result{}, err
 		}
@@ -124,35 +141,167 @@ func (r *reconciler) Reconcile(request reconcile.Request) (reconcile.Result, err
 		return reconcile.Result{}, nil
 	}
 
+	// Migration flow
+	if extensionscontroller.IsMigrateOperation(worker) {
+		operationType := extensionscontroller.LastOperationTypeMigrate
+		ctx = extensionscontroller.WithProgressReporter(ctx, r.newProgressReporter(worker, operationType))
+		if err := r.updateStatusProcessing(ctx, worker, operationType, "Migrating the worker"); err != nil {
+			return reconcile.Result{}, err
+		}
+
+		r.logger.Info("Starting the migration of worker", "worker", fmt.Sprintf("%s/%s", worker.Namespace, worker.Name))
+		observeOperation := metrics.ObserveOperation(extensionscontroller.UnsafeGuessKind(worker), worker.Spec.Type, operationType)
+		state, err := r.exportState(ctx, worker, cluster)
+		if err != nil {
+			observeOperation(extensionscontroller.ReconcileErrCauseOrErr(err), controllererror.Codes(r.errorClassifier, extensionscontroller.ReconcileErrCauseOrErr(err))...)
+			msg := "Error migrating worker"
+			utilruntime.HandleError(r.updateStatusError(ctx, extensionscontroller.ReconcileErrCauseOrErr(err), worker, operationType, msg))
+			r.logger.Error(err, msg, "worker", fmt.Sprintf("%s/%s", worker.Namespace, worker.Name))
+			return extensionscontroller.ReconcileErr(err)
+		}
+
+		observeOperation(nil)
+
+		msg := "Successfully migrated worker"
+		r.logger.Info(msg, "worker", fmt.Sprintf("%s/%s", worker.Namespace, worker.Name))
+		if err := r.updateStatusMigrated(ctx, worker, state, msg); err != nil {
+			return reconcile.Result{}, err
+		}
+
+		// The finalizer is removed so that deleting the migrated worker does not delete the machines.
+		r.logger.Info("Removing finalizer.", "worker", fmt.Sprintf("%s/%s", worker.Namespace, worker.Name))
+		if err := extensionscontroller.DeleteFinalizer(ctx, r.client, FinalizerName, worker); err != nil {
+			r.logger.Error(err, "Error removing finalizer from Worker", "worker", fmt.Sprintf("%s/%s", worker.Namespace, worker.Name))
+			return reconcile.Result{}, err
+		}
+
+		return reconcile.Result{}, extensionscontroller.RemoveMigrationOperationAnnotation(ctx, r.client, worker)
+	}
+
+	restore := extensionscontroller.IsRestoreOperation(worker)
+	if !restore && extensionscontroller.IsMigrated(&worker.Status.DefaultStatus) {
+		r.logger.Info("Skipping the reconciliation of migrated worker", "worker", fmt.Sprintf("%s/%s", worker.Namespace, worker.Name))
+		return reconcile.Result{}, nil
+	}
+
+	// Pause flow
+	paused, err := extensionscontroller.ReconciliationPaused(ctx, r.client, worker)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+	extensionscontroller.SetReconciliationPausedCondition(ctx, worker.Status.Conditions, paused)
+	if paused {
+		msg := "Reconciliation of the worker is paused"
+		r.logger.Info(msg, "worker", fmt.Sprintf("%s/%s", worker.Namespace, worker.Name))
+		r.recorder.Event(worker, corev1.EventTypeNormal, extensionscontroller.EventReconciliationPaused, msg)
+		return reconcile.Result{}, r.updateStatusConditions(ctx, worker)
+	}
+
 	// Reconcile flow
-	if err := controller.EnsureFinalizer(r.ctx, r.client, FinalizerName, worker); err != nil {
+	if err := controller.EnsureFinalizer(ctx, r.client, FinalizerName, worker); err != nil {
 		return reconcile.Result{}, err
 	}
 
 	operationType := gardencorev1alpha1helper.ComputeOperationType(worker.ObjectMeta, worker.Status.LastOperation)
-	if err := r.updateStatusProcessing(r.ctx, worker, operationType, "Reconciling the worker"); err != nil {
+	description := "Reconciling the worker"
+	if restore {
+		operationType = extensionscontroller.LastOperationTypeRestore
+		description = "Restoring the worker"
+	}
+	ctx = extensionscontroller.WithProgressReporter(ctx, r.newProgressReporter(worker, operationType))
+	if err := r.updateStatusProcessing(ctx, worker, operationType, description); err != nil {
 		return reconcile.Result{}, err
 	}
 
-	if err := r.actuator.Reconcile(r.ctx, worker, cluster); err != nil {
+	observeOperation := metrics.ObserveOperation(extensionscontroller.UnsafeGuessKind(worker), worker.Spec.Type, operationType)
+	if restore {
+		if err := r.restoreState(ctx, worker, cluster); err != nil {
+			observeOperation(extensionscontroller.ReconcileErrCauseOrErr(err), controllererror.Codes(r.errorClassifier, extensionscontroller.ReconcileErrCauseOrErr(err))...)
+			msg := "Error restoring worker"
+			utilruntime.HandleError(r.updateStatusError(ctx, extensionscontroller.ReconcileErrCauseOrErr(err), worker, operationType, msg))
+			r.logger.Error(err, msg, "worker", fmt.Sprintf("%s/%s", worker.Namespace, worker.Name))
+			return extensionscontroller.ReconcileErr(err)
+		}
+	}
+	if err := r.actuator.Reconcile(ctx, worker, cluster); err != nil {
+		observeOperation(extensionscontroller.ReconcileErrCauseOrErr(err), controllererror.Codes(r.errorClassifier, extensionscontroller.ReconcileErrCauseOrErr(err))...)
 		msg := "Error reconciling worker"
-		utilruntime.HandleError(r.updateStatusError(r.ctx, extensionscontroller.ReconcileErrCauseOrErr(err), worker, operationType, msg))
+		utilruntime.HandleError(r.updateStatusError(ctx, extensionscontroller.ReconcileErrCauseOrErr(err), worker, operationType, msg))
 		r.logger.Error(err, msg, "worker", fmt.Sprintf("%s/%s", worker.Namespace, worker.Name))
+		r.recordMachineImageDeprecation(worker)
 		return extensionscontroller.ReconcileErr(err)
 	}
 
+	observeOperation(nil)
+
 	msg := "Successfully reconciled worker"
+	if restore {
+		msg = "Successfully restored worker"
+	}
 	r.logger.Info(msg, "worker", fmt.Sprintf("%s/%s", worker.Namespace, worker.Name))
-	if err := r.updateStatusSuccess(r.ctx, worker, operationType, msg); err != nil {
+	if err := r.updateStatusSuccess(ctx, worker, operationType, msg); err != nil {
 		return reconcile.Result{}, err
 	}
+	r.recordMachineImageDeprecation(worker)
 
-	return reconcile.Result{}, nil
+	return reconcile.Result{}, extensionscontroller.RemoveMigrationOperationAnnotation(ctx, r.client, worker)
+}
+
+// recordMachineImageDeprecation records a warning event if the MachineImagesUpToDate condition of the given worker
+// reports deprecated or expired machine images.
+func (r *reconciler) recordMachineImageDeprecation(worker *extensionsv1alpha1.Worker) {
+	condition := gardencorev1alpha1helper.GetCondition(worker.Status.Conditions, ConditionTypeMachineImagesUpToDate)
+	if condition == nil || condition.Status != gardencorev1alpha1.ConditionFalse {
+		return
+	}
+
+	msg := fmt.Sprintf("Worker pools use deprecated machine images: %s", condition.Message)
+	r.logger.Info(msg, "worker", fmt.Sprintf("%s/%s", worker.Namespace, worker.Name))
+	r.recorder.Event(worker, corev1.EventTypeWarning, EventMachineImageDeprecation, msg)
+}
+
+func (r *reconciler) exportState(ctx context.Context, worker *extensionsv1alpha1.Worker, cluster *extensionscontroller.Cluster) (string, error) {
+	migrator, ok := r.actuator.(Migrator)
+	if !ok {
+		return "", fmt.Errorf("actuator for worker type %q does not support migration", worker.Spec.Type)
+	}
+
+	return migrator.Migrate(ctx, worker, cluster)
+}
+
+func (r *reconciler) restoreState(ctx context.Context, worker *extensionsv1alpha1.Worker, cluster *extensionscontroller.Cluster) error {
+	migrator, ok := r.actuator.(Migrator)
+	if !ok {
+		return fmt.Errorf("actuator for worker type %q does not support migration", worker.Spec.Type)
+	}
+
+	return migrator.Restore(ctx, worker, cluster, worker.Status.State)
 }
 
 func (r *reconciler) updateStatusProcessing(ctx context.Context, worker *extensionsv1alpha1.Worker, lastOperationType gardencorev1alpha1.LastOperationType, description string) error {
 	return extensionscontroller.TryUpdateStatus(ctx, retry.DefaultBackoff, r.client, worker, func() error {
-		worker.Status.LastOperation = extensionscontroller.LastOperation(lastOperationType, gardencorev1alpha1.LastOperationStateProcessing, 1, description)
+		worker.Status.LastOperation = extensionscontroller.LastOperation(lastOperationType, gardencorev1alpha1.LastOperationStateProcessing, extensionscontroller.LastReportedProgress(ctx, 1), description)
+		return nil
+	})
+}
+
+func (r *reconciler) newProgressReporter(worker *extensionsv1alpha1.Worker, lastOperationType gardencorev1alpha1.LastOperationType) extensionscontroller.ProgressReporter {
+	return extensionscontroller.NewThrottledProgressReporter(extensionscontroller.DefaultProgressReportInterval, extensionscontroller.InitialProgress(worker.Status.LastOperation, lastOperationType), func(ctx context.Context, progress int, description string) error {
+		return r.updateStatusProgress(ctx, worker, lastOperationType, progress, description)
+	})
+}
+
+func (r *reconciler) updateStatusProgress(ctx context.Context, worker *extensionsv1alpha1.Worker, lastOperationType gardencorev1alpha1.LastOperationType, progress int, description string) error {
+	return extensionscontroller.TryUpdateStatus(ctx, retry.DefaultBackoff, r.client, worker, func() error {
+		worker.Status.Conditions = extensionscontroller.MergeRecordedConditions(ctx, worker.Status.Conditions)
+		worker.Status.LastOperation = extensionscontroller.LastOperation(lastOperationType, gardencorev1alpha1.LastOperationStateProcessing, progress, description)
+		return nil
+	})
+}
+
+func (r *reconciler) updateStatusConditions(ctx context.Context, worker *extensionsv1alpha1.Worker) error {
+	return extensionscontroller.TryUpdateStatus(ctx, retry.DefaultBackoff, r.client, worker, func() error {
+		worker.Status.Conditions = extensionscontroller.MergeRecordedConditions(ctx, worker.Status.Conditions)
 		return nil
 	})
 }
@@ -160,7 +309,17 @@ func (r *reconciler) updateStatusProcessing(ctx context.Context, worker *extensi
 func (r *reconciler) updateStatusError(ctx context.Context, err error, worker *extensionsv1alpha1.Worker, lastOperationType gardencorev1alpha1.LastOperationType, description string) error {
 	return extensionscontroller.TryUpdateStatus(ctx, retry.DefaultBackoff, r.client, worker, func() error {
 		worker.Status.ObservedGeneration = worker.Generation
-		worker.Status.LastOperation, worker.Status.LastError = extensionscontroller.ReconcileError(lastOperationType, gardencorev1alpha1helper.FormatLastErrDescription(fmt.Errorf("%s: %v", description, err)), 50, gardencorev1alpha1helper.ExtractErrorCodes(err)...)
+		worker.Status.Conditions = extensionscontroller.MergeRecordedConditions(ctx, worker.Status.Conditions)
+		worker.Status.LastOperation, worker.Status.LastError = extensionscontroller.ReconcileError(lastOperationType, gardencorev1alpha1helper.FormatLastErrDescription(fmt.Errorf("%s: %v", description, err)), extensionscontroller.LastReportedProgress(ctx, 1), controllererror.Codes(r.errorClassifier, err)...)
+		return nil
+	})
+}
+
+func (r *reconciler) updateStatusMigrated(ctx context.Context, worker *extensionsv1alpha1.Worker, state, description string) error {
+	return extensionscontroller.TryUpdateStatus(ctx, retry.DefaultBackoff, r.client, worker, func() error {
+		worker.Status.State = state
+		worker.Status.Conditions = extensionscontroller.MergeRecordedConditions(ctx, worker.Status.Conditions)
+		worker.Status.LastOperation, worker.Status.LastError = extensionscontroller.ReconcileSucceeded(extensionscontroller.LastOperationTypeMigrate, description)
 		return nil
 	})
 }
@@ -168,6 +327,7 @@ func (r *reconciler) updateStatusError(ctx context.Context, err error, worker *e
 func (r *reconciler) updateStatusSuccess(ctx context.Context, worker *extensionsv1alpha1.Worker, lastOperationType gardencorev1alpha1.LastOperationType, description string) error {
 	return extensionscontroller.TryUpdateStatus(ctx, retry.DefaultBackoff, r.client, worker, func() error {
 		worker.Status.ObservedGeneration = worker.Generation
+		worker.Status.Conditions = extensionscontroller.MergeRecordedConditions(ctx, worker.Status.Conditions)
 		worker.Status.LastOperation, worker.Status.LastError = extensionscontroller.ReconcileSucceeded(lastOperationType, description)
 		return nil
 	})
@@ -0,0 +1,103 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package worker
+
+import (
+	"time"
+
+	apisworker "github.com/gardener/gardener-extensions/pkg/apis/worker"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// DefaultMachineDeploymentsTimeout is the default maximum time to wait until all machine deployments are available.
+	DefaultMachineDeploymentsTimeout = 30 * time.Minute
+	// DefaultMachineResourcesDeletionTimeout is the default maximum time to wait until all machine resources are deleted.
+	DefaultMachineResourcesDeletionTimeout = 30 * time.Minute
+	// DefaultMinReadySeconds is the default minimum number of seconds a new machine must be ready before it is
+	// considered available.
+	DefaultMinReadySeconds int32 = 500
+)
+
+// RolloutSettings contains the settings used when rolling out or deleting the machines of a worker.
+type RolloutSettings struct {
+	// MachineDeploymentsTimeout is the maximum time to wait until all machine deployments are available.
+	MachineDeploymentsTimeout time.Duration
+	// MachineResourcesDeletionTimeout is the maximum time to wait until all machine resources are deleted.
+	MachineResourcesDeletionTimeout time.Duration
+	// MinReadySeconds is the minimum number of seconds a new machine must be ready before it is considered available.
+	MinReadySeconds int32
+}
+
+// DefaultRolloutSettings returns the default RolloutSettings.
+func DefaultRolloutSettings() RolloutSettings {
+	return RolloutSettings{
+		MachineDeploymentsTimeout:       DefaultMachineDeploymentsTimeout,
+		MachineResourcesDeletionTimeout: DefaultMachineResourcesDeletionTimeout,
+		MinReadySeconds:                 DefaultMinReadySeconds,
+	}
+}
+
+// PoolRolloutSettings contains the rollout settings that can be overridden per worker pool in its WorkerConfig.
+type PoolRolloutSettings struct {
+	// MachineDeploymentsTimeout is the maximum time to wait until the machine deployments of the pool are available.
+	MachineDeploymentsTimeout *metav1.Duration
+	// MachineResourcesDeletionTimeout is the maximum time to wait until the machine resources of the pool are deleted.
+	MachineResourcesDeletionTimeout *metav1.Duration
+	// MinReadySeconds is the minimum number of seconds a new machine of the pool must be ready before it is
+	// considered available.
+	MinReadySeconds *int32
+}
+
+// PoolRolloutSettingsFromConfig returns the PoolRolloutSettings of the given WorkerConfig of a pool. Settings that
+// are not set in the WorkerConfig are not set.
+func PoolRolloutSettingsFromConfig(config *apisworker.WorkerConfig) *PoolRolloutSettings {
+	settings := &PoolRolloutSettings{}
+	if config == nil {
+		return settings
+	}
+
+	settings.MachineDeploymentsTimeout = config.MachineDeploymentsTimeout
+	settings.MachineResourcesDeletionTimeout = config.MachineResourcesDeletionTimeout
+	settings.MinReadySeconds = config.MinReadySeconds
+	return settings
+}
+
+// GetMinReadySeconds returns the MinReadySeconds of the pool settings, or the given default if not set.
+func (s *PoolRolloutSettings) GetMinReadySeconds(defaultMinReadySeconds int32) int32 {
+	if s != nil && s.MinReadySeconds != nil {
+		return *s.MinReadySeconds
+	}
+	return defaultMinReadySeconds
+}
+
+// GetMachineDeploymentsTimeout returns the MachineDeploymentsTimeout of the pool settings, or the given
+// default if not set.
+func (s *PoolRolloutSettings) GetMachineDeploymentsTimeout(defaultTimeout time.Duration) time.Duration {
+	if s != nil && s.MachineDeploymentsTimeout != nil {
+		return s.MachineDeploymentsTimeout.Duration
+	}
+	return defaultTimeout
+}
+
+// GetMachineResourcesDeletionTimeout returns the MachineResourcesDeletionTimeout of the pool settings, or the given
+// default if not set.
+func (s *PoolRolloutSettings) GetMachineResourcesDeletionTimeout(defaultTimeout time.Duration) time.Duration {
+	if s != nil && s.MachineResourcesDeletionTimeout != nil {
+		return s.MachineResourcesDeletionTimeout.Duration
+	}
+	return defaultTimeout
+}
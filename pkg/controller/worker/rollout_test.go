@@ -0,0 +1,76 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package worker_test
+
+import (
+	"time"
+
+	apisworker "github.com/gardener/gardener-extensions/pkg/apis/worker"
+	"github.com/gardener/gardener-extensions/pkg/controller/worker"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+)
+
+var _ = Describe("Rollout", func() {
+	Describe("#PoolRolloutSettingsFromConfig", func() {
+		It("should return empty settings if the config does not contain any", func() {
+			settings := worker.PoolRolloutSettingsFromConfig(nil)
+
+			Expect(settings).To(Equal(&worker.PoolRolloutSettings{}))
+			Expect(settings.GetMinReadySeconds(500)).To(Equal(int32(500)))
+			Expect(settings.GetMachineDeploymentsTimeout(time.Minute)).To(Equal(time.Minute))
+			Expect(settings.GetMachineResourcesDeletionTimeout(time.Minute)).To(Equal(time.Minute))
+		})
+
+		It("should return the settings of the config", func() {
+			settings := worker.PoolRolloutSettingsFromConfig(&apisworker.WorkerConfig{
+				MinReadySeconds:                 pointer.Int32Ptr(60),
+				MachineDeploymentsTimeout:       &metav1.Duration{Duration: time.Hour},
+				MachineResourcesDeletionTimeout: &metav1.Duration{Duration: 2 * time.Hour},
+			})
+
+			Expect(settings.GetMinReadySeconds(500)).To(Equal(int32(60)))
+			Expect(settings.GetMachineDeploymentsTimeout(time.Minute)).To(Equal(time.Hour))
+			Expect(settings.GetMachineResourcesDeletionTimeout(time.Minute)).To(Equal(2 * time.Hour))
+		})
+	})
+
+	Describe("#GetMachineDeploymentsTimeout", func() {
+		It("should return the default for nil settings", func() {
+			var settings *worker.PoolRolloutSettings
+			Expect(settings.GetMachineDeploymentsTimeout(time.Minute)).To(Equal(time.Minute))
+		})
+
+		It("should return the overridden timeout", func() {
+			settings := &worker.PoolRolloutSettings{MachineDeploymentsTimeout: &metav1.Duration{Duration: time.Hour}}
+			Expect(settings.GetMachineDeploymentsTimeout(time.Minute)).To(Equal(time.Hour))
+		})
+	})
+
+	Describe("#GetMachineResourcesDeletionTimeout", func() {
+		It("should return the default for nil settings", func() {
+			var settings *worker.PoolRolloutSettings
+			Expect(settings.GetMachineResourcesDeletionTimeout(time.Minute)).To(Equal(time.Minute))
+		})
+
+		It("should return the overridden timeout", func() {
+			settings := &worker.PoolRolloutSettings{MachineResourcesDeletionTimeout: &metav1.Duration{Duration: time.Hour}}
+			Expect(settings.GetMachineResourcesDeletionTimeout(time.Minute)).To(Equal(time.Hour))
+		})
+	})
+})
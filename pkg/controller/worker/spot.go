@@ -0,0 +1,82 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package worker
+
+import (
+	apisworker "github.com/gardener/gardener-extensions/pkg/apis/worker"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+const (
+	// LabelSpot is the label of nodes that run on interruptible capacity, i.e. spot, preemptible or low-priority
+	// instances.
+	LabelSpot = "worker.extensions.gardener.cloud/spot"
+	// TaintSpot is the key of the taint of nodes that run on interruptible capacity. Only workloads that tolerate
+	// it are scheduled onto these nodes.
+	TaintSpot = "worker.extensions.gardener.cloud/spot"
+)
+
+// SpotSettings contains the settings for worker pools whose machines run on interruptible capacity, i.e. spot,
+// preemptible or low-priority instances.
+type SpotSettings struct {
+	// MaxPrice is the maximum price that is paid for an instance. Its format and whether it is supported depend on
+	// the provider.
+	MaxPrice *string
+}
+
+// SpotSettingsFromConfig returns the SpotSettings of the given WorkerConfig of a pool. If the pool does not request
+// interruptible capacity, nil is returned.
+func SpotSettingsFromConfig(config *apisworker.WorkerConfig) *SpotSettings {
+	if config == nil || config.Spot == nil {
+		return nil
+	}
+	return &SpotSettings{MaxPrice: config.Spot.MaxPrice}
+}
+
+// SpotLabels returns the given labels amended by the spot label if the spot settings are set. The given labels are
+// not modified.
+func (s *SpotSettings) SpotLabels(labels map[string]string) map[string]string {
+	if s == nil {
+		return labels
+	}
+
+	out := make(map[string]string, len(labels)+1)
+	for key, value := range labels {
+		out[key] = value
+	}
+	out[LabelSpot] = "true"
+	return out
+}
+
+// SpotTaints returns the given taints amended by the spot taint if the spot settings are set. The given taints are
+// not modified.
+func (s *SpotSettings) SpotTaints(taints []corev1.Taint) []corev1.Taint {
+	if s == nil {
+		return taints
+	}
+
+	out := make([]corev1.Taint, 0, len(taints)+1)
+	for _, taint := range taints {
+		if taint.Key != TaintSpot {
+			out = append(out, taint)
+		}
+	}
+	return append(out, corev1.Taint{
+		Key:    TaintSpot,
+		Value:  "true",
+		Effect: corev1.TaintEffectNoSchedule,
+	})
+}
@@ -0,0 +1,73 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package worker_test
+
+import (
+	apisworker "github.com/gardener/gardener-extensions/pkg/apis/worker"
+	"github.com/gardener/gardener-extensions/pkg/controller/worker"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/pointer"
+)
+
+var _ = Describe("SpotSettings", func() {
+	spotTaint := corev1.Taint{Key: worker.TaintSpot, Value: "true", Effect: corev1.TaintEffectNoSchedule}
+
+	Describe("#SpotSettingsFromConfig", func() {
+		It("should return nil if the config does not request spot instances", func() {
+			Expect(worker.SpotSettingsFromConfig(nil)).To(BeNil())
+			Expect(worker.SpotSettingsFromConfig(&apisworker.WorkerConfig{})).To(BeNil())
+		})
+
+		It("should return the settings of the config", func() {
+			settings := worker.SpotSettingsFromConfig(&apisworker.WorkerConfig{
+				Spot: &apisworker.SpotSettings{MaxPrice: pointer.StringPtr("0.5")},
+			})
+
+			Expect(settings).To(Equal(&worker.SpotSettings{MaxPrice: pointer.StringPtr("0.5")}))
+		})
+	})
+
+	Describe("#SpotLabels, #SpotTaints", func() {
+		var (
+			labels = map[string]string{"foo": "bar"}
+			taints = []corev1.Taint{{Key: "foo", Value: "bar", Effect: corev1.TaintEffectNoExecute}}
+		)
+
+		It("should return the given labels and taints if spot instances are not requested", func() {
+			var settings *worker.SpotSettings
+
+			Expect(settings.SpotLabels(labels)).To(Equal(labels))
+			Expect(settings.SpotTaints(taints)).To(Equal(taints))
+		})
+
+		It("should add the spot label and taint without modifying the given ones", func() {
+			settings := &worker.SpotSettings{}
+
+			Expect(settings.SpotLabels(labels)).To(Equal(map[string]string{"foo": "bar", worker.LabelSpot: "true"}))
+			Expect(settings.SpotTaints(taints)).To(Equal(append([]corev1.Taint{taints[0]}, spotTaint)))
+			Expect(labels).To(HaveLen(1))
+			Expect(taints).To(HaveLen(1))
+		})
+
+		It("should not add the spot taint twice", func() {
+			settings := &worker.SpotSettings{}
+
+			Expect(settings.SpotTaints([]corev1.Taint{spotTaint})).To(Equal([]corev1.Taint{spotTaint}))
+		})
+	})
+})
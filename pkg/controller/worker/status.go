@@ -0,0 +1,206 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package worker
+
+import (
+	"fmt"
+	"strings"
+
+	apisworker "github.com/gardener/gardener-extensions/pkg/apis/worker"
+
+	"github.com/gardener/gardener/pkg/utils/kubernetes/health"
+	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+)
+
+// MachineDeploymentPhase is the rollout phase of a machine deployment.
+type MachineDeploymentPhase string
+
+const (
+	// MachineDeploymentPhasePending indicates that the machine deployment does not exist yet.
+	MachineDeploymentPhasePending MachineDeploymentPhase = "Pending"
+	// MachineDeploymentPhaseRollingOut indicates that machines of the machine deployment are still being
+	// created or updated.
+	MachineDeploymentPhaseRollingOut MachineDeploymentPhase = "RollingOut"
+	// MachineDeploymentPhaseAvailable indicates that all desired machines of the machine deployment are
+	// up-to-date and available.
+	MachineDeploymentPhaseAvailable MachineDeploymentPhase = "Available"
+	// MachineDeploymentPhaseFailed indicates that machines of the machine deployment have failed.
+	MachineDeploymentPhaseFailed MachineDeploymentPhase = "Failed"
+)
+
+// FailedMachine is a machine the machine-controller-manager reported as failed.
+type FailedMachine struct {
+	// Name is the name of the machine.
+	Name string
+	// LastOperation is the last operation performed on the machine.
+	LastOperation machinev1alpha1.LastOperation
+}
+
+// MachineDeploymentStatus is the rollout status of a machine deployment.
+type MachineDeploymentStatus struct {
+	// Name is the name of the machine deployment.
+	Name string
+	// PoolName is the name of the worker pool the machine deployment belongs to.
+	PoolName string
+	// Zone is the zone of the worker pool the machine deployment belongs to, if any.
+	Zone string
+	// ClassName is the name of the machine class used by the machine deployment.
+	ClassName string
+	// MachineImage is the machine image used by the machine deployment, if it is reported.
+	MachineImage *MachineImage
+	// Phase is the rollout phase of the machine deployment.
+	Phase MachineDeploymentPhase
+	// DesiredReplicas is the number of desired machines.
+	DesiredReplicas int32
+	// UpdatedReplicas is the number of machines using the current machine class.
+	UpdatedReplicas int32
+	// ReadyReplicas is the number of ready machines.
+	ReadyReplicas int32
+	// AvailableReplicas is the number of available machines.
+	AvailableReplicas int32
+	// UnavailableReplicas is the number of machines that are not yet available.
+	UnavailableReplicas int32
+	// FailedMachines are the machines that have failed.
+	FailedMachines []FailedMachine
+}
+
+// NewMachineDeploymentStatus computes the rollout status of the given wanted machine deployment from the existing
+// machine deployment. The existing machine deployment may be nil if it has not been created yet.
+func NewMachineDeploymentStatus(wanted MachineDeployment, existing *machinev1alpha1.MachineDeployment) MachineDeploymentStatus {
+	status := MachineDeploymentStatus{
+		Name:         wanted.Name,
+		PoolName:     wanted.PoolName,
+		Zone:         wanted.Zone,
+		ClassName:    wanted.ClassName,
+		MachineImage: wanted.MachineImage,
+		Phase:        MachineDeploymentPhasePending,
+	}
+	if existing == nil {
+		return status
+	}
+
+	status.ClassName = existing.Spec.Template.Spec.Class.Name
+	status.DesiredReplicas = existing.Spec.Replicas
+	status.UpdatedReplicas = existing.Status.UpdatedReplicas
+	status.ReadyReplicas = existing.Status.ReadyReplicas
+	status.AvailableReplicas = existing.Status.AvailableReplicas
+	status.UnavailableReplicas = existing.Status.UnavailableReplicas
+
+	for _, failedMachine := range existing.Status.FailedMachines {
+		if failedMachine == nil {
+			continue
+		}
+		status.FailedMachines = append(status.FailedMachines, FailedMachine{
+			Name:          failedMachine.Name,
+			LastOperation: failedMachine.LastOperation,
+		})
+	}
+
+	switch {
+	case len(status.FailedMachines) > 0:
+		status.Phase = MachineDeploymentPhaseFailed
+	case status.UpdatedReplicas >= status.DesiredReplicas && health.CheckMachineDeployment(existing) == nil:
+		status.Phase = MachineDeploymentPhaseAvailable
+	default:
+		status.Phase = MachineDeploymentPhaseRollingOut
+	}
+
+	return status
+}
+
+// IsAvailable returns true if the machine deployment is available.
+func (s MachineDeploymentStatus) IsAvailable() bool {
+	return s.Phase == MachineDeploymentPhaseAvailable
+}
+
+// LastFailure returns the most recent failure of the failed machines, or nil if no machine has failed.
+func (s MachineDeploymentStatus) LastFailure() *apisworker.MachineFailure {
+	var lastFailure *apisworker.MachineFailure
+	for _, failedMachine := range s.FailedMachines {
+		if lastFailure != nil && !lastFailure.LastUpdateTime.Before(&failedMachine.LastOperation.LastUpdateTime) {
+			continue
+		}
+		lastFailure = &apisworker.MachineFailure{
+			MachineName:    failedMachine.Name,
+			Operation:      string(failedMachine.LastOperation.Type),
+			Description:    failedMachine.LastOperation.Description,
+			LastUpdateTime: failedMachine.LastOperation.LastUpdateTime,
+		}
+	}
+	return lastFailure
+}
+
+// String returns a human-readable summary of the machine deployment status.
+func (s MachineDeploymentStatus) String() string {
+	summary := fmt.Sprintf("%s (pool %s, class %s): %s, %d/%d updated, %d ready, %d available",
+		s.Name, s.PoolName, s.ClassName, s.Phase, s.UpdatedReplicas, s.DesiredReplicas, s.ReadyReplicas, s.AvailableReplicas)
+
+	if len(s.FailedMachines) > 0 {
+		failedMachines := make([]string, 0, len(s.FailedMachines))
+		for _, failedMachine := range s.FailedMachines {
+			failedMachines = append(failedMachines, fmt.Sprintf("%s (%s %s: %s)", failedMachine.Name,
+				failedMachine.LastOperation.Type, failedMachine.LastOperation.State, failedMachine.LastOperation.Description))
+		}
+		summary += ", failed machines: " + strings.Join(failedMachines, ", ")
+	}
+
+	return summary
+}
+
+// MachineDeploymentStatuses is a list of machine deployment statuses.
+type MachineDeploymentStatuses []MachineDeploymentStatus
+
+// NotAvailable returns the statuses of all machine deployments that are not available.
+func (s MachineDeploymentStatuses) NotAvailable() MachineDeploymentStatuses {
+	var out MachineDeploymentStatuses
+	for _, status := range s {
+		if !status.IsAvailable() {
+			out = append(out, status)
+		}
+	}
+	return out
+}
+
+// String returns a human-readable summary of all machine deployment statuses, one per line.
+func (s MachineDeploymentStatuses) String() string {
+	summaries := make([]string, 0, len(s))
+	for _, status := range s {
+		summaries = append(summaries, status.String())
+	}
+	return strings.Join(summaries, "\n")
+}
+
+// WorkerStatus returns the WorkerStatus that carries the given machine deployment statuses and the machine images
+// used by them.
+func (s MachineDeploymentStatuses) WorkerStatus() *apisworker.WorkerStatus {
+	status := &apisworker.WorkerStatus{}
+	for _, machineDeployment := range s {
+		status.MachineDeployments = append(status.MachineDeployments, apisworker.MachineDeploymentStatus{
+			Name:                machineDeployment.Name,
+			PoolName:            machineDeployment.PoolName,
+			ClassName:           machineDeployment.ClassName,
+			Phase:               string(machineDeployment.Phase),
+			DesiredReplicas:     machineDeployment.DesiredReplicas,
+			UpdatedReplicas:     machineDeployment.UpdatedReplicas,
+			AvailableReplicas:   machineDeployment.AvailableReplicas,
+			UnavailableReplicas: machineDeployment.UnavailableReplicas,
+			LastFailure:         machineDeployment.LastFailure(),
+		})
+		if machineDeployment.MachineImage != nil {
+			status.MachineImages = append(status.MachineImages, machineDeployment.MachineImage.Status(machineDeployment.PoolName, machineDeployment.Zone))
+		}
+	}
+	return status
+}
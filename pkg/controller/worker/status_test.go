@@ -0,0 +1,183 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package worker_test
+
+import (
+	apisworker "github.com/gardener/gardener-extensions/pkg/apis/worker"
+	"github.com/gardener/gardener-extensions/pkg/controller/worker"
+
+	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("Status", func() {
+	var (
+		wanted   worker.MachineDeployment
+		existing *machinev1alpha1.MachineDeployment
+	)
+
+	BeforeEach(func() {
+		wanted = worker.MachineDeployment{Name: "shoot--foo--bar-pool-z1", PoolName: "pool", ClassName: "shoot--foo--bar-pool-z1-abcde"}
+		existing = &machinev1alpha1.MachineDeployment{
+			ObjectMeta: metav1.ObjectMeta{Name: wanted.Name, Generation: 1},
+			Spec: machinev1alpha1.MachineDeploymentSpec{
+				Replicas: 2,
+				Template: machinev1alpha1.MachineTemplateSpec{
+					Spec: machinev1alpha1.MachineSpec{Class: machinev1alpha1.ClassSpec{Name: "shoot--foo--bar-pool-z1-12345"}},
+				},
+			},
+			Status: machinev1alpha1.MachineDeploymentStatus{
+				ObservedGeneration: 1,
+				Replicas:           2,
+				UpdatedReplicas:    1,
+				ReadyReplicas:      2,
+				AvailableReplicas:  1,
+			},
+		}
+	})
+
+	Describe("#NewMachineDeploymentStatus", func() {
+		It("should return a pending status if the machine deployment does not exist", func() {
+			Expect(worker.NewMachineDeploymentStatus(wanted, nil)).To(Equal(worker.MachineDeploymentStatus{
+				Name:      wanted.Name,
+				PoolName:  "pool",
+				ClassName: wanted.ClassName,
+				Phase:     worker.MachineDeploymentPhasePending,
+			}))
+		})
+
+		It("should return a rolling out status if not all machines are updated", func() {
+			Expect(worker.NewMachineDeploymentStatus(wanted, existing)).To(Equal(worker.MachineDeploymentStatus{
+				Name:              wanted.Name,
+				PoolName:          "pool",
+				ClassName:         "shoot--foo--bar-pool-z1-12345",
+				Phase:             worker.MachineDeploymentPhaseRollingOut,
+				DesiredReplicas:   2,
+				UpdatedReplicas:   1,
+				ReadyReplicas:     2,
+				AvailableReplicas: 1,
+			}))
+		})
+
+		It("should return an available status if all machines are updated and available", func() {
+			existing.Status.UpdatedReplicas = 2
+			existing.Status.AvailableReplicas = 2
+			existing.Status.Conditions = []machinev1alpha1.MachineDeploymentCondition{
+				{Type: machinev1alpha1.MachineDeploymentAvailable, Status: machinev1alpha1.ConditionTrue},
+				{Type: machinev1alpha1.MachineDeploymentProgressing, Status: machinev1alpha1.ConditionTrue},
+			}
+
+			status := worker.NewMachineDeploymentStatus(wanted, existing)
+			Expect(status.Phase).To(Equal(worker.MachineDeploymentPhaseAvailable))
+			Expect(status.IsAvailable()).To(BeTrue())
+		})
+
+		It("should return a failed status with the failed machines", func() {
+			lastOperation := machinev1alpha1.LastOperation{Type: machinev1alpha1.MachineOperationCreate, State: machinev1alpha1.MachineStateFailed, Description: "quota exceeded"}
+			existing.Status.FailedMachines = []*machinev1alpha1.MachineSummary{{Name: "machine-1", LastOperation: lastOperation}}
+
+			status := worker.NewMachineDeploymentStatus(wanted, existing)
+			Expect(status.Phase).To(Equal(worker.MachineDeploymentPhaseFailed))
+			Expect(status.FailedMachines).To(Equal([]worker.FailedMachine{{Name: "machine-1", LastOperation: lastOperation}}))
+			Expect(status.String()).To(Equal("shoot--foo--bar-pool-z1 (pool pool, class shoot--foo--bar-pool-z1-12345): Failed, 1/2 updated, 2 ready, 1 available, failed machines: machine-1 (Create Failed: quota exceeded)"))
+		})
+	})
+
+	Describe("#LastFailure", func() {
+		It("should return nil if no machine has failed", func() {
+			Expect(worker.MachineDeploymentStatus{}.LastFailure()).To(BeNil())
+		})
+
+		It("should return the most recent failure", func() {
+			status := worker.MachineDeploymentStatus{
+				FailedMachines: []worker.FailedMachine{
+					{Name: "machine-1", LastOperation: machinev1alpha1.LastOperation{Type: machinev1alpha1.MachineOperationCreate, Description: "quota exceeded", LastUpdateTime: metav1.Unix(20, 0)}},
+					{Name: "machine-2", LastOperation: machinev1alpha1.LastOperation{Type: machinev1alpha1.MachineOperationHealthCheck, Description: "unhealthy", LastUpdateTime: metav1.Unix(30, 0)}},
+					{Name: "machine-3", LastOperation: machinev1alpha1.LastOperation{Type: machinev1alpha1.MachineOperationCreate, Description: "timeout", LastUpdateTime: metav1.Unix(10, 0)}},
+				},
+			}
+
+			Expect(status.LastFailure()).To(Equal(&apisworker.MachineFailure{
+				MachineName:    "machine-2",
+				Operation:      string(machinev1alpha1.MachineOperationHealthCheck),
+				Description:    "unhealthy",
+				LastUpdateTime: metav1.Unix(30, 0),
+			}))
+		})
+	})
+
+	Describe("#WorkerStatus", func() {
+		It("should convert the statuses", func() {
+			existing.Status.UnavailableReplicas = 1
+			lastOperation := machinev1alpha1.LastOperation{Type: machinev1alpha1.MachineOperationCreate, Description: "quota exceeded", LastUpdateTime: metav1.Unix(10, 0)}
+			existing.Status.FailedMachines = []*machinev1alpha1.MachineSummary{{Name: "machine-1", LastOperation: lastOperation}}
+
+			statuses := worker.MachineDeploymentStatuses{worker.NewMachineDeploymentStatus(wanted, existing)}
+
+			Expect(statuses.WorkerStatus()).To(Equal(&apisworker.WorkerStatus{
+				MachineDeployments: []apisworker.MachineDeploymentStatus{{
+					Name:                wanted.Name,
+					PoolName:            "pool",
+					ClassName:           "shoot--foo--bar-pool-z1-12345",
+					Phase:               string(worker.MachineDeploymentPhaseFailed),
+					DesiredReplicas:     2,
+					UpdatedReplicas:     1,
+					AvailableReplicas:   1,
+					UnavailableReplicas: 1,
+					LastFailure: &apisworker.MachineFailure{
+						MachineName:    "machine-1",
+						Operation:      string(machinev1alpha1.MachineOperationCreate),
+						Description:    "quota exceeded",
+						LastUpdateTime: metav1.Unix(10, 0),
+					},
+				}},
+			}))
+		})
+		It("should list the machine image of each pool and zone", func() {
+			expirationDate := metav1.Unix(20, 0)
+			other := worker.MachineDeployment{Name: "shoot--foo--bar-other-z1", PoolName: "other", Zone: "z1"}
+			wanted.Zone = "z1"
+			wanted.MachineImage = &worker.MachineImage{Name: "coreos", Version: "2023.5.0", ID: "ami-1", ExpirationDate: &expirationDate}
+
+			statuses := worker.MachineDeploymentStatuses{
+				worker.NewMachineDeploymentStatus(wanted, existing),
+				worker.NewMachineDeploymentStatus(other, nil),
+			}
+
+			Expect(statuses.WorkerStatus().MachineImages).To(Equal([]apisworker.MachineImageStatus{{
+				PoolName:       "pool",
+				Zone:           "z1",
+				Name:           "coreos",
+				Version:        "2023.5.0",
+				ID:             "ami-1",
+				ExpirationDate: &expirationDate,
+			}}))
+		})
+	})
+
+	Describe("#NotAvailable", func() {
+		It("should only return the statuses of machine deployments that are not available", func() {
+			statuses := worker.MachineDeploymentStatuses{
+				{Name: "a", Phase: worker.MachineDeploymentPhaseAvailable},
+				{Name: "b", Phase: worker.MachineDeploymentPhaseRollingOut},
+				{Name: "c", Phase: worker.MachineDeploymentPhasePending},
+			}
+
+			Expect(statuses.NotAvailable()).To(Equal(worker.MachineDeploymentStatuses{statuses[1], statuses[2]}))
+		})
+	})
+})
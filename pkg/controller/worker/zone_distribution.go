@@ -0,0 +1,266 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package worker
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+
+	apisworker "github.com/gardener/gardener-extensions/pkg/apis/worker"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+// ZoneSettings contains the settings for distributing the machines of a worker pool to one of its zones.
+type ZoneSettings = apisworker.ZoneSettings
+
+// ZoneSettingsFromConfig returns the ZoneSettings of the given WorkerConfig of a pool.
+func ZoneSettingsFromConfig(config *apisworker.WorkerConfig) []ZoneSettings {
+	if config == nil {
+		return nil
+	}
+	return config.ZoneDistribution
+}
+
+// ZoneDistribution is the distribution of the machines of a worker pool over its zones. The minimum and maximum of
+// the pool are distributed proportionally to the weights of the zones, except for zones that override them. Zones
+// without settings have a weight of 1, hence without any settings the machines are spread evenly like with
+// DistributeOverZones and DistributePositiveIntOrPercent.
+type ZoneDistribution struct {
+	weights        []int
+	minimums       []int
+	maximums       []int
+	maxSurge       intstr.IntOrString
+	maxUnavailable intstr.IntOrString
+}
+
+// ZoneDistributionFromPool computes the ZoneDistribution of the given pool based on the ZoneSettings of its
+// WorkerConfig. The zone indices of the distribution correspond to the indices of the pool's zones.
+func ZoneDistributionFromPool(pool extensionsv1alpha1.WorkerPool, config *apisworker.WorkerConfig) (*ZoneDistribution, error) {
+	var (
+		settings         = ZoneSettingsFromConfig(config)
+		zoneLen          = len(pool.Zones)
+		weights          = make([]int, zoneLen)
+		minimumOverrides = make([]*int, zoneLen)
+		maximumOverrides = make([]*int, zoneLen)
+		configured       = make([]bool, zoneLen)
+	)
+	for i := range weights {
+		weights[i] = 1
+	}
+
+	for _, zone := range settings {
+		i := zoneIndex(pool.Zones, zone.Name)
+		if i < 0 {
+			return nil, fmt.Errorf("zone %q of the zone distribution is not a zone of pool %q", zone.Name, pool.Name)
+		}
+		if configured[i] {
+			return nil, fmt.Errorf("duplicate zone %q in the zone distribution of pool %q", zone.Name, pool.Name)
+		}
+		configured[i] = true
+
+		if zone.Weight != nil {
+			if *zone.Weight < 0 {
+				return nil, fmt.Errorf("weight of zone %q in pool %q must not be negative", zone.Name, pool.Name)
+			}
+			weights[i] = int(*zone.Weight)
+		}
+		if (zone.Minimum != nil && *zone.Minimum < 0) || (zone.Maximum != nil && *zone.Maximum < 0) {
+			return nil, fmt.Errorf("minimum and maximum of zone %q in pool %q must not be negative", zone.Name, pool.Name)
+		}
+		minimumOverrides[i] = intPtr(zone.Minimum)
+		maximumOverrides[i] = intPtr(zone.Maximum)
+	}
+
+	if zoneLen > 0 && sum(weights) == 0 {
+		return nil, fmt.Errorf("at least one zone of pool %q must have a positive weight", pool.Name)
+	}
+
+	minimums, err := distributeWithOverrides(pool.Minimum, weights, minimumOverrides)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not distribute minimum of pool %q", pool.Name)
+	}
+	maximums, err := distributeWithOverrides(pool.Maximum, weights, maximumOverrides)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not distribute maximum of pool %q", pool.Name)
+	}
+	for i := range pool.Zones {
+		if minimums[i] > maximums[i] {
+			return nil, fmt.Errorf("minimum %d of zone %q in pool %q exceeds its maximum %d", minimums[i], pool.Zones[i], pool.Name, maximums[i])
+		}
+	}
+
+	return &ZoneDistribution{
+		weights:        weights,
+		minimums:       minimums,
+		maximums:       maximums,
+		maxSurge:       pool.MaxSurge,
+		maxUnavailable: pool.MaxUnavailable,
+	}, nil
+}
+
+// Minimum returns the minimum number of machines in the zone with the given index.
+func (d *ZoneDistribution) Minimum(zoneIndex int) int {
+	return d.minimums[zoneIndex]
+}
+
+// Maximum returns the maximum number of machines in the zone with the given index.
+func (d *ZoneDistribution) Maximum(zoneIndex int) int {
+	return d.maximums[zoneIndex]
+}
+
+// MaxSurge returns the max surge of the zone with the given index. Absolute values are distributed proportionally
+// to the weights of the zones, percentages are adapted to the share of the zone of the pool's maximum. Zones with a
+// weight of 0 do not get a share of an absolute value, hence they get a max surge of 1 if their maximum is
+// overridden, otherwise neither max surge nor max unavailable would allow to roll out their machines.
+func (d *ZoneDistribution) MaxSurge(zoneIndex int) intstr.IntOrString {
+	maxSurge := d.distributeIntOrPercent(zoneIndex, d.maxSurge, d.maximums)
+	if d.weights[zoneIndex] == 0 && d.maximums[zoneIndex] > 0 && maxSurge.Type == intstr.Int && maxSurge.IntVal == 0 {
+		return intstr.FromInt(1)
+	}
+	return maxSurge
+}
+
+// MaxUnavailable returns the max unavailable of the zone with the given index. Absolute values are distributed
+// proportionally to the weights of the zones, percentages are adapted to the share of the zone of the pool's minimum.
+func (d *ZoneDistribution) MaxUnavailable(zoneIndex int) intstr.IntOrString {
+	return d.distributeIntOrPercent(zoneIndex, d.maxUnavailable, d.minimums)
+}
+
+func (d *ZoneDistribution) distributeIntOrPercent(zoneIndex int, intOrPercent intstr.IntOrString, zoneTotals []int) intstr.IntOrString {
+	if intOrPercent.Type == intstr.Int {
+		return intstr.FromInt(distributeWeighted(int(intOrPercent.IntVal), d.weights)[zoneIndex])
+	}
+
+	percent := intOrPercent.StrVal
+	percents, err := strconv.Atoi(percent[:len(percent)-1])
+	if err != nil {
+		panic(fmt.Sprintf("given value %q is not a percent value", percent))
+	}
+
+	if allEqual(zoneTotals) {
+		// Zones are evenly sized, we don't need to adapt the percentage per zone
+		return intOrPercent
+	}
+
+	// Zones are not evenly sized, we need to calculate the ratio of each zone
+	// and modify the percentage depending on that ratio.
+	absoluteTotalRatio := float64(sum(zoneTotals)) / float64(len(zoneTotals))
+	ratio := 100.0 / absoluteTotalRatio * float64(zoneTotals[zoneIndex])
+	// Optimistic rounding up, this will cause an actual max surge / max unavailable percentage to be a bit higher.
+	return intstr.FromString(fmt.Sprintf("%d%%", int(math.Ceil(ratio*float64(percents)/100.0))))
+}
+
+// distributeWithOverrides distributes the given total over the zones with the given weights. Zones with an override
+// get the overridden value, the remainder of the total is distributed over the other zones.
+func distributeWithOverrides(total int, weights []int, overrides []*int) ([]int, error) {
+	var (
+		remaining        = total
+		remainingWeights = make([]int, len(weights))
+	)
+	for i, override := range overrides {
+		if override != nil {
+			remaining -= *override
+		} else {
+			remainingWeights[i] = weights[i]
+		}
+	}
+	if remaining < 0 {
+		remaining = 0
+	}
+	if remaining > 0 && sum(remainingWeights) == 0 {
+		return nil, fmt.Errorf("%d machines remain for zones without a positive weight or with overrides", remaining)
+	}
+
+	values := distributeWeighted(remaining, remainingWeights)
+	for i, override := range overrides {
+		if override != nil {
+			values[i] = *override
+		}
+	}
+	return values, nil
+}
+
+// distributeWeighted distributes the given total over zones proportionally to the given weights using the largest
+// remainder method. Remaining units go to the zones with the largest remainders, the first zones win ties.
+func distributeWeighted(total int, weights []int) []int {
+	var (
+		values      = make([]int, len(weights))
+		remainders  = make([]int, len(weights))
+		totalWeight = sum(weights)
+	)
+	if totalWeight == 0 {
+		return values
+	}
+
+	distributed := 0
+	for i, weight := range weights {
+		values[i] = total * weight / totalWeight
+		remainders[i] = total * weight % totalWeight
+		distributed += values[i]
+	}
+
+	indices := make([]int, 0, len(weights))
+	for i, weight := range weights {
+		if weight > 0 {
+			indices = append(indices, i)
+		}
+	}
+	sort.SliceStable(indices, func(a, b int) bool {
+		return remainders[indices[a]] > remainders[indices[b]]
+	})
+	for i := 0; i < total-distributed; i++ {
+		values[indices[i%len(indices)]]++
+	}
+	return values
+}
+
+func intPtr(value *int32) *int {
+	if value == nil {
+		return nil
+	}
+	out := int(*value)
+	return &out
+}
+
+func zoneIndex(zones []string, name string) int {
+	for i, zone := range zones {
+		if zone == name {
+			return i
+		}
+	}
+	return -1
+}
+
+func sum(values []int) int {
+	var out int
+	for _, value := range values {
+		out += value
+	}
+	return out
+}
+
+func allEqual(values []int) bool {
+	for _, value := range values {
+		if value != values[0] {
+			return false
+		}
+	}
+	return true
+}
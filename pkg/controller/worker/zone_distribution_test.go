@@ -0,0 +1,134 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package worker_test
+
+import (
+	"fmt"
+
+	workerhelper "github.com/gardener/gardener-extensions/pkg/apis/worker/helper"
+	"github.com/gardener/gardener-extensions/pkg/controller/worker"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+var _ = Describe("ZoneDistribution", func() {
+	newPool := func(minimum, maximum int, maxSurge, maxUnavailable intstr.IntOrString, zoneDistribution string) extensionsv1alpha1.WorkerPool {
+		pool := extensionsv1alpha1.WorkerPool{
+			Name:           "pool",
+			Minimum:        minimum,
+			Maximum:        maximum,
+			MaxSurge:       maxSurge,
+			MaxUnavailable: maxUnavailable,
+			Zones:          []string{"a", "b", "c"},
+		}
+		if len(zoneDistribution) > 0 {
+			pool.ProviderConfig = &runtime.RawExtension{Raw: []byte(fmt.Sprintf(`{"apiVersion":"worker.extensions.gardener.cloud/v1alpha1","kind":"WorkerConfig","zoneDistribution":%s}`, zoneDistribution))}
+		}
+		return pool
+	}
+
+	distribute := func(pool extensionsv1alpha1.WorkerPool) (*worker.ZoneDistribution, error) {
+		config, err := workerhelper.WorkerConfigFromPool(pool)
+		Expect(err).NotTo(HaveOccurred())
+		return worker.ZoneDistributionFromPool(pool, config)
+	}
+
+	minimums := func(d *worker.ZoneDistribution) []int {
+		return []int{d.Minimum(0), d.Minimum(1), d.Minimum(2)}
+	}
+	maximums := func(d *worker.ZoneDistribution) []int {
+		return []int{d.Maximum(0), d.Maximum(1), d.Maximum(2)}
+	}
+
+	Describe("#ZoneDistributionFromPool", func() {
+		It("should distribute like DistributeOverZones and DistributePositiveIntOrPercent without settings", func() {
+			for total := 0; total <= 10; total++ {
+				for _, value := range []intstr.IntOrString{intstr.FromInt(1), intstr.FromInt(4), intstr.FromString("25%"), intstr.FromString("100%")} {
+					pool := newPool(total, total+2, value, value, "")
+
+					distribution, err := distribute(pool)
+					Expect(err).NotTo(HaveOccurred())
+
+					for i := range pool.Zones {
+						Expect(distribution.Minimum(i)).To(Equal(worker.DistributeOverZones(i, pool.Minimum, 3)))
+						Expect(distribution.Maximum(i)).To(Equal(worker.DistributeOverZones(i, pool.Maximum, 3)))
+						Expect(distribution.MaxSurge(i)).To(Equal(worker.DistributePositiveIntOrPercent(i, value, 3, pool.Maximum)))
+						Expect(distribution.MaxUnavailable(i)).To(Equal(worker.DistributePositiveIntOrPercent(i, value, 3, pool.Minimum)))
+					}
+				}
+			}
+		})
+
+		It("should distribute proportionally to the weights", func() {
+			distribution, err := distribute(newPool(4, 12, intstr.FromInt(2), intstr.FromString("50%"), `[{"name":"a","weight":2},{"name":"c","weight":0}]`))
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(minimums(distribution)).To(Equal([]int{3, 1, 0}))
+			Expect(maximums(distribution)).To(Equal([]int{8, 4, 0}))
+			Expect(distribution.MaxSurge(0)).To(Equal(intstr.FromInt(1)))
+			Expect(distribution.MaxSurge(1)).To(Equal(intstr.FromInt(1)))
+			Expect(distribution.MaxSurge(2)).To(Equal(intstr.FromInt(0)))
+			Expect(distribution.MaxUnavailable(0)).To(Equal(intstr.FromString("113%")))
+			Expect(distribution.MaxUnavailable(1)).To(Equal(intstr.FromString("38%")))
+			Expect(distribution.MaxUnavailable(2)).To(Equal(intstr.FromString("0%")))
+		})
+
+		It("should honor the overrides and distribute the remainder over the other zones", func() {
+			distribution, err := distribute(newPool(5, 10, intstr.FromInt(1), intstr.FromInt(0), `[{"name":"b","minimum":3,"maximum":4}]`))
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(minimums(distribution)).To(Equal([]int{1, 3, 1}))
+			Expect(maximums(distribution)).To(Equal([]int{3, 4, 3}))
+		})
+
+		It("should allow zones with a weight of 0 and overridden machines to surge", func() {
+			distribution, err := distribute(newPool(3, 6, intstr.FromInt(1), intstr.FromInt(0), `[{"name":"c","weight":0,"minimum":1,"maximum":2}]`))
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(minimums(distribution)).To(Equal([]int{1, 1, 1}))
+			Expect(maximums(distribution)).To(Equal([]int{2, 2, 2}))
+			Expect(distribution.MaxSurge(2)).To(Equal(intstr.FromInt(1)))
+			Expect(distribution.MaxUnavailable(2)).To(Equal(intstr.FromInt(0)))
+		})
+
+		It("should not distribute negative remainders", func() {
+			distribution, err := distribute(newPool(2, 10, intstr.FromInt(1), intstr.FromInt(0), `[{"name":"a","minimum":3}]`))
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(minimums(distribution)).To(Equal([]int{3, 0, 0}))
+		})
+
+		DescribeTable("should fail for invalid zone settings",
+			func(minimum, maximum int, zoneDistribution string) {
+				_, err := distribute(newPool(minimum, maximum, intstr.FromInt(1), intstr.FromInt(0), zoneDistribution))
+
+				Expect(err).To(HaveOccurred())
+			},
+			Entry("unknown zone", 1, 3, `[{"name":"d"}]`),
+			Entry("duplicate zone", 1, 3, `[{"name":"a"},{"name":"a"}]`),
+			Entry("negative weight", 1, 3, `[{"name":"a","weight":-1}]`),
+			Entry("negative minimum", 1, 3, `[{"name":"a","minimum":-1}]`),
+			Entry("no positive weight", 1, 3, `[{"name":"a","weight":0},{"name":"b","weight":0},{"name":"c","weight":0}]`),
+			Entry("remainder without weighted zones", 4, 6, `[{"name":"a","minimum":1,"maximum":2},{"name":"b","weight":0},{"name":"c","weight":0}]`),
+			Entry("minimum exceeds maximum", 1, 3, `[{"name":"a","minimum":2,"maximum":1}]`),
+			Entry("distributed minimum exceeds maximum override", 6, 9, `[{"name":"a","maximum":1}]`),
+		)
+	})
+})
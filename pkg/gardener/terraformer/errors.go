@@ -0,0 +1,63 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package terraformer
+
+import (
+	"strings"
+
+	controllererror "github.com/gardener/gardener-extensions/pkg/controller/error"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+)
+
+const (
+	// executionErrorPrefix is the prefix of the errors of failed Terraform executions.
+	executionErrorPrefix = "Terraform execution job"
+	// issuesMarker separates the issues found in the Terraform logs from the rest of the error message.
+	issuesMarker = "The following issues have been found in the logs:"
+)
+
+// IsExecutionError checks whether the given error was returned by a failed Terraform execution.
+func IsExecutionError(err error) bool {
+	return err != nil && strings.HasPrefix(err.Error(), executionErrorPrefix)
+}
+
+// ReportedIssues returns the issues that have been found in the logs of the failed Terraform execution
+// which returned the given error. It returns an empty string if no issues have been reported.
+func ReportedIssues(err error) string {
+	if !IsExecutionError(err) {
+		return ""
+	}
+
+	message := err.Error()
+	if idx := strings.Index(message, issuesMarker); idx != -1 {
+		return strings.TrimSpace(message[idx+len(issuesMarker):])
+	}
+	return ""
+}
+
+// NewErrorClassifier returns a classifier for errors of failed Terraform executions. It matches the issues
+// reported in the Terraform logs against the given patterns of the cloud provider. Other errors are not classified.
+func NewErrorClassifier(patterns ...controllererror.Pattern) controllererror.Classifier {
+	return controllererror.ClassifierFunc(func(err error) []gardencorev1alpha1.ErrorCode {
+		var codes []gardencorev1alpha1.ErrorCode
+		for _, err := range controllererror.Errors(err) {
+			if issues := ReportedIssues(err); issues != "" {
+				codes = append(codes, controllererror.MatchPatterns(issues, patterns...)...)
+			}
+		}
+		return controllererror.UniqueCodes(codes)
+	})
+}
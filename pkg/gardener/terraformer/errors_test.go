@@ -0,0 +1,57 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package terraformer_test
+
+import (
+	"errors"
+
+	controllererror "github.com/gardener/gardener-extensions/pkg/controller/error"
+	. "github.com/gardener/gardener-extensions/pkg/gardener/terraformer"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	pkgerrors "github.com/pkg/errors"
+)
+
+var _ = Describe("Errors", func() {
+	var (
+		quotaPattern = controllererror.NewPattern(`QuotaExceeded`, gardencorev1alpha1.ErrorInfraQuotaExceeded)
+
+		executionErr = errors.New("Terraform execution job 'foo' could not be completed. The following issues have been found in the logs:\n\n-> Pod 'foo' reported:\n* QuotaExceeded")
+	)
+
+	Describe("#ReportedIssues", func() {
+		It("should return the issues of execution errors", func() {
+			Expect(ReportedIssues(executionErr)).To(Equal("-> Pod 'foo' reported:\n* QuotaExceeded"))
+		})
+
+		It("should return nothing for other errors", func() {
+			Expect(ReportedIssues(errors.New("QuotaExceeded"))).To(BeEmpty())
+		})
+	})
+
+	Describe("#NewErrorClassifier", func() {
+		classifier := NewErrorClassifier(quotaPattern)
+
+		It("should classify the reported issues of wrapped execution errors", func() {
+			Expect(classifier.Classify(pkgerrors.Wrap(executionErr, "failed"))).To(Equal([]gardencorev1alpha1.ErrorCode{gardencorev1alpha1.ErrorInfraQuotaExceeded}))
+		})
+
+		It("should ignore errors that are no execution errors", func() {
+			Expect(classifier.Classify(errors.New("QuotaExceeded"))).To(BeEmpty())
+		})
+	})
+})
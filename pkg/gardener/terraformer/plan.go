@@ -0,0 +1,429 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package terraformer
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	gardencorev1alpha1helper "github.com/gardener/gardener/pkg/apis/core/v1alpha1/helper"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/operation/common"
+	gardenerterraformer "github.com/gardener/gardener/pkg/operation/terraformer"
+	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// PlanPodSuffix is the suffix used for the name of the Pod which plans the Terraform configuration.
+	PlanPodSuffix = ".tf-plan"
+
+	// PlanTimeout is the maximum duration to wait for a Terraform plan to complete.
+	PlanTimeout = 10 * time.Minute
+
+	planPollInterval = 5 * time.Second
+
+	planConfigMountPath = "/tf"
+	planVarsMountPath   = "/tfvars"
+	planStateMountPath  = "/tf-state-in"
+	planProvidersPath   = "/terraform-providers"
+)
+
+// PlanAction is the action Terraform plans for a resource.
+type PlanAction string
+
+const (
+	// PlanActionCreate indicates that a resource will be created.
+	PlanActionCreate PlanAction = "create"
+	// PlanActionUpdate indicates that a resource will be updated in-place.
+	PlanActionUpdate PlanAction = "update"
+	// PlanActionDestroy indicates that a resource will be destroyed.
+	PlanActionDestroy PlanAction = "destroy"
+	// PlanActionReplace indicates that a resource will be destroyed and created again.
+	PlanActionReplace PlanAction = "replace"
+)
+
+// PlannedResource is a resource affected by a Terraform plan.
+type PlannedResource struct {
+	// Address is the Terraform address of the resource.
+	Address string
+	// Action is the planned action for the resource.
+	Action PlanAction
+}
+
+// Plan is the summary of a Terraform plan.
+type Plan struct {
+	// Add is the number of resources Terraform would create.
+	Add int
+	// Change is the number of resources Terraform would update in-place.
+	Change int
+	// Destroy is the number of resources Terraform would destroy.
+	Destroy int
+	// Resources are the resources affected by the plan.
+	Resources []PlannedResource
+}
+
+// HasChanges checks whether applying the plan would change any resource.
+func (p *Plan) HasChanges() bool {
+	return p.Add > 0 || p.Change > 0 || p.Destroy > 0
+}
+
+// String returns a summary of the planned adds, changes and destroys, followed by the affected resources.
+func (p *Plan) String() string {
+	summary := fmt.Sprintf("%d to add, %d to change, %d to destroy", p.Add, p.Change, p.Destroy)
+	if len(p.Resources) == 0 {
+		return summary
+	}
+
+	resources := make([]string, 0, len(p.Resources))
+	for _, r := range p.Resources {
+		resources = append(resources, fmt.Sprintf("%s (%s)", r.Address, r.Action))
+	}
+	return fmt.Sprintf("%s: %s", summary, strings.Join(resources, ", "))
+}
+
+var (
+	planSummaryRegexp   = regexp.MustCompile(`Plan: (\d+) to add, (\d+) to change, (\d+) to destroy`)
+	planNoChangesRegexp = regexp.MustCompile(`No changes\.`)
+	// Terraform >= 0.12 annotates every planned resource with a comment.
+	planResourceCommentRegexp = regexp.MustCompile(`(?m)^\s*# (\S+) (will be created|will be updated in-place|will be destroyed|must be replaced)`)
+	// Terraform < 0.12 prefixes every planned resource with the symbol of its action.
+	planResourceSymbolRegexp = regexp.MustCompile(`(?m)^\s*(-/\+|\+/-|\+|~|-) (\S+)`)
+)
+
+// ParsePlan parses the output of 'terraform plan' into a Plan.
+func ParsePlan(output string) (*Plan, error) {
+	plan := &Plan{}
+
+	if match := planSummaryRegexp.FindStringSubmatch(output); match != nil {
+		plan.Add, _ = strconv.Atoi(match[1])
+		plan.Change, _ = strconv.Atoi(match[2])
+		plan.Destroy, _ = strconv.Atoi(match[3])
+	} else if planNoChangesRegexp.MatchString(output) {
+		return plan, nil
+	} else {
+		return nil, fmt.Errorf("could not find the plan summary in the Terraform output")
+	}
+
+	if matches := planResourceCommentRegexp.FindAllStringSubmatch(output, -1); len(matches) > 0 {
+		for _, match := range matches {
+			plan.Resources = append(plan.Resources, PlannedResource{Address: match[1], Action: planActionForComment(match[2])})
+		}
+		return plan, nil
+	}
+
+	for _, match := range planResourceSymbolRegexp.FindAllStringSubmatch(output, -1) {
+		plan.Resources = append(plan.Resources, PlannedResource{Address: match[2], Action: planActionForSymbol(match[1])})
+	}
+	return plan, nil
+}
+
+func planActionForComment(comment string) PlanAction {
+	switch comment {
+	case "will be created":
+		return PlanActionCreate
+	case "will be updated in-place":
+		return PlanActionUpdate
+	case "will be destroyed":
+		return PlanActionDestroy
+	default:
+		return PlanActionReplace
+	}
+}
+
+func planActionForSymbol(symbol string) PlanAction {
+	switch symbol {
+	case "+":
+		return PlanActionCreate
+	case "~":
+		return PlanActionUpdate
+	case "-":
+		return PlanActionDestroy
+	default:
+		return PlanActionReplace
+	}
+}
+
+type planner struct {
+	logger       logrus.FieldLogger
+	client       client.Client
+	coreV1Client corev1client.CoreV1Interface
+
+	namespace     string
+	image         string
+	configName    string
+	variablesName string
+	stateName     string
+	podName       string
+
+	liveStateName string
+}
+
+// NewPlanner creates a new Planner for the Terraform configuration of the given purpose, namespace and name.
+// The configuration and variables are written to plan-specific ConfigMaps and Secrets which are deleted after
+// the plan has finished. The state of the Terraformer of the same purpose, namespace and name is only read.
+func NewPlanner(logger logrus.FieldLogger, client client.Client, coreV1Client corev1client.CoreV1Interface, purpose, namespace, name, image string) Planner {
+	prefix := fmt.Sprintf("%s.%s", name, purpose)
+	planPrefix := prefix + PlanPodSuffix
+
+	return &planner{
+		logger:       logger,
+		client:       client,
+		coreV1Client: coreV1Client,
+
+		namespace:     namespace,
+		image:         image,
+		configName:    planPrefix + common.TerraformerConfigSuffix,
+		variablesName: planPrefix + common.TerraformerVariablesSuffix,
+		stateName:     planPrefix + common.TerraformerStateSuffix,
+		podName:       planPrefix,
+
+		liveStateName: prefix + common.TerraformerStateSuffix,
+	}
+}
+
+// NewPlannerForConfig creates a new Planner and its dependencies from the given configuration.
+func NewPlannerForConfig(logger logrus.FieldLogger, config *rest.Config, purpose, namespace, name, image string) (Planner, error) {
+	c, err := client.New(config, client.Options{})
+	if err != nil {
+		return nil, err
+	}
+
+	coreV1Client, err := corev1client.NewForConfig(config)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewPlanner(logger, c, coreV1Client, purpose, namespace, name, image), nil
+}
+
+// Plan implements Planner.
+func (p *planner) Plan(ctx context.Context, initializer Initializer, variablesEnvironment map[string]string) (*Plan, error) {
+	return p.plan(ctx, initializer, variablesEnvironment, false)
+}
+
+// PlanDestroy implements Planner.
+func (p *planner) PlanDestroy(ctx context.Context, initializer Initializer, variablesEnvironment map[string]string) (*Plan, error) {
+	return p.plan(ctx, initializer, variablesEnvironment, true)
+}
+
+func (p *planner) plan(ctx context.Context, initializer Initializer, variablesEnvironment map[string]string, destroy bool) (*Plan, error) {
+	if variablesEnvironment == nil {
+		return nil, fmt.Errorf("no Terraform variables environment provided")
+	}
+	defer func() {
+		if err := p.cleanup(context.TODO()); err != nil {
+			p.logger.Errorf("Could not clean up Terraform plan pod '%s': %v", p.podName, err)
+		}
+	}()
+
+	stateName, err := p.initialize(ctx, initializer)
+	if err != nil {
+		return nil, fmt.Errorf("could not create the Terraform ConfigMaps/Secrets: %v", err)
+	}
+
+	pod := p.pod(stateName, variablesEnvironment, destroy)
+	if err := p.deletePod(ctx); err != nil {
+		return nil, err
+	}
+	if err := p.client.Create(ctx, pod); err != nil {
+		return nil, err
+	}
+
+	phase, err := p.waitForPod(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	logs, err := kubernetes.GetPodLogs(p.coreV1Client.Pods(p.namespace), p.podName, &corev1.PodLogOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	if phase != corev1.PodSucceeded {
+		return nil, gardencorev1alpha1helper.DetermineError(fmt.Sprintf("Terraform plan pod '%s' could not be completed: %s", p.podName, string(logs)))
+	}
+	return ParsePlan(string(logs))
+}
+
+// initialize calls the given initializer with the plan-specific configuration of the planner and returns the
+// name of the state ConfigMap to plan against. This is the state of the Terraformer if it exists, otherwise an
+// empty plan-specific state is initialized, i.e. the infrastructure has never been applied.
+func (p *planner) initialize(ctx context.Context, initializer Initializer) (string, error) {
+	stateName, initializeState := p.liveStateName, false
+	if err := p.client.Get(ctx, kutil.Key(p.namespace, p.liveStateName), &corev1.ConfigMap{}); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return "", err
+		}
+		stateName, initializeState = p.stateName, true
+	}
+
+	if err := initializer.Initialize(&gardenerterraformer.InitializerConfig{
+		Namespace:         p.namespace,
+		ConfigurationName: p.configName,
+		VariablesName:     p.variablesName,
+		StateName:         p.stateName,
+		InitializeState:   initializeState,
+	}); err != nil {
+		return "", err
+	}
+	return stateName, nil
+}
+
+func (p *planner) waitForPod(ctx context.Context) (corev1.PodPhase, error) {
+	ctx, cancel := context.WithTimeout(ctx, PlanTimeout)
+	defer cancel()
+
+	var phase corev1.PodPhase
+	if err := wait.PollImmediateUntil(planPollInterval, func() (bool, error) {
+		pod := &corev1.Pod{}
+		if err := p.client.Get(ctx, kutil.Key(p.namespace, p.podName), pod); err != nil {
+			return false, err
+		}
+
+		phase = pod.Status.Phase
+		return phase == corev1.PodSucceeded || phase == corev1.PodFailed, nil
+	}, ctx.Done()); err != nil {
+		return "", fmt.Errorf("error while waiting for Terraform plan pod '%s': %v", p.podName, err)
+	}
+	return phase, nil
+}
+
+func (p *planner) deletePod(ctx context.Context) error {
+	if err := p.client.Delete(ctx, &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: p.namespace, Name: p.podName}}, client.GracePeriodSeconds(0)); err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+// cleanup deletes the plan pod as well as the plan-specific ConfigMaps and Secrets.
+func (p *planner) cleanup(ctx context.Context) error {
+	if err := p.deletePod(ctx); err != nil {
+		return err
+	}
+
+	for _, obj := range []runtime.Object{
+		&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Namespace: p.namespace, Name: p.configName}},
+		&corev1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: p.namespace, Name: p.variablesName}},
+		&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Namespace: p.namespace, Name: p.stateName}},
+	} {
+		if err := p.client.Delete(ctx, obj); err != nil && !apierrors.IsNotFound(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *planner) pod(stateName string, variablesEnvironment map[string]string, destroy bool) *corev1.Pod {
+	const (
+		tfVolume      = "tf"
+		tfVarsVolume  = "tfvars"
+		tfStateVolume = "tfstate"
+	)
+
+	var (
+		activeDeadlineSeconds = int64(PlanTimeout / time.Second)
+		env                   []corev1.EnvVar
+		planFlags             = "-input=false -lock=false -no-color -parallelism=4"
+	)
+
+	if destroy {
+		planFlags += " -destroy"
+	}
+
+	command := fmt.Sprintf(`cp -r %[1]s /tmp/tf && cd /tmp/tf && `+
+		`terraform init -input=false -plugin-dir=%[2]s . && `+
+		`terraform plan %[5]s -state=%[3]s/terraform.tfstate -var-file=%[4]s/terraform.tfvars .`,
+		planConfigMountPath, planProvidersPath, planStateMountPath, planVarsMountPath, planFlags)
+
+	for key, value := range variablesEnvironment {
+		env = append(env, corev1.EnvVar{Name: key, Value: value})
+	}
+	sort.Slice(env, func(i, j int) bool { return env[i].Name < env[j].Name })
+
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: p.namespace,
+			Name:      p.podName,
+			Labels: map[string]string{
+				"networking.gardener.cloud/to-dns":              "allowed",
+				"networking.gardener.cloud/to-private-networks": "allowed",
+				"networking.gardener.cloud/to-public-networks":  "allowed",
+			},
+		},
+		Spec: corev1.PodSpec{
+			RestartPolicy:         corev1.RestartPolicyNever,
+			ActiveDeadlineSeconds: &activeDeadlineSeconds,
+			Containers: []corev1.Container{
+				{
+					Name:            "terraform",
+					Image:           p.image,
+					ImagePullPolicy: corev1.PullIfNotPresent,
+					Command:         []string{"sh", "-c", command},
+					Resources: corev1.ResourceRequirements{
+						Requests: corev1.ResourceList{
+							corev1.ResourceCPU:    resource.MustParse("50m"),
+							corev1.ResourceMemory: resource.MustParse("200Mi"),
+						},
+						Limits: corev1.ResourceList{
+							corev1.ResourceCPU:    resource.MustParse("200m"),
+							corev1.ResourceMemory: resource.MustParse("512Mi"),
+						},
+					},
+					Env: env,
+					VolumeMounts: []corev1.VolumeMount{
+						{Name: tfVolume, MountPath: planConfigMountPath},
+						{Name: tfVarsVolume, MountPath: planVarsMountPath},
+						{Name: tfStateVolume, MountPath: planStateMountPath},
+					},
+				},
+			},
+			Volumes: []corev1.Volume{
+				{
+					Name: tfVolume,
+					VolumeSource: corev1.VolumeSource{
+						ConfigMap: &corev1.ConfigMapVolumeSource{LocalObjectReference: corev1.LocalObjectReference{Name: p.configName}},
+					},
+				},
+				{
+					Name: tfVarsVolume,
+					VolumeSource: corev1.VolumeSource{
+						Secret: &corev1.SecretVolumeSource{SecretName: p.variablesName},
+					},
+				},
+				{
+					Name: tfStateVolume,
+					VolumeSource: corev1.VolumeSource{
+						ConfigMap: &corev1.ConfigMapVolumeSource{LocalObjectReference: corev1.LocalObjectReference{Name: stateName}},
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,248 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package terraformer_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	. "github.com/gardener/gardener-extensions/pkg/gardener/terraformer"
+	mockclient "github.com/gardener/gardener-extensions/pkg/mock/controller-runtime/client"
+	mockterraformer "github.com/gardener/gardener-extensions/pkg/mock/gardener-extensions/gardener/terraformer"
+
+	gardenerterraformer "github.com/gardener/gardener/pkg/operation/terraformer"
+	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
+	"k8s.io/client-go/rest"
+)
+
+var _ = Describe("Plan", func() {
+	Describe("#ParsePlan", func() {
+		It("should parse a plan without changes", func() {
+			plan, err := ParsePlan("No changes. Infrastructure is up-to-date.")
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(plan.HasChanges()).To(BeFalse())
+			Expect(plan.String()).To(Equal("0 to add, 0 to change, 0 to destroy"))
+		})
+
+		It("should parse a plan of Terraform < 0.12", func() {
+			plan, err := ParsePlan(`
+An execution plan has been generated and is shown below.
+
+  + aws_subnet.nodes_z0
+      id:                   <computed>
+
+  ~ aws_vpc.vpc
+      tags.%:               "1" => "2"
+
+-/+ aws_nat_gateway.natgw_z0 (new resource required)
+      id:                   "nat-123" => <computed> (forces new resource)
+
+  - aws_eip.eip_natgw_z1
+
+Plan: 2 to add, 1 to change, 2 to destroy.
+`)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(plan.HasChanges()).To(BeTrue())
+			Expect(plan).To(Equal(&Plan{
+				Add:     2,
+				Change:  1,
+				Destroy: 2,
+				Resources: []PlannedResource{
+					{Address: "aws_subnet.nodes_z0", Action: PlanActionCreate},
+					{Address: "aws_vpc.vpc", Action: PlanActionUpdate},
+					{Address: "aws_nat_gateway.natgw_z0", Action: PlanActionReplace},
+					{Address: "aws_eip.eip_natgw_z1", Action: PlanActionDestroy},
+				},
+			}))
+		})
+
+		It("should parse a plan of Terraform >= 0.12", func() {
+			plan, err := ParsePlan(`
+  # azurerm_subnet.workers will be created
+  + resource "azurerm_subnet" "workers" {
+      + id = (known after apply)
+    }
+
+  # azurerm_route_table.workers will be destroyed
+  - resource "azurerm_route_table" "workers" {
+      - id = "foo" -> null
+    }
+
+Plan: 1 to add, 0 to change, 1 to destroy.
+`)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(plan.String()).To(Equal("1 to add, 0 to change, 1 to destroy: azurerm_subnet.workers (create), azurerm_route_table.workers (destroy)"))
+		})
+
+		It("should fail if the output does not contain a plan summary", func() {
+			_, err := ParsePlan("Error: provider.aws: no suitable version installed")
+
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("#Planner", func() {
+		const (
+			purpose   = "infra"
+			namespace = "shoot--foo--bar"
+			name      = "bar"
+			image     = "terraformer:v1"
+		)
+
+		var (
+			ctx         context.Context
+			ctrl        *gomock.Controller
+			c           *mockclient.MockClient
+			initializer *mockterraformer.MockInitializer
+			server      *httptest.Server
+			planner     Planner
+
+			logs = "Plan: 1 to add, 0 to change, 0 to destroy."
+
+			pod       = &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: "bar.infra.tf-plan"}}
+			config    = &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: "bar.infra.tf-plan.tf-config"}}
+			variables = &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: "bar.infra.tf-plan.tf-vars"}}
+			state     = &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: "bar.infra.tf-plan.tf-state"}}
+			notFound  = apierrors.NewNotFound(schema.GroupResource{}, "")
+
+			expectPlan = func(initializeState bool, stateName string, phase corev1.PodPhase, destroy bool) {
+				c.EXPECT().Get(ctx, kutil.Key(namespace, "bar.infra.tf-state"), gomock.AssignableToTypeOf(&corev1.ConfigMap{})).DoAndReturn(func(_ context.Context, _ interface{}, _ *corev1.ConfigMap) error {
+					if initializeState {
+						return notFound
+					}
+					return nil
+				})
+				initializer.EXPECT().Initialize(&gardenerterraformer.InitializerConfig{
+					Namespace:         namespace,
+					ConfigurationName: "bar.infra.tf-plan.tf-config",
+					VariablesName:     "bar.infra.tf-plan.tf-vars",
+					StateName:         "bar.infra.tf-plan.tf-state",
+					InitializeState:   initializeState,
+				})
+				c.EXPECT().Delete(ctx, pod, gomock.Any()).Return(notFound)
+				c.EXPECT().Create(ctx, gomock.AssignableToTypeOf(&corev1.Pod{})).DoAndReturn(func(_ context.Context, obj *corev1.Pod) error {
+					Expect(obj.Spec.Volumes).To(ContainElement(corev1.Volume{
+						Name: "tfstate",
+						VolumeSource: corev1.VolumeSource{
+							ConfigMap: &corev1.ConfigMapVolumeSource{LocalObjectReference: corev1.LocalObjectReference{Name: stateName}},
+						},
+					}))
+					if destroy {
+						Expect(obj.Spec.Containers[0].Command).To(ContainElement(ContainSubstring("terraform plan -input=false -lock=false -no-color -parallelism=4 -destroy ")))
+					} else {
+						Expect(obj.Spec.Containers[0].Command).NotTo(ContainElement(ContainSubstring("-destroy")))
+					}
+					return nil
+				})
+				c.EXPECT().Get(gomock.Any(), kutil.Key(namespace, "bar.infra.tf-plan"), gomock.AssignableToTypeOf(&corev1.Pod{})).DoAndReturn(func(_ context.Context, _ interface{}, obj *corev1.Pod) error {
+					obj.Status.Phase = phase
+					return nil
+				})
+			}
+
+			expectCleanup = func() {
+				c.EXPECT().Delete(gomock.Any(), pod, gomock.Any())
+				c.EXPECT().Delete(gomock.Any(), config)
+				c.EXPECT().Delete(gomock.Any(), variables)
+				c.EXPECT().Delete(gomock.Any(), state).Return(notFound)
+			}
+		)
+
+		BeforeEach(func() {
+			ctx = context.TODO()
+			ctrl = gomock.NewController(GinkgoT())
+			c = mockclient.NewMockClient(ctrl)
+			initializer = mockterraformer.NewMockInitializer(ctrl)
+
+			server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				Expect(r.URL.Path).To(Equal(fmt.Sprintf("/api/v1/namespaces/%s/pods/bar.infra.tf-plan/log", namespace)))
+				_, _ = w.Write([]byte(logs))
+			}))
+
+			coreV1Client, err := corev1client.NewForConfig(&rest.Config{Host: server.URL})
+			Expect(err).NotTo(HaveOccurred())
+
+			planner = NewPlanner(logrus.New(), c, coreV1Client, purpose, namespace, name, image)
+		})
+
+		AfterEach(func() {
+			server.Close()
+			ctrl.Finish()
+		})
+
+		It("should plan against the existing state and clean up afterwards", func() {
+			expectPlan(false, "bar.infra.tf-state", corev1.PodSucceeded, false)
+			expectCleanup()
+
+			plan, err := planner.Plan(ctx, initializer, map[string]string{"TF_VAR_FOO": "foo"})
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(plan).To(Equal(&Plan{Add: 1}))
+		})
+
+		It("should plan the destruction of the existing state", func() {
+			expectPlan(false, "bar.infra.tf-state", corev1.PodSucceeded, true)
+			expectCleanup()
+
+			plan, err := planner.PlanDestroy(ctx, initializer, map[string]string{})
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(plan).To(Equal(&Plan{Add: 1}))
+		})
+
+		It("should plan against an empty plan-specific state if the infrastructure has never been applied", func() {
+			expectPlan(true, "bar.infra.tf-plan.tf-state", corev1.PodSucceeded, false)
+			expectCleanup()
+
+			plan, err := planner.Plan(ctx, initializer, map[string]string{})
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(plan).To(Equal(&Plan{Add: 1}))
+		})
+
+		It("should clean up if the plan pod failed", func() {
+			expectPlan(false, "bar.infra.tf-state", corev1.PodFailed, false)
+			expectCleanup()
+
+			_, err := planner.Plan(ctx, initializer, map[string]string{})
+
+			Expect(err).To(MatchError(ContainSubstring("Terraform plan pod 'bar.infra.tf-plan' could not be completed")))
+		})
+
+		It("should clean up if the initialization failed", func() {
+			c.EXPECT().Get(ctx, kutil.Key(namespace, "bar.infra.tf-state"), gomock.AssignableToTypeOf(&corev1.ConfigMap{}))
+			initializer.EXPECT().Initialize(gomock.Any()).Return(fmt.Errorf("foo"))
+			expectCleanup()
+
+			_, err := planner.Plan(ctx, initializer, map[string]string{})
+
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
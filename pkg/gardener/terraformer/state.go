@@ -0,0 +1,59 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package terraformer
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gardener/gardener/pkg/operation/common"
+	gardenerterraformer "github.com/gardener/gardener/pkg/operation/terraformer"
+	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// StateName returns the name of the ConfigMap containing the Terraform state of the given purpose and name.
+func StateName(purpose, name string) string {
+	return fmt.Sprintf("%s.%s", name, purpose) + common.TerraformerStateSuffix
+}
+
+// GetState returns the Terraform state of the given purpose, namespace and name. It returns an empty state
+// if the Terraform configuration has never been applied.
+func GetState(ctx context.Context, c client.Client, purpose, namespace, name string) (string, error) {
+	configMap := &corev1.ConfigMap{}
+	if err := c.Get(ctx, kutil.Key(namespace, StateName(purpose, name)), configMap); err != nil {
+		if apierrors.IsNotFound(err) {
+			return "", nil
+		}
+		return "", err
+	}
+
+	return configMap.Data[gardenerterraformer.StateKey], nil
+}
+
+// RestoreState creates or updates the Terraform state of the given purpose, namespace and name with the
+// given state, so that subsequent Terraform runs adopt the resources contained in it instead of creating
+// them again.
+func RestoreState(ctx context.Context, c client.Client, purpose, namespace, name, state string) error {
+	if len(state) == 0 {
+		return nil
+	}
+
+	_, err := gardenerterraformer.CreateOrUpdateStateConfigMap(ctx, c, namespace, StateName(purpose, name), state)
+	return err
+}
@@ -0,0 +1,86 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package terraformer_test
+
+import (
+	"context"
+
+	. "github.com/gardener/gardener-extensions/pkg/gardener/terraformer"
+	mockclient "github.com/gardener/gardener-extensions/pkg/mock/controller-runtime/client"
+
+	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+var _ = Describe("State", func() {
+	const (
+		purpose   = "infra"
+		namespace = "shoot--foo--bar"
+		name      = "bar"
+	)
+
+	var (
+		ctx  context.Context
+		ctrl *gomock.Controller
+		c    *mockclient.MockClient
+	)
+
+	BeforeEach(func() {
+		ctx = context.TODO()
+		ctrl = gomock.NewController(GinkgoT())
+		c = mockclient.NewMockClient(ctrl)
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Describe("#StateName", func() {
+		It("should return the name of the state ConfigMap", func() {
+			Expect(StateName(purpose, name)).To(Equal("bar.infra.tf-state"))
+		})
+	})
+
+	Describe("#GetState", func() {
+		It("should return the state", func() {
+			c.EXPECT().Get(ctx, kutil.Key(namespace, "bar.infra.tf-state"), gomock.AssignableToTypeOf(&corev1.ConfigMap{})).
+				DoAndReturn(func(_ context.Context, _ interface{}, configMap *corev1.ConfigMap) error {
+					configMap.Data = map[string]string{"terraform.tfstate": "state"}
+					return nil
+				})
+
+			Expect(GetState(ctx, c, purpose, namespace, name)).To(Equal("state"))
+		})
+
+		It("should return an empty state if the ConfigMap does not exist", func() {
+			c.EXPECT().Get(ctx, kutil.Key(namespace, "bar.infra.tf-state"), gomock.AssignableToTypeOf(&corev1.ConfigMap{})).
+				Return(apierrors.NewNotFound(schema.GroupResource{Resource: "configmaps"}, "bar.infra.tf-state"))
+
+			Expect(GetState(ctx, c, purpose, namespace, name)).To(BeEmpty())
+		})
+	})
+
+	Describe("#RestoreState", func() {
+		It("should not restore an empty state", func() {
+			Expect(RestoreState(ctx, c, purpose, namespace, name, "")).To(Succeed())
+		})
+	})
+})
@@ -15,6 +15,8 @@
 package terraformer
 
 import (
+	"context"
+
 	gardenerterraformer "github.com/gardener/gardener/pkg/operation/terraformer"
 	"github.com/sirupsen/logrus"
 	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
@@ -43,3 +45,13 @@ type Factory interface {
 type Initializer interface {
 	Initialize(config *gardenerterraformer.InitializerConfig) error
 }
+
+// Planner can plan a Terraform configuration without applying it.
+type Planner interface {
+	// Plan initializes the Terraform configuration with the given initializer, runs 'terraform plan' with the
+	// given variables environment and returns the summary of the plan.
+	Plan(ctx context.Context, initializer Initializer, variablesEnvironment map[string]string) (*Plan, error)
+	// PlanDestroy initializes the Terraform configuration with the given initializer, runs 'terraform plan -destroy'
+	// with the given variables environment and returns the summary of the plan.
+	PlanDestroy(ctx context.Context, initializer Initializer, variablesEnvironment map[string]string) (*Plan, error)
+}
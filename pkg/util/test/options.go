@@ -17,6 +17,7 @@ package test
 import (
 	"fmt"
 	"strings"
+	"time"
 )
 
 type Flag interface {
@@ -61,6 +62,15 @@ func (f *boolFlag) Slice() []string {
 	return []string{keyToFlag(f.key), value}
 }
 
+type durationFlag struct {
+	key   string
+	value time.Duration
+}
+
+func (f *durationFlag) Slice() []string {
+	return []string{keyToFlag(f.key), f.value.String()}
+}
+
 type stringSliceFlag struct {
 	key   string
 	value []string
@@ -82,6 +92,10 @@ func BoolFlag(key string, value bool) Flag {
 	return &boolFlag{key, value}
 }
 
+func DurationFlag(key string, value time.Duration) Flag {
+	return &durationFlag{key, value}
+}
+
 func StringSliceFlag(key string, value ...string) Flag {
 	return &stringSliceFlag{key, value}
 }
@@ -0,0 +1,108 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	"fmt"
+	"net"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// CIDR is a CIDR together with the path of the field it is specified in.
+type CIDR struct {
+	// Path is the path of the field the CIDR is specified in.
+	Path *field.Path
+	// Value is the CIDR.
+	Value string
+}
+
+// NewCIDR creates a new CIDR with the given value that is specified in the field with the given path.
+func NewCIDR(value string, fldPath *field.Path) CIDR {
+	return CIDR{Path: fldPath, Value: value}
+}
+
+func (c CIDR) parse() *net.IPNet {
+	_, ipNet, err := net.ParseCIDR(c.Value)
+	if err != nil {
+		return nil
+	}
+	return ipNet
+}
+
+// ValidateCIDRParse validates that all given CIDRs can be parsed.
+func ValidateCIDRParse(cidrs ...CIDR) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	for _, cidr := range cidrs {
+		if _, _, err := net.ParseCIDR(cidr.Value); err != nil {
+			allErrs = append(allErrs, field.Invalid(cidr.Path, cidr.Value, err.Error()))
+		}
+	}
+
+	return allErrs
+}
+
+// ValidateCIDRIsSubset validates that all given subsets are contained in the given superset. CIDRs that cannot
+// be parsed are ignored, see ValidateCIDRParse.
+func ValidateCIDRIsSubset(superset CIDR, subsets ...CIDR) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	supersetNet := superset.parse()
+	if supersetNet == nil {
+		return allErrs
+	}
+
+	for _, subset := range subsets {
+		subsetNet := subset.parse()
+		if subsetNet == nil {
+			continue
+		}
+
+		supersetOnes, _ := supersetNet.Mask.Size()
+		subsetOnes, _ := subsetNet.Mask.Size()
+		if !supersetNet.Contains(subsetNet.IP) || subsetOnes < supersetOnes {
+			allErrs = append(allErrs, field.Invalid(subset.Path, subset.Value, fmt.Sprintf("must be a subset of %q (%s)", superset.Path.String(), superset.Value)))
+		}
+	}
+
+	return allErrs
+}
+
+// ValidateCIDROverlap validates that the given CIDRs do not overlap with each other. An overlap is reported
+// for the latter of both CIDRs. CIDRs that cannot be parsed are ignored, see ValidateCIDRParse.
+func ValidateCIDROverlap(cidrs ...CIDR) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	for i, cidr := range cidrs {
+		ipNet := cidr.parse()
+		if ipNet == nil {
+			continue
+		}
+
+		for _, other := range cidrs[:i] {
+			otherNet := other.parse()
+			if otherNet == nil {
+				continue
+			}
+
+			if ipNet.Contains(otherNet.IP) || otherNet.Contains(ipNet.IP) {
+				allErrs = append(allErrs, field.Invalid(cidr.Path, cidr.Value, fmt.Sprintf("must not overlap with %q (%s)", other.Path.String(), other.Value)))
+			}
+		}
+	}
+
+	return allErrs
+}
@@ -0,0 +1,79 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation_test
+
+import (
+	. "github.com/gardener/gardener-extensions/pkg/util/validation"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+var _ = Describe("CIDR", func() {
+	var (
+		vpcPath = field.NewPath("vpc", "cidr")
+		aPath   = field.NewPath("zones").Index(0).Child("workers")
+		bPath   = field.NewPath("zones").Index(1).Child("workers")
+	)
+
+	Describe("#ValidateCIDRParse", func() {
+		It("should accept valid CIDRs", func() {
+			Expect(ValidateCIDRParse(NewCIDR("10.250.0.0/16", vpcPath))).To(BeEmpty())
+		})
+
+		It("should reject invalid CIDRs", func() {
+			Expect(ValidateCIDRParse(NewCIDR("10.250.0.0", vpcPath))).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("vpc.cidr"),
+			}))))
+		})
+	})
+
+	Describe("#ValidateCIDRIsSubset", func() {
+		It("should accept subsets", func() {
+			Expect(ValidateCIDRIsSubset(NewCIDR("10.250.0.0/16", vpcPath), NewCIDR("10.250.0.0/19", aPath))).To(BeEmpty())
+		})
+
+		It("should reject CIDRs outside of the superset", func() {
+			Expect(ValidateCIDRIsSubset(NewCIDR("10.250.0.0/16", vpcPath), NewCIDR("10.251.0.0/19", aPath))).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("zones[0].workers"),
+			}))))
+		})
+
+		It("should reject CIDRs larger than the superset", func() {
+			Expect(ValidateCIDRIsSubset(NewCIDR("10.250.0.0/16", vpcPath), NewCIDR("10.250.0.0/8", aPath))).To(HaveLen(1))
+		})
+
+		It("should ignore CIDRs that cannot be parsed", func() {
+			Expect(ValidateCIDRIsSubset(NewCIDR("foo", vpcPath), NewCIDR("10.250.0.0/8", aPath))).To(BeEmpty())
+		})
+	})
+
+	Describe("#ValidateCIDROverlap", func() {
+		It("should accept disjoint CIDRs", func() {
+			Expect(ValidateCIDROverlap(NewCIDR("10.250.0.0/19", aPath), NewCIDR("10.250.32.0/19", bPath))).To(BeEmpty())
+		})
+
+		It("should reject overlapping CIDRs", func() {
+			Expect(ValidateCIDROverlap(NewCIDR("10.250.0.0/16", aPath), NewCIDR("10.250.32.0/19", bPath))).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("zones[1].workers"),
+			}))))
+		})
+	})
+})
@@ -0,0 +1,121 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	"fmt"
+
+	"github.com/Masterminds/semver"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// MinimumCSIKubernetesVersion is the minimum Kubernetes version of shoots for which CSI drivers can be enabled.
+const MinimumCSIKubernetesVersion = "1.13"
+
+var minimumCSIKubernetesVersion = semver.MustParse(MinimumCSIKubernetesVersion)
+
+// CloudControllerManagerSettings are the cloud-controller-manager settings that the ControlPlaneConfigs of all
+// providers have in common.
+type CloudControllerManagerSettings struct {
+	// RouteReconciliationPeriod is the period for reconciling the routes created for nodes by the cloud provider.
+	RouteReconciliationPeriod *metav1.Duration
+	// NodeMonitorPeriod is the period for syncing the status of the nodes.
+	NodeMonitorPeriod *metav1.Duration
+	// NodeStatusUpdateFrequency is the frequency in which the node status is updated from the cloud provider.
+	NodeStatusUpdateFrequency *metav1.Duration
+	// ConcurrentServiceSyncs is the number of services that are allowed to sync concurrently.
+	ConcurrentServiceSyncs *int32
+	// Verbosity is the log level verbosity of the cloud-controller-manager.
+	Verbosity *int32
+	// Resources are the compute resource requirements of the cloud-controller-manager.
+	Resources *corev1.ResourceRequirements
+}
+
+// ValidateCloudControllerManagerSettings validates the given cloud-controller-manager settings.
+func ValidateCloudControllerManagerSettings(settings CloudControllerManagerSettings, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs, ValidatePositiveDuration(settings.RouteReconciliationPeriod, fldPath.Child("routeReconciliationPeriod"))...)
+	allErrs = append(allErrs, ValidatePositiveDuration(settings.NodeMonitorPeriod, fldPath.Child("nodeMonitorPeriod"))...)
+	allErrs = append(allErrs, ValidatePositiveDuration(settings.NodeStatusUpdateFrequency, fldPath.Child("nodeStatusUpdateFrequency"))...)
+
+	if settings.ConcurrentServiceSyncs != nil && *settings.ConcurrentServiceSyncs <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("concurrentServiceSyncs"), *settings.ConcurrentServiceSyncs, "must be greater than 0"))
+	}
+	if settings.Verbosity != nil && *settings.Verbosity < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("verbosity"), *settings.Verbosity, "must be greater than or equal to 0"))
+	}
+
+	allErrs = append(allErrs, ValidateResourceRequirements(settings.Resources, fldPath.Child("resources"))...)
+
+	return allErrs
+}
+
+// ValidatePositiveDuration validates that the given duration, if set, is greater than zero.
+func ValidatePositiveDuration(duration *metav1.Duration, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if duration != nil && duration.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath, duration.Duration.String(), "must be greater than 0"))
+	}
+
+	return allErrs
+}
+
+// ValidateResourceRequirements validates that the given resource requirements, if set, only contain non-negative
+// quantities and that no request exceeds the corresponding limit.
+func ValidateResourceRequirements(resources *corev1.ResourceRequirements, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if resources == nil {
+		return allErrs
+	}
+
+	for name, quantity := range resources.Limits {
+		if quantity.Sign() < 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("limits").Key(string(name)), quantity.String(), "must be greater than or equal to 0"))
+		}
+	}
+
+	for name, quantity := range resources.Requests {
+		if quantity.Sign() < 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("requests").Key(string(name)), quantity.String(), "must be greater than or equal to 0"))
+		}
+		if limit, ok := resources.Limits[name]; ok && quantity.Cmp(limit) > 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("requests").Key(string(name)), quantity.String(), fmt.Sprintf("must be less than or equal to %s limit", name)))
+		}
+	}
+
+	return allErrs
+}
+
+// ValidateCSIKubernetesVersion validates that CSI drivers can be enabled for shoots with the given Kubernetes version.
+func ValidateCSIKubernetesVersion(kubernetesVersion string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	version, err := semver.NewVersion(kubernetesVersion)
+	if err != nil {
+		allErrs = append(allErrs, field.Forbidden(fldPath, fmt.Sprintf("cannot be enabled for Kubernetes version %q: %v", kubernetesVersion, err)))
+		return allErrs
+	}
+	if version.LessThan(minimumCSIKubernetesVersion) {
+		allErrs = append(allErrs, field.Forbidden(fldPath, fmt.Sprintf("cannot be enabled for Kubernetes versions lower than %s, but the shoot uses %s", MinimumCSIKubernetesVersion, kubernetesVersion)))
+	}
+
+	return allErrs
+}
@@ -0,0 +1,171 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation_test
+
+import (
+	"time"
+
+	. "github.com/gardener/gardener-extensions/pkg/util/validation"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+var _ = Describe("ControlPlane", func() {
+	Describe("#ValidateCloudControllerManagerSettings", func() {
+		var (
+			fldPath = field.NewPath("cloudControllerManager")
+
+			concurrentServiceSyncs        int32 = 5
+			invalidConcurrentServiceSyncs int32
+			invalidVerbosity              int32 = -1
+		)
+
+		It("should accept empty settings", func() {
+			Expect(ValidateCloudControllerManagerSettings(CloudControllerManagerSettings{}, fldPath)).To(BeEmpty())
+		})
+
+		It("should accept valid settings", func() {
+			Expect(ValidateCloudControllerManagerSettings(CloudControllerManagerSettings{
+				RouteReconciliationPeriod: &metav1.Duration{Duration: 30 * time.Second},
+				ConcurrentServiceSyncs:    &concurrentServiceSyncs,
+				Resources: &corev1.ResourceRequirements{
+					Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("100m")},
+					Limits:   corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("1")},
+				},
+			}, fldPath)).To(BeEmpty())
+		})
+
+		It("should reject invalid settings", func() {
+			Expect(ValidateCloudControllerManagerSettings(CloudControllerManagerSettings{
+				NodeMonitorPeriod:      &metav1.Duration{},
+				ConcurrentServiceSyncs: &invalidConcurrentServiceSyncs,
+				Verbosity:              &invalidVerbosity,
+				Resources: &corev1.ResourceRequirements{
+					Requests: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("1Gi")},
+					Limits:   corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("512Mi")},
+				},
+			}, fldPath)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("cloudControllerManager.nodeMonitorPeriod"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("cloudControllerManager.concurrentServiceSyncs"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("cloudControllerManager.verbosity"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("cloudControllerManager.resources.requests[memory]"),
+				})),
+			))
+		})
+	})
+
+	Describe("#ValidatePositiveDuration", func() {
+		fldPath := field.NewPath("period")
+
+		It("should accept an unset duration", func() {
+			Expect(ValidatePositiveDuration(nil, fldPath)).To(BeEmpty())
+		})
+
+		It("should accept a positive duration", func() {
+			Expect(ValidatePositiveDuration(&metav1.Duration{Duration: 10 * time.Second}, fldPath)).To(BeEmpty())
+		})
+
+		It("should reject a non-positive duration", func() {
+			Expect(ValidatePositiveDuration(&metav1.Duration{}, fldPath)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("period"),
+				})),
+			))
+		})
+	})
+
+	Describe("#ValidateResourceRequirements", func() {
+		fldPath := field.NewPath("resources")
+
+		It("should accept unset resources", func() {
+			Expect(ValidateResourceRequirements(nil, fldPath)).To(BeEmpty())
+		})
+
+		It("should accept valid resources", func() {
+			Expect(ValidateResourceRequirements(&corev1.ResourceRequirements{
+				Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("100m")},
+				Limits:   corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("500m"), corev1.ResourceMemory: resource.MustParse("512Mi")},
+			}, fldPath)).To(BeEmpty())
+		})
+
+		It("should reject negative quantities", func() {
+			Expect(ValidateResourceRequirements(&corev1.ResourceRequirements{
+				Limits: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("-1Mi")},
+			}, fldPath)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("resources.limits[memory]"),
+				})),
+			))
+		})
+
+		It("should reject requests exceeding the limits", func() {
+			Expect(ValidateResourceRequirements(&corev1.ResourceRequirements{
+				Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("1")},
+				Limits:   corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("500m")},
+			}, fldPath)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("resources.requests[cpu]"),
+				})),
+			))
+		})
+	})
+
+	Describe("#ValidateCSIKubernetesVersion", func() {
+		fldPath := field.NewPath("csi", "enabled")
+
+		It("should accept Kubernetes versions supporting CSI drivers", func() {
+			Expect(ValidateCSIKubernetesVersion("1.13.0", fldPath)).To(BeEmpty())
+			Expect(ValidateCSIKubernetesVersion("1.14.3", fldPath)).To(BeEmpty())
+		})
+
+		It("should reject Kubernetes versions not supporting CSI drivers", func() {
+			Expect(ValidateCSIKubernetesVersion("1.12.7", fldPath)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("csi.enabled"),
+				})),
+			))
+		})
+
+		It("should reject invalid Kubernetes versions", func() {
+			Expect(ValidateCSIKubernetesVersion("foo", fldPath)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("csi.enabled"),
+				})),
+			))
+		})
+	})
+})
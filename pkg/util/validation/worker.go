@@ -0,0 +1,279 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	"fmt"
+	"path"
+
+	apisworker "github.com/gardener/gardener-extensions/pkg/apis/worker"
+	workerhelper "github.com/gardener/gardener-extensions/pkg/apis/worker/helper"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// ValidateWorkerPools validates the provider independent fields of the given worker pools. If zonesRequired is
+// true, every worker pool has to specify at least one zone.
+func ValidateWorkerPools(pools []extensionsv1alpha1.WorkerPool, zonesRequired bool, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	names := sets.NewString()
+
+	for i, pool := range pools {
+		idxPath := fldPath.Index(i)
+
+		if len(pool.Name) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("name"), "field is required"))
+		} else if names.Has(pool.Name) {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), pool.Name))
+		}
+		names.Insert(pool.Name)
+
+		if len(pool.MachineType) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("machineType"), "field is required"))
+		}
+		if len(pool.MachineImage.Name) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("machineImage", "name"), "field is required"))
+		}
+		if len(pool.MachineImage.Version) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("machineImage", "version"), "field is required"))
+		}
+
+		if pool.Minimum < 0 {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("minimum"), pool.Minimum, "must not be negative"))
+		}
+		if pool.Maximum < pool.Minimum {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("maximum"), pool.Maximum, "must be greater than or equal to minimum"))
+		}
+		if isZero(pool.MaxSurge) && isZero(pool.MaxUnavailable) {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("maxUnavailable"), pool.MaxUnavailable.String(), "must not be 0 if maxSurge is 0"))
+		}
+
+		if pool.Volume != nil {
+			if _, err := resource.ParseQuantity(pool.Volume.Size); err != nil {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("volume", "size"), pool.Volume.Size, err.Error()))
+			}
+		}
+
+		if zonesRequired && len(pool.Zones) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("zones"), "at least one zone must be specified"))
+		}
+		allErrs = append(allErrs, validateZones(pool.Zones, idxPath.Child("zones"))...)
+
+		configPath := idxPath.Child("providerConfig")
+		if config, err := workerhelper.WorkerConfigFromPool(pool); err != nil {
+			allErrs = append(allErrs, field.Invalid(configPath, string(pool.ProviderConfig.Raw), fmt.Sprintf("could not decode provider config: %+v", err)))
+		} else {
+			allErrs = append(allErrs, validateWorkerConfig(config, pool, configPath)...)
+		}
+	}
+
+	return allErrs
+}
+
+const (
+	// WorkerConfigFieldSpot is the field of the provider-independent WorkerConfig that requests interruptible capacity.
+	WorkerConfigFieldSpot = "spot"
+	// WorkerConfigFieldDataVolumes is the field of the provider-independent WorkerConfig that requests additional
+	// data volumes.
+	WorkerConfigFieldDataVolumes = "dataVolumes"
+	// WorkerConfigFieldZoneDistribution is the field of the provider-independent WorkerConfig that configures the
+	// distribution of the machines of a pool over its zones.
+	WorkerConfigFieldZoneDistribution = "zoneDistribution"
+)
+
+// ValidateUnsupportedWorkerConfigFields returns an error for each of the given fields of the provider-independent
+// WorkerConfig that is set for a worker pool although the provider does not support it. Pools whose providerConfig
+// cannot be decoded are skipped as they are already rejected by ValidateWorkerPools.
+func ValidateUnsupportedWorkerConfigFields(pools []extensionsv1alpha1.WorkerPool, fields []string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	for i, pool := range pools {
+		config, err := workerhelper.WorkerConfigFromPool(pool)
+		if err != nil {
+			continue
+		}
+
+		configPath := fldPath.Index(i).Child("providerConfig")
+		for _, name := range fields {
+			if workerConfigFieldSet(config, name) {
+				allErrs = append(allErrs, field.Forbidden(configPath.Child(name), "is not supported by this provider"))
+			}
+		}
+	}
+
+	return allErrs
+}
+
+func workerConfigFieldSet(config *apisworker.WorkerConfig, name string) bool {
+	switch name {
+	case WorkerConfigFieldSpot:
+		return config.Spot != nil
+	case WorkerConfigFieldDataVolumes:
+		return len(config.DataVolumes) > 0
+	case WorkerConfigFieldZoneDistribution:
+		return len(config.ZoneDistribution) > 0
+	}
+	return false
+}
+
+// validateWorkerConfig validates the given provider-independent WorkerConfig of the given pool.
+func validateWorkerConfig(config *apisworker.WorkerConfig, pool extensionsv1alpha1.WorkerPool, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if config.Autoscaler != nil && config.Autoscaler.Priority != nil && *config.Autoscaler.Priority < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("autoscaler", "priority"), *config.Autoscaler.Priority, "must not be negative"))
+	}
+
+	allErrs = append(allErrs, ValidatePositiveDuration(config.MachineDrainTimeout, fldPath.Child("machineDrainTimeout"))...)
+	allErrs = append(allErrs, ValidatePositiveDuration(config.MachineHealthTimeout, fldPath.Child("machineHealthTimeout"))...)
+	allErrs = append(allErrs, ValidatePositiveDuration(config.MachineCreationTimeout, fldPath.Child("machineCreationTimeout"))...)
+	allErrs = append(allErrs, ValidatePositiveDuration(config.MachineDeploymentsTimeout, fldPath.Child("machineDeploymentsTimeout"))...)
+	allErrs = append(allErrs, ValidatePositiveDuration(config.MachineResourcesDeletionTimeout, fldPath.Child("machineResourcesDeletionTimeout"))...)
+	if config.MaxEvictRetries != nil && *config.MaxEvictRetries < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxEvictRetries"), *config.MaxEvictRetries, "must not be negative"))
+	}
+	if config.MinReadySeconds != nil && *config.MinReadySeconds < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("minReadySeconds"), *config.MinReadySeconds, "must not be negative"))
+	}
+
+	if config.Spot != nil && config.Spot.MaxPrice != nil && len(*config.Spot.MaxPrice) == 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("spot", "maxPrice"), *config.Spot.MaxPrice, "must not be empty"))
+	}
+
+	allErrs = append(allErrs, validateDataVolumes(config.DataVolumes, fldPath.Child("dataVolumes"))...)
+	allErrs = append(allErrs, validateZoneDistribution(config.ZoneDistribution, pool, fldPath.Child("zoneDistribution"))...)
+
+	return allErrs
+}
+
+func validateDataVolumes(volumes []apisworker.DataVolume, fldPath *field.Path) field.ErrorList {
+	var (
+		allErrs    = field.ErrorList{}
+		names      = sets.NewString()
+		mountPaths = sets.NewString()
+	)
+
+	for i, volume := range volumes {
+		idxPath := fldPath.Index(i)
+
+		if len(volume.Name) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("name"), "field is required"))
+		} else if names.Has(volume.Name) {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), volume.Name))
+		}
+		names.Insert(volume.Name)
+
+		if size, err := resource.ParseQuantity(volume.Size); err != nil {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("size"), volume.Size, err.Error()))
+		} else if size.Sign() <= 0 {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("size"), volume.Size, "must be greater than 0"))
+		}
+
+		if volume.MountPath != nil {
+			mountPath := *volume.MountPath
+			if !path.IsAbs(mountPath) || path.Clean(mountPath) != mountPath || mountPath == "/" {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("mountPath"), mountPath, "must be a clean absolute path other than /"))
+			} else if mountPaths.Has(mountPath) {
+				allErrs = append(allErrs, field.Duplicate(idxPath.Child("mountPath"), mountPath))
+			}
+			mountPaths.Insert(mountPath)
+		}
+	}
+
+	return allErrs
+}
+
+func validateZoneDistribution(zones []apisworker.ZoneSettings, pool extensionsv1alpha1.WorkerPool, fldPath *field.Path) field.ErrorList {
+	var (
+		allErrs        = field.ErrorList{}
+		poolZones      = sets.NewString(pool.Zones...)
+		seen           = sets.NewString()
+		positiveWeight = len(zones) < len(pool.Zones)
+		minimums       int
+		maximums       int
+	)
+
+	for i, zone := range zones {
+		idxPath := fldPath.Index(i)
+
+		if !poolZones.Has(zone.Name) {
+			allErrs = append(allErrs, field.NotSupported(idxPath.Child("name"), zone.Name, pool.Zones))
+		} else if seen.Has(zone.Name) {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), zone.Name))
+		}
+		seen.Insert(zone.Name)
+
+		if zone.Weight == nil || *zone.Weight > 0 {
+			positiveWeight = true
+		} else if *zone.Weight < 0 {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("weight"), *zone.Weight, "must not be negative"))
+		}
+		if zone.Minimum != nil {
+			if *zone.Minimum < 0 {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("minimum"), *zone.Minimum, "must not be negative"))
+			}
+			minimums += int(*zone.Minimum)
+		}
+		if zone.Maximum != nil {
+			if *zone.Maximum < 0 {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("maximum"), *zone.Maximum, "must not be negative"))
+			}
+			maximums += int(*zone.Maximum)
+		}
+		if zone.Minimum != nil && zone.Maximum != nil && *zone.Maximum < *zone.Minimum {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("maximum"), *zone.Maximum, "must be greater than or equal to minimum"))
+		}
+	}
+
+	if len(zones) > 0 && !positiveWeight {
+		allErrs = append(allErrs, field.Invalid(fldPath, zones, "at least one zone must have a positive weight"))
+	}
+	if minimums > pool.Minimum {
+		allErrs = append(allErrs, field.Invalid(fldPath, minimums, fmt.Sprintf("sum of the zone minimums must not exceed the minimum %d of the pool", pool.Minimum)))
+	}
+	if maximums > pool.Maximum {
+		allErrs = append(allErrs, field.Invalid(fldPath, maximums, fmt.Sprintf("sum of the zone maximums must not exceed the maximum %d of the pool", pool.Maximum)))
+	}
+
+	return allErrs
+}
+
+func validateZones(zones []string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	seen := sets.NewString()
+
+	for i, zone := range zones {
+		if len(zone) == 0 {
+			allErrs = append(allErrs, field.Required(fldPath.Index(i), "zone must not be empty"))
+		} else if seen.Has(zone) {
+			allErrs = append(allErrs, field.Duplicate(fldPath.Index(i), zone))
+		}
+		seen.Insert(zone)
+	}
+
+	return allErrs
+}
+
+func isZero(value intstr.IntOrString) bool {
+	if value.Type == intstr.Int {
+		return value.IntVal == 0
+	}
+	return value.StrVal == "" || value.StrVal == "0" || value.StrVal == "0%"
+}
@@ -0,0 +1,256 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation_test
+
+import (
+	. "github.com/gardener/gardener-extensions/pkg/util/validation"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+var _ = Describe("Worker", func() {
+	Describe("#ValidateWorkerPools", func() {
+		var (
+			fldPath = field.NewPath("spec", "pools")
+			pool    extensionsv1alpha1.WorkerPool
+		)
+
+		BeforeEach(func() {
+			pool = extensionsv1alpha1.WorkerPool{
+				Name:           "pool",
+				MachineType:    "large",
+				MachineImage:   extensionsv1alpha1.MachineImage{Name: "coreos", Version: "2023.5.0"},
+				Minimum:        1,
+				Maximum:        2,
+				MaxSurge:       intstr.FromInt(1),
+				MaxUnavailable: intstr.FromInt(0),
+				Volume:         &extensionsv1alpha1.Volume{Type: "ssd", Size: "20Gi"},
+				Zones:          []string{"zone-a"},
+			}
+		})
+
+		It("should accept a valid worker pool", func() {
+			Expect(ValidateWorkerPools([]extensionsv1alpha1.WorkerPool{pool}, true, fldPath)).To(BeEmpty())
+		})
+
+		It("should reject an invalid worker pool", func() {
+			pool.Name = ""
+			pool.Maximum = 0
+			pool.MaxSurge = intstr.FromString("0%")
+			pool.Volume.Size = "large"
+
+			Expect(ValidateWorkerPools([]extensionsv1alpha1.WorkerPool{pool}, true, fldPath)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("spec.pools[0].name"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.pools[0].maximum"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.pools[0].maxUnavailable"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.pools[0].volume.size"),
+				})),
+			))
+		})
+
+		It("should reject duplicate pool names and zones", func() {
+			pool.Zones = []string{"zone-a", "zone-a"}
+
+			Expect(ValidateWorkerPools([]extensionsv1alpha1.WorkerPool{pool, pool}, true, fldPath)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeDuplicate),
+					"Field": Equal("spec.pools[0].zones[1]"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeDuplicate),
+					"Field": Equal("spec.pools[1].name"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeDuplicate),
+					"Field": Equal("spec.pools[1].zones[1]"),
+				})),
+			))
+		})
+
+		Context("providerConfig", func() {
+			withConfig := func(config string) {
+				pool.Zones = []string{"zone-a", "zone-b"}
+				pool.ProviderConfig = &runtime.RawExtension{Raw: []byte(`{"apiVersion":"worker.extensions.gardener.cloud/v1alpha1","kind":"WorkerConfig",` + config + `}`)}
+			}
+
+			It("should accept a valid providerConfig", func() {
+				withConfig(`"autoscaler":{"priority":1},"machineDrainTimeout":"1h","maxEvictRetries":10,"minReadySeconds":30,` +
+					`"machineResourcesDeletionTimeout":"2h","spot":{"maxPrice":"0.5"},` +
+					`"dataVolumes":[{"name":"data","size":"50Gi","mountPath":"/var/data"}],` +
+					`"zoneDistribution":[{"name":"zone-a","weight":2},{"name":"zone-b","minimum":1,"maximum":2}]`)
+
+				Expect(ValidateWorkerPools([]extensionsv1alpha1.WorkerPool{pool}, true, fldPath)).To(BeEmpty())
+			})
+
+			It("should reject a providerConfig that cannot be decoded", func() {
+				pool.ProviderConfig = &runtime.RawExtension{Raw: []byte(`{"apiVersion":"worker.extensions.gardener.cloud/v1alpha1","kind":"WorkerConfig","minReadySeconds":"foo"}`)}
+
+				Expect(ValidateWorkerPools([]extensionsv1alpha1.WorkerPool{pool}, true, fldPath)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.pools[0].providerConfig"),
+				}))))
+			})
+
+			It("should reject invalid settings", func() {
+				withConfig(`"autoscaler":{"priority":-1},"machineDrainTimeout":"0s","machineResourcesDeletionTimeout":"-1h",` +
+					`"maxEvictRetries":-1,"minReadySeconds":-1,"spot":{"maxPrice":""}`)
+
+				Expect(ValidateWorkerPools([]extensionsv1alpha1.WorkerPool{pool}, true, fldPath)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.pools[0].providerConfig.autoscaler.priority"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.pools[0].providerConfig.machineDrainTimeout"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.pools[0].providerConfig.machineResourcesDeletionTimeout"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.pools[0].providerConfig.maxEvictRetries"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.pools[0].providerConfig.minReadySeconds"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.pools[0].providerConfig.spot.maxPrice"),
+					})),
+				))
+			})
+
+			DescribeTable("should reject invalid data volumes",
+				func(dataVolumes string, errorType field.ErrorType, fieldName string) {
+					withConfig(`"dataVolumes":` + dataVolumes)
+
+					Expect(ValidateWorkerPools([]extensionsv1alpha1.WorkerPool{pool}, true, fldPath)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(errorType),
+						"Field": Equal(fieldName),
+					}))))
+				},
+				Entry("missing name", `[{"size":"10Gi"}]`, field.ErrorTypeRequired, "spec.pools[0].providerConfig.dataVolumes[0].name"),
+				Entry("duplicate name", `[{"name":"a","size":"10Gi"},{"name":"a","size":"10Gi"}]`, field.ErrorTypeDuplicate, "spec.pools[0].providerConfig.dataVolumes[1].name"),
+				Entry("invalid size", `[{"name":"a","size":"large"}]`, field.ErrorTypeInvalid, "spec.pools[0].providerConfig.dataVolumes[0].size"),
+				Entry("zero size", `[{"name":"a","size":"0"}]`, field.ErrorTypeInvalid, "spec.pools[0].providerConfig.dataVolumes[0].size"),
+				Entry("relative mount path", `[{"name":"a","size":"10Gi","mountPath":"var/data"}]`, field.ErrorTypeInvalid, "spec.pools[0].providerConfig.dataVolumes[0].mountPath"),
+				Entry("unclean mount path", `[{"name":"a","size":"10Gi","mountPath":"/var/../data"}]`, field.ErrorTypeInvalid, "spec.pools[0].providerConfig.dataVolumes[0].mountPath"),
+				Entry("root mount path", `[{"name":"a","size":"10Gi","mountPath":"/"}]`, field.ErrorTypeInvalid, "spec.pools[0].providerConfig.dataVolumes[0].mountPath"),
+				Entry("duplicate mount path", `[{"name":"a","size":"10Gi","mountPath":"/data"},{"name":"b","size":"10Gi","mountPath":"/data"}]`, field.ErrorTypeDuplicate, "spec.pools[0].providerConfig.dataVolumes[1].mountPath"),
+			)
+
+			DescribeTable("should reject an invalid zone distribution",
+				func(zoneDistribution string, errorType field.ErrorType, fieldName string) {
+					withConfig(`"zoneDistribution":` + zoneDistribution)
+
+					Expect(ValidateWorkerPools([]extensionsv1alpha1.WorkerPool{pool}, true, fldPath)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(errorType),
+						"Field": Equal(fieldName),
+					}))))
+				},
+				Entry("unknown zone", `[{"name":"zone-c"}]`, field.ErrorTypeNotSupported, "spec.pools[0].providerConfig.zoneDistribution[0].name"),
+				Entry("duplicate zone", `[{"name":"zone-a"},{"name":"zone-a"}]`, field.ErrorTypeDuplicate, "spec.pools[0].providerConfig.zoneDistribution[1].name"),
+				Entry("negative weight", `[{"name":"zone-a","weight":-1}]`, field.ErrorTypeInvalid, "spec.pools[0].providerConfig.zoneDistribution[0].weight"),
+				Entry("negative minimum", `[{"name":"zone-a","minimum":-1}]`, field.ErrorTypeInvalid, "spec.pools[0].providerConfig.zoneDistribution[0].minimum"),
+				Entry("maximum below minimum", `[{"name":"zone-a","minimum":1,"maximum":0}]`, field.ErrorTypeInvalid, "spec.pools[0].providerConfig.zoneDistribution[0].maximum"),
+				Entry("no positive weight", `[{"name":"zone-a","weight":0},{"name":"zone-b","weight":0}]`, field.ErrorTypeInvalid, "spec.pools[0].providerConfig.zoneDistribution"),
+				Entry("minimums exceed pool minimum", `[{"name":"zone-a","minimum":1},{"name":"zone-b","minimum":1}]`, field.ErrorTypeInvalid, "spec.pools[0].providerConfig.zoneDistribution"),
+				Entry("maximums exceed pool maximum", `[{"name":"zone-a","maximum":2},{"name":"zone-b","maximum":1}]`, field.ErrorTypeInvalid, "spec.pools[0].providerConfig.zoneDistribution"),
+			)
+		})
+
+		It("should only require zones if requested", func() {
+			pool.Zones = nil
+
+			Expect(ValidateWorkerPools([]extensionsv1alpha1.WorkerPool{pool}, false, fldPath)).To(BeEmpty())
+			Expect(ValidateWorkerPools([]extensionsv1alpha1.WorkerPool{pool}, true, fldPath)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("spec.pools[0].zones"),
+			}))))
+		})
+	})
+
+	Describe("#ValidateUnsupportedWorkerConfigFields", func() {
+		var fldPath = field.NewPath("spec", "pools")
+
+		poolWithConfig := func(config string) extensionsv1alpha1.WorkerPool {
+			return extensionsv1alpha1.WorkerPool{
+				Name:           "pool",
+				ProviderConfig: &runtime.RawExtension{Raw: []byte(`{"apiVersion":"worker.extensions.gardener.cloud/v1alpha1","kind":"WorkerConfig"` + config + `}`)},
+			}
+		}
+
+		It("should forbid the unsupported fields that are set", func() {
+			pools := []extensionsv1alpha1.WorkerPool{
+				poolWithConfig(`,"minReadySeconds":30,"dataVolumes":[]`),
+				poolWithConfig(`,"spot":{}`),
+				poolWithConfig(`,"dataVolumes":[{"name":"data","size":"50Gi"}]`),
+				poolWithConfig(`,"zoneDistribution":[{"name":"zone-a"}]`),
+			}
+
+			Expect(ValidateUnsupportedWorkerConfigFields(pools, []string{WorkerConfigFieldSpot, WorkerConfigFieldDataVolumes, WorkerConfigFieldZoneDistribution}, fldPath)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("spec.pools[1].providerConfig.spot"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("spec.pools[2].providerConfig.dataVolumes"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("spec.pools[3].providerConfig.zoneDistribution"),
+				})),
+			))
+		})
+
+		It("should allow the fields that are not listed as unsupported", func() {
+			pools := []extensionsv1alpha1.WorkerPool{poolWithConfig(`,"spot":{}`)}
+
+			Expect(ValidateUnsupportedWorkerConfigFields(pools, nil, fldPath)).To(BeEmpty())
+		})
+
+		It("should skip pools without or with an undecodable providerConfig", func() {
+			pools := []extensionsv1alpha1.WorkerPool{
+				{Name: "pool"},
+				poolWithConfig(`,"spot":"foo"`),
+			}
+
+			Expect(ValidateUnsupportedWorkerConfigFields(pools, []string{WorkerConfigFieldSpot}, fldPath)).To(BeEmpty())
+		})
+	})
+})
@@ -131,7 +131,8 @@ func (w *ServerOptions) buildBootstrapOptions() (*webhook.BootstrapOptions, erro
 		}
 
 		return &webhook.BootstrapOptions{
-			MutatingWebhookConfigName: w.Name,
+			MutatingWebhookConfigName:   w.Name,
+			ValidatingWebhookConfigName: w.Name,
 			Service: &webhook.Service{
 				Name:      w.Name,
 				Namespace: w.Namespace,
@@ -141,8 +142,9 @@ func (w *ServerOptions) buildBootstrapOptions() (*webhook.BootstrapOptions, erro
 
 	case URLMode:
 		return &webhook.BootstrapOptions{
-			MutatingWebhookConfigName: w.Name,
-			Host:                      &w.Host,
+			MutatingWebhookConfigName:   w.Name,
+			ValidatingWebhookConfigName: w.Name,
+			Host:                        &w.Host,
 		}, nil
 
 	default:
@@ -94,7 +94,8 @@ var _ = Describe("Options", func() {
 					Port:    port,
 					CertDir: certDir,
 					BootstrapOptions: &webhook.BootstrapOptions{
-						MutatingWebhookConfigName: name,
+						MutatingWebhookConfigName:   name,
+						ValidatingWebhookConfigName: name,
 						Service: &webhook.Service{
 							Name:      name,
 							Namespace: namespace,
@@ -142,8 +143,9 @@ var _ = Describe("Options", func() {
 					Port:    port,
 					CertDir: certDir,
 					BootstrapOptions: &webhook.BootstrapOptions{
-						MutatingWebhookConfigName: name,
-						Host:                      &h,
+						MutatingWebhookConfigName:   name,
+						ValidatingWebhookConfigName: name,
+						Host:                        &h,
 					},
 				}))
 			})
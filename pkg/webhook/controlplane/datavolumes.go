@@ -0,0 +1,92 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controlplane
+
+import (
+	"fmt"
+
+	"github.com/gardener/gardener-extensions/pkg/controller/worker"
+
+	"github.com/coreos/go-systemd/unit"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/pkg/errors"
+)
+
+// DataVolumeFilesystem is the filesystem with which data volumes are formatted.
+const DataVolumeFilesystem = "ext4"
+
+// EnsureDataVolumeUnits ensures that the given units contain a unit that formats and a unit that mounts each of the
+// given data volumes that has a mount path. The devicePath function returns the path of the block device of the
+// data volume with the given index on the machine.
+func EnsureDataVolumeUnits(units []extensionsv1alpha1.Unit, volumes []worker.DataVolume, devicePath func(int) string) ([]extensionsv1alpha1.Unit, error) {
+	unitSerializer := NewUnitSerializer()
+
+	for i, volume := range volumes {
+		if volume.MountPath == nil {
+			continue
+		}
+
+		var (
+			device     = devicePath(i)
+			deviceUnit = unit.UnitNamePathEscape(device) + ".device"
+			formatUnit = fmt.Sprintf("format-data-volume-%s.service", unit.UnitNameEscape(volume.Name))
+			mountUnit  = unit.UnitNamePathEscape(*volume.MountPath) + ".mount"
+		)
+
+		formatContent, err := unitSerializer.Serialize([]*unit.UnitOption{
+			unit.NewUnitOption("Unit", "Description", fmt.Sprintf("Format data volume %s", volume.Name)),
+			unit.NewUnitOption("Unit", "DefaultDependencies", "no"),
+			unit.NewUnitOption("Unit", "BindsTo", deviceUnit),
+			unit.NewUnitOption("Unit", "After", deviceUnit),
+			unit.NewUnitOption("Unit", "Before", mountUnit),
+			unit.NewUnitOption("Service", "Type", "oneshot"),
+			unit.NewUnitOption("Service", "RemainAfterExit", "yes"),
+			unit.NewUnitOption("Service", "ExecStart", fmt.Sprintf("/bin/sh -c 'blkid %s || mkfs.%s %s'", device, DataVolumeFilesystem, device)),
+		})
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not serialize unit %s", formatUnit)
+		}
+
+		mountContent, err := unitSerializer.Serialize([]*unit.UnitOption{
+			unit.NewUnitOption("Unit", "Description", fmt.Sprintf("Mount data volume %s", volume.Name)),
+			unit.NewUnitOption("Unit", "Requires", formatUnit),
+			unit.NewUnitOption("Unit", "After", formatUnit),
+			unit.NewUnitOption("Mount", "What", device),
+			unit.NewUnitOption("Mount", "Where", *volume.MountPath),
+			unit.NewUnitOption("Mount", "Type", DataVolumeFilesystem),
+			unit.NewUnitOption("Install", "WantedBy", "local-fs.target"),
+		})
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not serialize unit %s", mountUnit)
+		}
+
+		units = EnsureUnitWithName(units, dataVolumeUnit(formatUnit, formatContent))
+		units = EnsureUnitWithName(units, dataVolumeUnit(mountUnit, mountContent))
+	}
+	return units, nil
+}
+
+func dataVolumeUnit(name, content string) extensionsv1alpha1.Unit {
+	var (
+		command = "start"
+		enable  = true
+	)
+	return extensionsv1alpha1.Unit{
+		Name:    name,
+		Command: &command,
+		Enable:  &enable,
+		Content: &content,
+	}
+}
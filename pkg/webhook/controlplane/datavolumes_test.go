@@ -0,0 +1,98 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controlplane
+
+import (
+	"fmt"
+
+	"github.com/gardener/gardener-extensions/pkg/controller/worker"
+	"github.com/gardener/gardener-extensions/pkg/util"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+const (
+	formatUnitContent = `[Unit]
+Description=Format data volume image-cache
+DefaultDependencies=no
+BindsTo=dev-xvdg.device
+After=dev-xvdg.device
+Before=var-lib-image\x2dcache.mount
+
+[Service]
+Type=oneshot
+RemainAfterExit=yes
+ExecStart=/bin/sh -c 'blkid /dev/xvdg || mkfs.ext4 /dev/xvdg'
+`
+	mountUnitContent = `[Unit]
+Description=Mount data volume image-cache
+Requires=format-data-volume-image\x2dcache.service
+After=format-data-volume-image\x2dcache.service
+
+[Mount]
+What=/dev/xvdg
+Where=/var/lib/image-cache
+Type=ext4
+
+[Install]
+WantedBy=local-fs.target
+`
+)
+
+var _ = Describe("DataVolumes", func() {
+	Describe("#EnsureDataVolumeUnits", func() {
+		var (
+			devicePath = func(i int) string {
+				return fmt.Sprintf("/dev/xvd%c", 'f'+i)
+			}
+			volumes = []worker.DataVolume{
+				{Name: "raw", Size: "100Gi"},
+				{Name: "image-cache", Size: "50Gi", MountPath: util.StringPtr("/var/lib/image-cache")},
+			}
+			kubeletUnit = extensionsv1alpha1.Unit{Name: "kubelet.service"}
+		)
+
+		It("should add format and mount units for the data volumes with a mount path", func() {
+			units, err := EnsureDataVolumeUnits([]extensionsv1alpha1.Unit{kubeletUnit}, volumes, devicePath)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(units).To(Equal([]extensionsv1alpha1.Unit{
+				kubeletUnit,
+				{
+					Name:    `format-data-volume-image\x2dcache.service`,
+					Command: util.StringPtr("start"),
+					Enable:  util.BoolPtr(true),
+					Content: util.StringPtr(formatUnitContent),
+				},
+				{
+					Name:    `var-lib-image\x2dcache.mount`,
+					Command: util.StringPtr("start"),
+					Enable:  util.BoolPtr(true),
+					Content: util.StringPtr(mountUnitContent),
+				},
+			}))
+		})
+
+		It("should replace existing units with the same name", func() {
+			units, err := EnsureDataVolumeUnits([]extensionsv1alpha1.Unit{{Name: `var-lib-image\x2dcache.mount`}}, volumes, devicePath)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(units).To(HaveLen(2))
+			Expect(UnitWithName(units, `var-lib-image\x2dcache.mount`).Content).To(Equal(util.StringPtr(mountUnitContent)))
+		})
+	})
+})
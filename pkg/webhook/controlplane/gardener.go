@@ -17,6 +17,7 @@ package controlplane
 import (
 	"context"
 
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
@@ -49,3 +50,15 @@ func GetLoadBalancerIngress(ctx context.Context, client client.Client, namespace
 
 	return "", errors.New("`.status.loadBalancer.ingress[]` has an element which does neither contain `.ip` nor `.hostname`")
 }
+
+// GetControlPlane returns the ControlPlane resource in the given namespace, or nil if there is none.
+func GetControlPlane(ctx context.Context, c client.Client, namespace string) (*extensionsv1alpha1.ControlPlane, error) {
+	cpList := &extensionsv1alpha1.ControlPlaneList{}
+	if err := c.List(ctx, client.InNamespace(namespace), cpList); err != nil {
+		return nil, errors.Wrapf(err, "could not list controlplanes in namespace '%s'", namespace)
+	}
+	if len(cpList.Items) == 0 {
+		return nil, nil
+	}
+	return &cpList.Items[0], nil
+}
@@ -16,7 +16,11 @@ package genericmutator
 
 import (
 	"context"
+	"strings"
+
+	workerhelper "github.com/gardener/gardener-extensions/pkg/apis/worker/helper"
 	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
+	"github.com/gardener/gardener-extensions/pkg/controller/worker"
 	"github.com/gardener/gardener-extensions/pkg/webhook/controlplane"
 
 	"github.com/coreos/go-systemd/unit"
@@ -46,11 +50,15 @@ type Ensurer interface {
 	// EnsureETCDStatefulSet ensures that the etcd stateful sets conform to the provider requirements.
 	EnsureETCDStatefulSet(context.Context, *appsv1.StatefulSet, *extensionscontroller.Cluster) error
 	// EnsureKubeletServiceUnitOptions ensures that the kubelet.service unit options conform to the provider requirements.
-	EnsureKubeletServiceUnitOptions(context.Context, []*unit.UnitOption) ([]*unit.UnitOption, error)
+	// The given ControlPlane is the one of the shoot the kubelet belongs to, it is nil if there is none (yet).
+	EnsureKubeletServiceUnitOptions(context.Context, []*unit.UnitOption, *extensionsv1alpha1.ControlPlane) ([]*unit.UnitOption, error)
 	// EnsureKubeletConfiguration ensures that the kubelet configuration conforms to the provider requirements.
-	EnsureKubeletConfiguration(context.Context, *kubeletconfigv1beta1.KubeletConfiguration) error
+	// The given ControlPlane is the one of the shoot the kubelet belongs to, it is nil if there is none (yet).
+	EnsureKubeletConfiguration(context.Context, *kubeletconfigv1beta1.KubeletConfiguration, *extensionsv1alpha1.ControlPlane) error
 	// EnsureKubernetesGeneralConfiguration ensures that the kubernetes general configuration conforms to the provider requirements.
 	EnsureKubernetesGeneralConfiguration(context.Context, *string) error
+	// EnsureDataVolumeUnits ensures that the given units format and mount the given data volumes of a worker pool.
+	EnsureDataVolumeUnits(context.Context, *[]extensionsv1alpha1.Unit, []worker.DataVolume) error
 }
 
 // NewMutator creates a new controlplane mutator.
@@ -118,16 +126,30 @@ func (m *mutator) Mutate(ctx context.Context, obj runtime.Object) error {
 }
 
 func (m *mutator) mutateOperatingSystemConfig(ctx context.Context, osc *extensionsv1alpha1.OperatingSystemConfig) error {
+	var (
+		kubeletUnit = controlplane.UnitWithName(osc.Spec.Units, "kubelet.service")
+		kubeletFile = controlplane.FileWithPath(osc.Spec.Files, "/var/lib/kubelet/config/kubelet")
+		cp          *extensionsv1alpha1.ControlPlane
+		err         error
+	)
+
+	// Get the control plane of the shoot, if the kubelet is going to be mutated
+	if kubeletUnit != nil || kubeletFile != nil {
+		if cp, err = controlplane.GetControlPlane(ctx, m.client, osc.Namespace); err != nil {
+			return err
+		}
+	}
+
 	// Mutate kubelet.service unit, if present
-	if u := controlplane.UnitWithName(osc.Spec.Units, "kubelet.service"); u != nil && u.Content != nil {
-		if err := m.ensureKubeletServiceUnitContent(ctx, u.Content); err != nil {
+	if kubeletUnit != nil && kubeletUnit.Content != nil {
+		if err := m.ensureKubeletServiceUnitContent(ctx, kubeletUnit.Content, cp); err != nil {
 			return err
 		}
 	}
 
 	// Mutate kubelet configuration file, if present
-	if f := controlplane.FileWithPath(osc.Spec.Files, "/var/lib/kubelet/config/kubelet"); f != nil && f.Content.Inline != nil {
-		if err := m.ensureKubeletConfigFileContent(ctx, f.Content.Inline); err != nil {
+	if kubeletFile != nil && kubeletFile.Content.Inline != nil {
+		if err := m.ensureKubeletConfigFileContent(ctx, kubeletFile.Content.Inline, cp); err != nil {
 			return err
 		}
 	}
@@ -139,10 +161,36 @@ func (m *mutator) mutateOperatingSystemConfig(ctx context.Context, osc *extensio
 		}
 	}
 
+	// Ensure units for the data volumes of the worker pool, if any
+	volumes, err := getDataVolumes(osc)
+	if err != nil {
+		return err
+	}
+	if len(volumes) > 0 {
+		if err := m.ensurer.EnsureDataVolumeUnits(ctx, &osc.Spec.Units, volumes); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
-func (m *mutator) ensureKubeletServiceUnitContent(ctx context.Context, content *string) error {
+// getDataVolumes returns the data volumes of the worker pool the given operating system config belongs to, if any.
+// The Worker of the pool does not exist yet when the operating system config is created, hence its WorkerConfig is
+// read from the operating system config itself.
+func getDataVolumes(osc *extensionsv1alpha1.OperatingSystemConfig) ([]worker.DataVolume, error) {
+	if !strings.HasPrefix(osc.Name, common.CloudConfigPrefix) {
+		return nil, nil
+	}
+
+	config, err := workerhelper.WorkerConfigFromOperatingSystemConfig(osc)
+	if err != nil {
+		return nil, err
+	}
+	return worker.DataVolumesFromConfig(config), nil
+}
+
+func (m *mutator) ensureKubeletServiceUnitContent(ctx context.Context, content *string, cp *extensionsv1alpha1.ControlPlane) error {
 	var opts []*unit.UnitOption
 	var err error
 
@@ -151,7 +199,7 @@ func (m *mutator) ensureKubeletServiceUnitContent(ctx context.Context, content *
 		return errors.Wrap(err, "could not deserialize kubelet.service unit content")
 	}
 
-	if opts, err = m.ensurer.EnsureKubeletServiceUnitOptions(ctx, opts); err != nil {
+	if opts, err = m.ensurer.EnsureKubeletServiceUnitOptions(ctx, opts, cp); err != nil {
 		return err
 	}
 
@@ -163,7 +211,7 @@ func (m *mutator) ensureKubeletServiceUnitContent(ctx context.Context, content *
 	return nil
 }
 
-func (m *mutator) ensureKubeletConfigFileContent(ctx context.Context, fci *extensionsv1alpha1.FileContentInline) error {
+func (m *mutator) ensureKubeletConfigFileContent(ctx context.Context, fci *extensionsv1alpha1.FileContentInline, cp *extensionsv1alpha1.ControlPlane) error {
 	var kubeletConfig *kubeletconfigv1beta1.KubeletConfiguration
 	var err error
 
@@ -172,7 +220,7 @@ func (m *mutator) ensureKubeletConfigFileContent(ctx context.Context, fci *exten
 		return errors.Wrap(err, "could not decode kubelet configuration")
 	}
 
-	if err = m.ensurer.EnsureKubeletConfiguration(ctx, kubeletConfig); err != nil {
+	if err = m.ensurer.EnsureKubeletConfiguration(ctx, kubeletConfig, cp); err != nil {
 		return err
 	}
 
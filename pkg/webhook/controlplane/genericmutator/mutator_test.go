@@ -19,7 +19,9 @@ import (
 	"encoding/json"
 	"testing"
 
+	workerhelper "github.com/gardener/gardener-extensions/pkg/apis/worker/helper"
 	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
+	"github.com/gardener/gardener-extensions/pkg/controller/worker"
 	mockclient "github.com/gardener/gardener-extensions/pkg/mock/controller-runtime/client"
 	mockcontrolplane "github.com/gardener/gardener-extensions/pkg/mock/gardener-extensions/webhook/controlplane"
 	mockgenericmutator "github.com/gardener/gardener-extensions/pkg/mock/gardener-extensions/webhook/controlplane/genericmutator"
@@ -267,8 +269,11 @@ var _ = Describe("Mutator", func() {
 				oldFci = &extensionsv1alpha1.FileContentInline{
 					Data: oldKubernetesGeneralConfigData,
 				}
+				cp = &extensionsv1alpha1.ControlPlane{
+					ObjectMeta: metav1.ObjectMeta{Name: "control-plane", Namespace: namespace},
+				}
 				osc = &extensionsv1alpha1.OperatingSystemConfig{
-					ObjectMeta: metav1.ObjectMeta{Name: "test"},
+					ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: namespace},
 					Spec: extensionsv1alpha1.OperatingSystemConfigSpec{
 						Purpose: extensionsv1alpha1.OperatingSystemConfigPurposeReconcile,
 						Units: []extensionsv1alpha1.Unit{
@@ -324,11 +329,20 @@ var _ = Describe("Mutator", func() {
 				}
 			)
 
+			// Create mock client
+			client := mockclient.NewMockClient(ctrl)
+			client.EXPECT().List(context.TODO(), gomock.Any(), &extensionsv1alpha1.ControlPlaneList{}).DoAndReturn(
+				func(_ context.Context, _ interface{}, list *extensionsv1alpha1.ControlPlaneList) error {
+					list.Items = []extensionsv1alpha1.ControlPlane{*cp}
+					return nil
+				},
+			)
+
 			// Create mock ensurer
 			ensurer := mockgenericmutator.NewMockEnsurer(ctrl)
-			ensurer.EXPECT().EnsureKubeletServiceUnitOptions(context.TODO(), oldUnitOptions).Return(newUnitOptions, nil)
-			ensurer.EXPECT().EnsureKubeletConfiguration(context.TODO(), oldKubeletConfig).DoAndReturn(
-				func(ctx context.Context, kubeletConfig *kubeletconfigv1beta1.KubeletConfiguration) error {
+			ensurer.EXPECT().EnsureKubeletServiceUnitOptions(context.TODO(), oldUnitOptions, cp).Return(newUnitOptions, nil)
+			ensurer.EXPECT().EnsureKubeletConfiguration(context.TODO(), oldKubeletConfig, cp).DoAndReturn(
+				func(ctx context.Context, kubeletConfig *kubeletconfigv1beta1.KubeletConfiguration, _ *extensionsv1alpha1.ControlPlane) error {
 					*kubeletConfig = *newKubeletConfig
 					return nil
 				},
@@ -352,12 +366,69 @@ var _ = Describe("Mutator", func() {
 
 			// Create mutator
 			mutator := NewMutator(ensurer, us, kcc, logger)
+			err := mutator.(inject.Client).InjectClient(client)
+			Expect(err).To(Not(HaveOccurred()))
 
 			// Call Mutate method and check the result
-			err := mutator.Mutate(context.TODO(), osc)
+			err = mutator.Mutate(context.TODO(), osc)
 			Expect(err).To(Not(HaveOccurred()))
 			checkOperatingSystemConfig(osc)
 		})
+
+		It("should invoke EnsureDataVolumeUnits with the data volumes of the worker pool of the OperatingSystemConfig", func() {
+			var (
+				osc = &extensionsv1alpha1.OperatingSystemConfig{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "cloud-config-pool-1-a1b2c-original",
+						Namespace: namespace,
+						Annotations: map[string]string{
+							workerhelper.AnnotationWorkerConfig: `{"apiVersion":"worker.extensions.gardener.cloud/v1alpha1","kind":"WorkerConfig","dataVolumes":[{"name":"cache","size":"50Gi","mountPath":"/var/lib/cache"}]}`,
+						},
+					},
+					Spec: extensionsv1alpha1.OperatingSystemConfigSpec{
+						Purpose: extensionsv1alpha1.OperatingSystemConfigPurposeReconcile,
+					},
+				}
+				units = []extensionsv1alpha1.Unit{{Name: "var-lib-cache.mount"}}
+			)
+
+			// Create mock ensurer
+			ensurer := mockgenericmutator.NewMockEnsurer(ctrl)
+			ensurer.EXPECT().EnsureDataVolumeUnits(context.TODO(), &osc.Spec.Units, []worker.DataVolume{{Name: "cache", Size: "50Gi", MountPath: util.StringPtr("/var/lib/cache")}}).DoAndReturn(
+				func(_ context.Context, u *[]extensionsv1alpha1.Unit, _ []worker.DataVolume) error {
+					*u = units
+					return nil
+				},
+			)
+
+			// Create mutator
+			mutator := NewMutator(ensurer, nil, nil, logger)
+
+			// Call Mutate method and check the result
+			err := mutator.Mutate(context.TODO(), osc)
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(osc.Spec.Units).To(Equal(units))
+		})
+
+		It("should fail if the WorkerConfig of the OperatingSystemConfig cannot be decoded", func() {
+			osc := &extensionsv1alpha1.OperatingSystemConfig{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "cloud-config-pool-1-a1b2c-original",
+					Namespace:   namespace,
+					Annotations: map[string]string{workerhelper.AnnotationWorkerConfig: `{"dataVolumes":[]}`},
+				},
+				Spec: extensionsv1alpha1.OperatingSystemConfigSpec{
+					Purpose: extensionsv1alpha1.OperatingSystemConfigPurposeReconcile,
+				},
+			}
+
+			// Create mutator
+			mutator := NewMutator(mockgenericmutator.NewMockEnsurer(ctrl), nil, nil, logger)
+
+			// Call Mutate method and check the result
+			err := mutator.Mutate(context.TODO(), osc)
+			Expect(err).To(HaveOccurred())
+		})
 	})
 })
 
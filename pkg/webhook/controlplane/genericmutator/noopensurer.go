@@ -18,8 +18,10 @@ import (
 	"context"
 
 	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
+	"github.com/gardener/gardener-extensions/pkg/controller/worker"
 
 	"github.com/coreos/go-systemd/unit"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	kubeletconfigv1beta1 "k8s.io/kubelet/config/v1beta1"
@@ -54,12 +56,12 @@ func (e *NoopEnsurer) EnsureETCDStatefulSet(context.Context, *appsv1.StatefulSet
 }
 
 // EnsureKubeletServiceUnitOptions ensures that the kubelet.service unit options conform to the provider requirements.
-func (e *NoopEnsurer) EnsureKubeletServiceUnitOptions(_ context.Context, opts []*unit.UnitOption) ([]*unit.UnitOption, error) {
+func (e *NoopEnsurer) EnsureKubeletServiceUnitOptions(_ context.Context, opts []*unit.UnitOption, _ *extensionsv1alpha1.ControlPlane) ([]*unit.UnitOption, error) {
 	return opts, nil
 }
 
 // EnsureKubeletConfiguration ensures that the kubelet configuration conforms to the provider requirements.
-func (e *NoopEnsurer) EnsureKubeletConfiguration(context.Context, *kubeletconfigv1beta1.KubeletConfiguration) error {
+func (e *NoopEnsurer) EnsureKubeletConfiguration(context.Context, *kubeletconfigv1beta1.KubeletConfiguration, *extensionsv1alpha1.ControlPlane) error {
 	return nil
 }
 
@@ -67,3 +69,8 @@ func (e *NoopEnsurer) EnsureKubeletConfiguration(context.Context, *kubeletconfig
 func (e *NoopEnsurer) EnsureKubernetesGeneralConfiguration(context.Context, *string) error {
 	return nil
 }
+
+// EnsureDataVolumeUnits ensures that the given units format and mount the given data volumes of a worker pool.
+func (e *NoopEnsurer) EnsureDataVolumeUnits(context.Context, *[]extensionsv1alpha1.Unit, []worker.DataVolume) error {
+	return nil
+}
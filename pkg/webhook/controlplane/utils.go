@@ -225,6 +225,17 @@ func EnsureNoPVCWithName(items []corev1.PersistentVolumeClaim, name string) []co
 	return items
 }
 
+// EnsureUnitWithName ensures that a Unit with a name equal to the name of the given Unit exists
+// in the given slice and is equal to the given Unit.
+func EnsureUnitWithName(items []extensionsv1alpha1.Unit, item extensionsv1alpha1.Unit) []extensionsv1alpha1.Unit {
+	if i := unitWithNameIndex(items, item.Name); i < 0 {
+		items = append(items, item)
+	} else if !reflect.DeepEqual(items[i], item) {
+		items = append(append(items[:i], item), items[i+1:]...)
+	}
+	return items
+}
+
 // EnsureUnitOption ensures the given unit option exist in the given slice.
 func EnsureUnitOption(items []*unit.UnitOption, item *unit.UnitOption) []*unit.UnitOption {
 	if i := unitOptionIndex(items, item); i < 0 {
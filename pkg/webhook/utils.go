@@ -126,6 +126,29 @@ func (s *ServerBuilder) AddToManager(mgr manager.Manager) error {
 // with the given kind, provider, and name, applicable to objects of all given types,
 // executing the given handler, and bound to the given manager.
 func NewWebhook(mgr manager.Manager, kind Kind, provider, name string, types []runtime.Object, handler admission.Handler) (*admission.Webhook, error) {
+	b, err := newWebhookBuilder(mgr, kind, provider, name, types, handler)
+	if err != nil {
+		return nil, err
+	}
+
+	return b.Mutating().Build()
+}
+
+// NewValidatingWebhook creates a new validating webhook for create and update operations
+// with the given kind, provider, and name, applicable to objects of all given types,
+// executing the given handler, and bound to the given manager.
+func NewValidatingWebhook(mgr manager.Manager, kind Kind, provider, name string, types []runtime.Object, handler admission.Handler) (*admission.Webhook, error) {
+	b, err := newWebhookBuilder(mgr, kind, provider, name, types, handler)
+	if err != nil {
+		return nil, err
+	}
+
+	return b.Validating().Build()
+}
+
+// newWebhookBuilder creates a webhook builder for create and update operations with the given kind, provider,
+// and name, applicable to objects of all given types, executing the given handler, and bound to the given manager.
+func newWebhookBuilder(mgr manager.Manager, kind Kind, provider, name string, types []runtime.Object, handler admission.Handler) (*builder.WebhookBuilder, error) {
 	// Build namespace selector from the webhook kind and provider
 	namespaceSelector, err := buildSelector(kind, provider)
 	if err != nil {
@@ -146,13 +169,11 @@ func NewWebhook(mgr manager.Manager, kind Kind, provider, name string, types []r
 	return builder.NewWebhookBuilder().
 		Name(name + "." + provider + "." + NameSuffix).
 		Path("/" + name).
-		Mutating().
 		FailurePolicy(admissionregistrationv1beta1.Fail).
 		NamespaceSelector(namespaceSelector).
 		Rules(rules...).
 		Handlers(handler).
-		WithManager(mgr).
-		Build()
+		WithManager(mgr), nil
 }
 
 // buildSelector creates and returns a LabelSelector for the given webhook kind and provider.
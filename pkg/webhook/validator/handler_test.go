@@ -0,0 +1,162 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validator
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	mockmanager "github.com/gardener/gardener-extensions/pkg/mock/controller-runtime/manager"
+	mocktypes "github.com/gardener/gardener-extensions/pkg/mock/controller-runtime/webhook/admission/types"
+	mockvalidator "github.com/gardener/gardener-extensions/pkg/mock/gardener-extensions/webhook/validator"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	admissionv1beta1 "k8s.io/api/admission/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission/types"
+)
+
+var _ = Describe("Handler", func() {
+	const (
+		name      = "foo"
+		namespace = "default"
+	)
+
+	var (
+		ctrl    *gomock.Controller
+		mgr     *mockmanager.MockManager
+		decoder *mocktypes.MockDecoder
+
+		objTypes = []runtime.Object{&corev1.Service{}}
+		svc      = &corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name},
+		}
+
+		req = types.Request{
+			AdmissionRequest: &admissionv1beta1.AdmissionRequest{
+				Kind:      metav1.GroupVersionKind{Group: "", Version: "v1", Kind: "Service"},
+				Name:      name,
+				Namespace: namespace,
+				Operation: admissionv1beta1.Create,
+			},
+		}
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+
+		// Build scheme
+		scheme := runtime.NewScheme()
+		_ = corev1.AddToScheme(scheme)
+
+		// Create mock manager
+		mgr = mockmanager.NewMockManager(ctrl)
+		mgr.EXPECT().GetScheme().Return(scheme)
+
+		// Create mock decoder
+		decoder = mocktypes.NewMockDecoder(ctrl)
+		decoder.EXPECT().Decode(req, &corev1.Service{}).DoAndReturn(decoderDecode(svc))
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Describe("#Handle", func() {
+		It("should return an allowing response if the resource is valid", func() {
+			// Create mock validator
+			validator := mockvalidator.NewMockValidator(ctrl)
+			validator.EXPECT().Validate(context.TODO(), svc).Return(nil)
+
+			// Create handler
+			h, err := newHandler(mgr, objTypes, validator, logger)
+			Expect(err).NotTo(HaveOccurred())
+			h.decoder = decoder
+
+			// Call Handle and check response
+			resp := h.Handle(context.TODO(), req)
+			Expect(resp).To(Equal(types.Response{
+				Response: &admissionv1beta1.AdmissionResponse{
+					Allowed: true,
+				},
+			}))
+		})
+
+		It("should return a denying response with the status of an invalid error", func() {
+			invalidErr := apierrors.NewInvalid(corev1.SchemeGroupVersion.WithKind("Service").GroupKind(), name, field.ErrorList{
+				field.Required(field.NewPath("spec", "ports"), "must not be empty"),
+			})
+
+			// Create mock validator
+			validator := mockvalidator.NewMockValidator(ctrl)
+			validator.EXPECT().Validate(context.TODO(), svc).Return(invalidErr)
+
+			// Create handler
+			h, err := newHandler(mgr, objTypes, validator, logger)
+			Expect(err).NotTo(HaveOccurred())
+			h.decoder = decoder
+
+			// Call Handle and check response
+			status := invalidErr.Status()
+			resp := h.Handle(context.TODO(), req)
+			Expect(resp).To(Equal(types.Response{
+				Response: &admissionv1beta1.AdmissionResponse{
+					Allowed: false,
+					Result:  &status,
+				},
+			}))
+		})
+
+		It("should return an error response if the validator returned an error", func() {
+			// Create mock validator
+			validator := mockvalidator.NewMockValidator(ctrl)
+			validator.EXPECT().Validate(context.TODO(), svc).Return(errors.New("test error"))
+
+			// Create handler
+			h, err := newHandler(mgr, objTypes, validator, logger)
+			Expect(err).NotTo(HaveOccurred())
+			h.decoder = decoder
+
+			// Call Handle and check response
+			resp := h.Handle(context.TODO(), req)
+			Expect(resp).To(Equal(types.Response{
+				Response: &admissionv1beta1.AdmissionResponse{
+					Allowed: false,
+					Result: &metav1.Status{
+						Code:    http.StatusUnprocessableEntity,
+						Message: "could not validate Service default/foo: test error",
+					},
+				},
+			}))
+		})
+	})
+})
+
+func decoderDecode(result runtime.Object) interface{} {
+	return func(ar types.Request, obj runtime.Object) error {
+		switch obj.(type) {
+		case *corev1.Service:
+			*obj.(*corev1.Service) = *result.(*corev1.Service)
+		}
+		return nil
+	}
+}